@@ -1,5 +1,7 @@
 package ast
 
+import "math/big"
+
 type Nodes []Node
 type Node interface {
 	LineNumber() int
@@ -27,6 +29,34 @@ func (n ConstantUint) LineNumber() int {
 	return n.Line
 }
 
+// integer literals too large for a uint64
+type ConstantBigInt struct {
+	Line  int
+	Value *big.Int
+}
+
+func (n ConstantBigInt) LineNumber() int {
+	return n.Line
+}
+
+type ConstantRational struct {
+	Line  int
+	Value *big.Rat
+}
+
+func (n ConstantRational) LineNumber() int {
+	return n.Line
+}
+
+type ConstantComplex struct {
+	Line  int
+	Value complex128
+}
+
+func (n ConstantComplex) LineNumber() int {
+	return n.Line
+}
+
 type ConstantFloat struct {
 	Line  int
 	Value float64
@@ -39,6 +69,9 @@ func (n ConstantFloat) LineNumber() int {
 type SimpleString struct {
 	Line  int
 	Value string
+
+	// set for literals in files with "# frozen_string_literal: true"
+	Frozen bool
 }
 
 func (n SimpleString) LineNumber() int {
@@ -52,6 +85,10 @@ func (n SimpleString) StringValue() string {
 type InterpolatedString struct {
 	Line  int
 	Value string
+
+	// set for literals in files with "# frozen_string_literal: true"
+	// as long as they do not interpolate anything
+	Frozen bool
 }
 
 func (n InterpolatedString) LineNumber() int {
@@ -427,6 +464,7 @@ type MethodParam struct {
 	DefaultValue Node
 	IsSplat      bool
 	IsProc       bool
+	IsKeyword    bool
 }
 
 type Ternary struct {
@@ -494,6 +532,9 @@ type Loop struct {
 	Line      int
 	Condition Node
 	Body      []Node
+	// set for `begin ... end while cond`, where the body runs before the
+	// condition is first checked
+	BodyFirst bool
 }
 
 func (n Loop) LineNumber() int {
@@ -629,6 +670,15 @@ func (n Alias) LineNumber() int {
 	return n.Line
 }
 
+type Undef struct {
+	Line  int
+	Names []Symbol
+}
+
+func (n Undef) LineNumber() int {
+	return n.Line
+}
+
 type Nil struct {
 	Line int
 }
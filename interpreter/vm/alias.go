@@ -11,17 +11,16 @@ func interpretAliasInContext(
 	aliasNode ast.Alias,
 	context Value,
 ) (Value, error) {
-	// FIXME: assumes that the context will be a module, but could also be a class
-	contextModule := context.(Module)
+	// at the top level, methods are aliased on the class of main (Object)
+	contextModule, ok := context.(Module)
+	if !ok {
+		contextModule = context.Class()
+	}
 
-	m, err := contextModule.InstanceMethod(aliasNode.From.Name)
+	err := AliasMethod(contextModule, aliasNode.To.Name, aliasNode.From.Name, vm)
 	if err != nil {
-		return nil, NewNameError(aliasNode.From.Name, contextModule.String(), contextModule.String(), vm.stack.String())
+		return nil, err
 	}
 
-	contextModule.AddInstanceMethod(NewNativeMethod(aliasNode.To.Name, vm, func(self Value, block Block, args ...Value) (Value, error) {
-		return m.Execute(self, block, args...)
-	}))
-
-	return nil, nil
+	return vm.SingletonWithName("nil"), nil
 }
@@ -43,6 +43,40 @@ var _ = Describe("Arrays", func() {
 		})
 	})
 
+	Describe("the set-like operators", func() {
+		It("concatenate arrays with +", func() {
+			value, err := vm.Run("a = [1, 2]; b = a + [3, 4]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[1, 2, 3, 4]"))
+			Expect(vm.MustGet("a").PrettyPrint()).To(Equal("[1, 2]"))
+		})
+
+		It("do not modify the receiver when subtracting", func() {
+			value, err := vm.Run("a = [1, 2, 3, 2]; b = a - [2]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[1, 3]"))
+			Expect(vm.MustGet("a").PrettyPrint()).To(Equal("[1, 2, 3, 2]"))
+		})
+
+		It("intersect arrays with &, in the order of the receiver", func() {
+			value, err := vm.Run("[3, 1, 2, 1] & [1, 3, 5]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[3, 1]"))
+		})
+
+		It("join arrays without duplicates with |", func() {
+			value, err := vm.Run("[1, 2, 1] | [3, 2, [4]] | [[4]]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[1, 2, 3, [4]]"))
+		})
+
+		It("raise a TypeError when given something other than an array", func() {
+			_, err := vm.Run("[1] + 2")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("TypeError: no implicit conversion of Fixnum into Array"))
+		})
+	})
+
 	Describe("joining the elements of an array together", func() {
 		It("returns the elements joined with the given string separator", func() {
 			value, err := vm.Run("[1,2,3].join(':')")
@@ -113,4 +147,366 @@ visited = []
 			Expect(visited[2].String()).To(ContainSubstring(""))
 		})
 	})
+
+	Describe("#flatten", func() {
+		It("returns a new array with the nested arrays expanded in place", func() {
+			value, err := vm.Run("nested = [[1, [2]], 3]; nested.flatten")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm), NewFixnum(3, vm)}))
+
+			Expect(vm.MustGet("nested").(*Array).Members()).To(HaveLen(2))
+		})
+
+		It("takes an optional depth", func() {
+			value, err := vm.Run("[[1, [2]], 3].flatten(1)")
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members).To(HaveLen(3))
+			Expect(members[1].(*Array).Members()).To(Equal([]Value{NewFixnum(2, vm)}))
+		})
+
+		It("raises an ArgumentError for an array that contains itself", func() {
+			_, err := vm.Run("a = [1]; a << a; a.flatten")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: tried to flatten recursive array"))
+		})
+	})
+
+	Describe("#compact", func() {
+		It("returns a new array without the nils", func() {
+			value, err := vm.Run("with_nils = [1, nil, 2, nil]; with_nils.compact")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm)}))
+
+			Expect(vm.MustGet("with_nils").(*Array).Members()).To(HaveLen(4))
+		})
+	})
+
+	Describe("#hash and #eql?", func() {
+		It("compare the members of nested arrays", func() {
+			value, err := vm.Run(`[
+  [1, [2, "x"]].eql?([1, [2, "x"]]),
+  [1, [2, "x"]].hash == [1, [2, "x"]].hash,
+  [1, [2]].eql?([1, [3]]),
+  [[1, 2]].hash == [[2, 1]].hash
+]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true, false, false]"))
+		})
+
+		It("do not treat integers and floats as eql, unlike ==", func() {
+			value, err := vm.Run("[[1].eql?([1.0]), [1] == [1.0]]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[false, true]"))
+		})
+
+		It("let arrays be used as hash keys", func() {
+			value, err := vm.Run(`hash = {[1, [2, "x"]] => "found"}; hash[[1, [2, "x"]]]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal("found"))
+		})
+
+		It("handle arrays that contain themselves", func() {
+			value, err := vm.Run(`
+a = [1]
+a << a
+b = [1]
+b << b
+hash = {a => "found"}
+[a.hash == b.hash, a.eql?(b), hash[a]]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[true, true, "found"]`))
+		})
+	})
+
+	Describe("#uniq", func() {
+		It("returns a new array without duplicates, in the order they first appear", func() {
+			value, err := vm.Run("[1, 1, 2, 3, 3, 2].uniq")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm), NewFixnum(3, vm)}))
+		})
+
+		It("compares strings and arrays by value", func() {
+			value, err := vm.Run("['a', 'a', :a, [1], [1]].uniq")
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members).To(HaveLen(3))
+			Expect(members[0].(*StringValue).RawString()).To(Equal("a"))
+			Expect(members[1].(*SymbolValue).Name()).To(Equal("a"))
+			Expect(members[2].(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm)}))
+		})
+
+		It("does not treat integers and floats as duplicates", func() {
+			value, err := vm.Run("[1, 1.0].uniq")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(HaveLen(2))
+		})
+
+		It("removes duplicates by the result of the block given", func() {
+			value, err := vm.Run("[1, 2, 3, 4].uniq { |x| x % 2 }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm)}))
+		})
+	})
+
+	Describe("#zip", func() {
+		It("combines the elements at each index into arrays", func() {
+			value, err := vm.Run("[1, 2].zip([3, 4], [5, 6])")
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(3, vm), NewFixnum(5, vm)}))
+			Expect(members[1].(*Array).Members()).To(Equal([]Value{NewFixnum(2, vm), NewFixnum(4, vm), NewFixnum(6, vm)}))
+		})
+
+		It("pads shorter arguments with nil", func() {
+			value, err := vm.Run("[1, 2].zip([3])")
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members[1].(*Array).Members()).To(Equal([]Value{NewFixnum(2, vm), vm.SingletonWithName("nil")}))
+		})
+
+		It("wraps each element in an array when given no arguments", func() {
+			value, err := vm.Run("[1, 2].zip")
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm)}))
+		})
+
+		It("yields each tuple to the block given and returns nil", func() {
+			value, err := vm.Run(`
+tuples = []
+[1, 2].zip([3, 4]) { |tuple| tuples << tuple }
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+			Expect(vm.MustGet("tuples").(*Array).Members()).To(HaveLen(2))
+		})
+
+		It("can be flattened back into a single array", func() {
+			value, err := vm.Run("[1, 2].zip([3, 4]).flatten")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(3, vm), NewFixnum(2, vm), NewFixnum(4, vm)}))
+		})
+	})
+
+	Describe("#min and #max", func() {
+		It("return the smallest and largest members", func() {
+			value, err := vm.Run("[3, 1, 2].min")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+
+			value, err = vm.Run("[3, 1, 2].max")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(3, vm)))
+		})
+
+		It("return nil for an empty array", func() {
+			value, err := vm.Run("[].min")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+
+			value, err = vm.Run("[].max")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("raise an ArgumentError when the members cannot be compared", func() {
+			_, err := vm.Run("[1, 'a'].max")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: comparison of String with Fixnum failed"))
+		})
+	})
+
+	Describe("#min_by and #max_by", func() {
+		It("compare the members by the result of the block", func() {
+			value, err := vm.Run("[3, 1, 2].min_by { |x| -x }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(3, vm)))
+
+			value, err = vm.Run("[3, 1, 2].max_by { |x| -x }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+		})
+	})
+
+	Describe("#sum", func() {
+		It("adds up the members", func() {
+			value, err := vm.Run("[1, 2, 3].sum")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(6, vm)))
+		})
+
+		It("takes an optional initial value", func() {
+			value, err := vm.Run("[1, 2, 3].sum(10)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(16, vm)))
+
+			value, err = vm.Run("[].sum(10)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(10, vm)))
+		})
+
+		It("returns 0 for an empty array", func() {
+			value, err := vm.Run("[].sum")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(0, vm)))
+		})
+	})
+
+	Describe("#take and #drop", func() {
+		It("return the first n members, or all but the first n", func() {
+			value, err := vm.Run("[1, 2, 3, 4].take(2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm)}))
+
+			value, err = vm.Run("[1, 2, 3, 4].drop(2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(3, vm), NewFixnum(4, vm)}))
+		})
+
+		It("clamp counts larger than the array", func() {
+			value, err := vm.Run("[1, 2].take(5)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(HaveLen(2))
+
+			value, err = vm.Run("[1, 2].drop(5)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(BeEmpty())
+		})
+
+		It("raise an ArgumentError for negative counts", func() {
+			_, err := vm.Run("[1, 2].take(-1)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: attempt to take negative size"))
+
+			_, err = vm.Run("[1, 2].drop(-1)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: attempt to drop negative size"))
+		})
+
+		It("raise an ArgumentError without a count", func() {
+			_, err := vm.Run("[1, 2].take")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (0 for 1)"))
+
+			_, err = vm.Run("[1, 2].drop")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (0 for 1)"))
+		})
+	})
+
+	Describe("#first", func() {
+		It("returns the first member, or the first n members", func() {
+			value, err := vm.Run("[1, 2, 3].first")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+
+			value, err = vm.Run("[1, 2, 3].first(2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm)}))
+
+			value, err = vm.Run("[].first")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+	})
+
+	Describe("#take_while and #drop_while", func() {
+		It("split the array at the first member the block is falsy for", func() {
+			value, err := vm.Run("[1, 2, 3, 1].take_while { |x| x < 3 }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm)}))
+
+			value, err = vm.Run("[1, 2, 3, 1].drop_while { |x| x < 3 }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(3, vm), NewFixnum(1, vm)}))
+		})
+	})
+
+	Describe("#each_slice and #each_cons", func() {
+		It("yield consecutive slices of the given size", func() {
+			value, err := vm.Run("slices = []; [1, 2, 3, 4, 5].each_slice(2) { |slice| slices << slice }; slices")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[[1, 2], [3, 4], [5]]"))
+		})
+
+		It("yield each window of the given size", func() {
+			value, err := vm.Run("windows = []; [1, 2, 3, 4].each_cons(3) { |window| windows << window }; windows")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[[1, 2, 3], [2, 3, 4]]"))
+		})
+
+		It("raise an ArgumentError for a size of zero", func() {
+			_, err := vm.Run("[1].each_slice(0) { |slice| slice }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: invalid slice size"))
+		})
+
+		It("raise an ArgumentError without a size", func() {
+			_, err := vm.Run("[1].each_slice { |slice| slice }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (0 for 1)"))
+
+			_, err = vm.Run("[1].each_cons { |window| window }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (0 for 1)"))
+		})
+	})
+
+	Describe("#*", func() {
+		It("repeats the array", func() {
+			value, err := vm.Run("[1, 2] * 3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[1, 2, 1, 2, 1, 2]"))
+		})
+
+		It("joins the array when given a string", func() {
+			value, err := vm.Run(`[1, 2] * ","`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal("1,2"))
+		})
+
+		It("raises an ArgumentError for a negative count", func() {
+			_, err := vm.Run("[1, 2] * -1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: negative argument"))
+		})
+	})
+
+	Describe("#sort", func() {
+		It("orders the members with <=>", func() {
+			value, err := vm.Run("[3, 1, 2].sort")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[1, 2, 3]"))
+		})
+
+		It("orders the members by the result of the block given", func() {
+			value, err := vm.Run("a = [3, 1, 2]; b = a.sort { |x, y| y <=> x }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[3, 2, 1]"))
+			Expect(vm.MustGet("a").PrettyPrint()).To(Equal("[3, 1, 2]"))
+		})
+
+		It("raises an ArgumentError when the block does not return an integer", func() {
+			_, err := vm.Run("[3, 1, 2].sort { |x, y| nil }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: comparison of Fixnum with Fixnum failed"))
+		})
+	})
+
+	Describe("#sort_by", func() {
+		It("orders the members by the key the block returns", func() {
+			value, err := vm.Run("[[1, 2, 3], [1], [1, 2]].sort_by { |a| a.sum }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[[1], [1, 2], [1, 2, 3]]"))
+		})
+	})
 })
@@ -23,16 +23,20 @@ func interpretAssignmentInContext(
 	switch assignment.LHS.(type) {
 	case ast.BareReference:
 		ref := assignment.LHS.(ast.BareReference)
-		vm.ObjectSpace[ref.Name] = returnValue
+		vm.Set(ref.Name, returnValue)
 	case ast.GlobalVariable:
 		globalVar := assignment.LHS.(ast.GlobalVariable)
-		vm.CurrentGlobals[globalVar.Name] = returnValue
+		vm.SetGlobal(globalVar.Name, returnValue)
 	case ast.InstanceVariable:
+		if context.IsFrozen() {
+			return nil, NewFrozenError(context, vm)
+		}
+
 		ivar := assignment.LHS.(ast.InstanceVariable)
 		context.SetInstanceVariable(ivar.Name, returnValue)
 	case ast.ClassVariable:
 		classVar := assignment.LHS.(ast.ClassVariable)
-		context.SetClassVariable(classVar.Name, returnValue)
+		AssignClassVariable(ClassVariableScope(context), classVar.Name, returnValue)
 	case ast.Constant:
 		var target Module
 		if vm.currentModuleName == "" {
@@ -48,7 +52,19 @@ func interpretAssignmentInContext(
 			}
 		}
 
-		target.SetConstant(assignment.LHS.(ast.Constant).Name, returnValue)
+		constant := assignment.LHS.(ast.Constant)
+		if class, ok := returnValue.(*UserDefinedClass); ok {
+			class.SetName(constant.Name)
+		}
+
+		if err := vm.checkCanReassignConstant(target, constant.Name); err != nil {
+			return nil, err
+		}
+		err := vm.warnOfConstantReassignment(target, constant.Name, constant.LineNumber())
+		if err != nil {
+			return nil, err
+		}
+		target.SetConstant(constant.Name, returnValue)
 	case ast.Class:
 		asClass := assignment.LHS.(ast.Class)
 		if asClass.Namespace != "" {
@@ -67,6 +83,13 @@ func interpretAssignmentInContext(
 				}
 			}
 
+			if err := vm.checkCanReassignConstant(target.(Module), asClass.Name); err != nil {
+				return nil, err
+			}
+			err := vm.warnOfConstantReassignment(target.(Module), asClass.Name, asClass.LineNumber())
+			if err != nil {
+				return nil, err
+			}
 			target.(Module).SetConstant(asClass.Name, returnValue)
 		}
 	default:
@@ -90,18 +113,18 @@ func interpretConditionalAssignmentInContext(
 	switch conditionalAssignment.LHS.(type) {
 	case ast.BareReference:
 		ref := conditionalAssignment.LHS.(ast.BareReference)
-		if vm.ObjectSpace[ref.Name] != nil && vm.ObjectSpace[ref.Name].IsTruthy() {
-			return vm.ObjectSpace[ref.Name], nil
+		if value, ok := vm.objectSpaceValue(ref.Name); ok && value.IsTruthy() {
+			return value, nil
 		}
 
-		vm.ObjectSpace[ref.Name] = returnValue
+		vm.Set(ref.Name, returnValue)
 	case ast.GlobalVariable:
 		globalVar := conditionalAssignment.LHS.(ast.GlobalVariable)
-		if vm.CurrentGlobals[globalVar.Name] != nil && vm.CurrentGlobals[globalVar.Name].IsTruthy() {
-			return vm.CurrentGlobals[globalVar.Name], nil
+		if value, ok := vm.global(globalVar.Name); ok && value.IsTruthy() {
+			return value, nil
 		}
 
-		vm.CurrentGlobals[globalVar.Name] = returnValue
+		vm.SetGlobal(globalVar.Name, returnValue)
 	case ast.InstanceVariable:
 		ivar := conditionalAssignment.LHS.(ast.InstanceVariable)
 		existingIvar := context.GetInstanceVariable(ivar.Name)
@@ -109,15 +132,20 @@ func interpretConditionalAssignmentInContext(
 			return existingIvar, nil
 		}
 
+		if context.IsFrozen() {
+			return nil, NewFrozenError(context, vm)
+		}
+
 		context.SetInstanceVariable(ivar.Name, returnValue)
 	case ast.ClassVariable:
 		classVar := conditionalAssignment.LHS.(ast.ClassVariable)
-		existingClassVar := context.GetClassVariable(classVar.Name)
+		scope := ClassVariableScope(context)
+		existingClassVar := LookupClassVariable(scope, classVar.Name)
 		if existingClassVar != nil && existingClassVar.IsTruthy() {
 			return existingClassVar, nil
 		}
 
-		context.SetClassVariable(classVar.Name, returnValue)
+		AssignClassVariable(scope, classVar.Name, returnValue)
 	case ast.Constant:
 		var target Module
 		if vm.currentModuleName == "" {
@@ -138,6 +166,9 @@ func interpretConditionalAssignmentInContext(
 		if err == nil && constant.IsTruthy() {
 			return constant, nil
 		}
+		if err := vm.checkCanReassignConstant(target, name); err != nil {
+			return nil, err
+		}
 
 		target.SetConstant(name, returnValue)
 	case ast.Class:
@@ -162,6 +193,9 @@ func interpretConditionalAssignmentInContext(
 			if err == nil && constant.IsTruthy() {
 				return constant, nil
 			}
+			if err := vm.checkCanReassignConstant(target.(Module), asClass.Name); err != nil {
+				return nil, err
+			}
 			target.(Module).SetConstant(asClass.Name, returnValue)
 		}
 	default:
@@ -170,3 +204,38 @@ func interpretConditionalAssignmentInContext(
 
 	return returnValue, nil
 }
+
+// like ruby, reassigning a constant is allowed, but warned about
+func (vm *vm) warnOfConstantReassignment(target Module, name string, lineNumber int) error {
+	if _, err := target.Constant(name); err != nil {
+		return nil
+	}
+
+	if target != vm.CurrentClasses["Object"] {
+		name = target.String() + "::" + name
+	}
+
+	return vm.warn(lineNumber, fmt.Sprintf("already initialized constant %s", name))
+}
+
+// a sandboxed program may define constants of its own, but a frozen module
+// keeps those it has, which for Object include the builtin classes and modules
+func (vm *vm) checkCanReassignConstant(target Module, name string) error {
+	if !target.IsFrozen() {
+		return nil
+	}
+
+	_, err := target.Constant(name)
+	defined := err == nil
+	if target == vm.CurrentClasses["Object"] {
+		_, class := vm.class(name)
+		_, module := vm.module(name)
+		defined = defined || class || module
+	}
+
+	if defined {
+		return NewFrozenError(target, vm)
+	}
+
+	return nil
+}
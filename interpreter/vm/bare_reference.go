@@ -24,7 +24,7 @@ func interpretBareReferenceInContext(
 			return nil
 		}
 	}).OrSome(gomads.Maybe(func() interface{} {
-		m, ok := vm.ObjectSpace[name]
+		m, ok := vm.objectSpaceValue(name)
 		if ok {
 			return m
 		} else {
@@ -50,7 +50,7 @@ func interpretBareReferenceInContext(
 			return nil
 		}
 
-		value, err := maybeMethod.Execute(context, nil)
+		value, err := vm.invokeMethod(maybeMethod, context, nil, ref.LineNumber())
 		if err != nil {
 			returnErr = err
 			return nil
@@ -59,19 +59,14 @@ func interpretBareReferenceInContext(
 		}
 	}))
 
+	value, ok := maybe.Value().(Value)
 	if returnErr != nil {
 		return nil, returnErr
 	}
 
-	value, ok := maybe.Value().(Value)
 	if ok {
 		return value, nil
 	} else {
-		return nil, NewNameError(
-			name,
-			context.String(),
-			context.Class().String(),
-			vm.stack.String(),
-		)
+		return nil, NewNameError(name, context, vm)
 	}
 }
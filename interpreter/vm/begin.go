@@ -11,34 +11,67 @@ func interpretBeginInContext(
 	begin ast.Begin,
 	context Value,
 ) (Value, error) {
-	_, err := vm.executeWithContext(context, begin.Body...)
+	// the value of a begin is that of whichever of the body, the matching
+	// rescue clause or the else clause ran last, never that of the ensure
+	value, err := vm.executeWithContext(context, begin.Body...)
 
 	if err != nil {
-		matchingRescue := false
-
+		// errors that are not ruby values, like a throw on its way to its catch,
+		// are never rescued, but still run the ensure clause
 		rubyErr, ok := err.(Value)
-		if !ok {
-			panic(context)
-			return nil, err
-		}
-
 		for _, rescue := range begin.Rescue {
-			if matchingRescue {
+			if !ok {
 				break
 			}
 
 			r := rescue.(ast.Rescue)
-			for _, exceptionClass := range r.Exception.Classes {
-				if exceptionClass.Name == rubyErr.String() {
-					_, err = vm.executeWithContext(context, r.Body...)
-					if err == nil {
-						matchingRescue = true
-						break
-					}
-				}
+			if !vm.rescues(r, rubyErr) {
+				continue
+			}
+
+			switch exceptionVar := r.Exception.Var.(type) {
+			case ast.BareReference:
+				vm.Set(exceptionVar.Name, rubyErr)
+			case ast.InstanceVariable:
+				context.SetInstanceVariable(exceptionVar.Name, rubyErr)
 			}
+
+			value, err = vm.executeWithContext(context, r.Body...)
+			break
+		}
+	} else if len(begin.Else) > 0 {
+		// the else clause only runs when the body raised nothing, and what
+		// it raises is not rescued by the rescue clauses of the same begin
+		value, err = vm.executeWithContext(context, begin.Else...)
+	}
+
+	if _, exiting := err.(*ImmediateExit); len(begin.Ensure) > 0 && !exiting {
+		_, ensureErr := vm.executeWithContext(context, begin.Ensure...)
+		if ensureErr != nil {
+			return nil, ensureErr
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// rescues is whether the rescue clause handles the exception, which is an
+// instance of one of the classes it names (or of StandardError for a bare rescue)
+func (vm *vm) rescues(rescue ast.Rescue, exception Value) bool {
+	if len(rescue.Exception.Classes) == 0 {
+		return IsKindOf(exception, vm.CurrentClasses["StandardError"])
+	}
+
+	for _, exceptionClass := range rescue.Exception.Classes {
+		class := vm.ClassWithName(exceptionClass.FullName())
+		if class != nil && IsKindOf(exception, class) {
+			return true
 		}
 	}
 
-	return nil, err
+	return false
 }
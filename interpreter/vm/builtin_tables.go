@@ -0,0 +1,84 @@
+package vm
+
+import (
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// builtinTables is what registerBuiltinClassesAndModules created, kept so
+// that Reset can put the builtins back as they were rather than create
+// every class, module and method again
+type builtinTables struct {
+	classes    map[string]Class
+	modules    map[string]Module
+	symbols    map[string]Value
+	singletons map[string]Value
+
+	// put back whatever a program changed about the builtin classes,
+	// modules and singletons, like the methods it added
+	restores []func()
+}
+
+func (vm *vm) snapshotBuiltins() *builtinTables {
+	builtins := &builtinTables{
+		classes:    make(map[string]Class, len(vm.CurrentClasses)),
+		modules:    make(map[string]Module, len(vm.CurrentModules)),
+		symbols:    copyTable(vm.CurrentSymbols),
+		singletons: copyTable(vm.singletons),
+	}
+
+	seen := map[Value]bool{}
+	var snapshot func(value Value)
+	snapshot = func(value Value) {
+		if seen[value] {
+			return
+		}
+		seen[value] = true
+		builtins.restores = append(builtins.restores, Snapshot(value))
+
+		// eg: Errno::ENOENT is only a constant of its module
+		if module, ok := value.(Module); ok {
+			for _, constant := range module.ConstantsWithNames() {
+				if nested, ok := constant.(Module); ok {
+					snapshot(nested)
+				}
+			}
+		}
+	}
+
+	for name, class := range vm.CurrentClasses {
+		builtins.classes[name] = class
+		snapshot(class)
+	}
+	for name, module := range vm.CurrentModules {
+		builtins.modules[name] = module
+		snapshot(module)
+	}
+	for _, singleton := range vm.singletons {
+		snapshot(singleton)
+	}
+
+	return builtins
+}
+
+// restoreTables replaces the tables of the vm with copies of the builtin ones
+// the caller holds the tables lock
+func (builtins *builtinTables) restoreTables(vm *vm) {
+	vm.CurrentClasses = make(map[string]Class, len(builtins.classes))
+	for name, class := range builtins.classes {
+		vm.CurrentClasses[name] = class
+	}
+
+	vm.CurrentModules = make(map[string]Module, len(builtins.modules))
+	for name, module := range builtins.modules {
+		vm.CurrentModules[name] = module
+	}
+
+	vm.CurrentSymbols = copyTable(builtins.symbols)
+	vm.singletons = copyTable(builtins.singletons)
+}
+
+func (builtins *builtinTables) restoreValues() {
+	for _, restore := range builtins.restores {
+		restore()
+	}
+}
@@ -1,5 +1,5 @@
 package builtins
 
 func NewArgumentErrorClass(provider Provider) Class {
-	return NewGenericClass("ArgumentError", "Exception", provider)
+	return NewGenericClass("ArgumentError", "StandardError", provider)
 }
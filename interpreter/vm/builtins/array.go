@@ -3,13 +3,13 @@ package builtins
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 )
 
 type ArrayClass struct {
 	valueStub
 	classStub
-	instanceMethods []Method
 
 	singletonProvider SingletonProvider
 }
@@ -23,6 +23,10 @@ func NewArrayClass(provider Provider) Class {
 
 	a.AddMethod(NewNativeMethod("shift", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		a := self.(*Array)
+		if a.IsFrozen() {
+			return nil, NewFrozenError(a, provider)
+		}
+
 		if len(a.members) == 0 {
 			return provider.SingletonProvider().SingletonWithName("nil"), nil
 		}
@@ -34,10 +38,34 @@ func NewArrayClass(provider Provider) Class {
 
 	a.AddMethod(NewNativeMethod("unshift", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		a := self.(*Array)
+		if a.IsFrozen() {
+			return nil, NewFrozenError(a, provider)
+		}
+
 		a.members = append([]Value{args[0]}, a.members[0:]...)
 		return a, nil
 	}))
 
+	a.AddMethod(NewNativeMethod("push", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		a := self.(*Array)
+		if a.IsFrozen() {
+			return nil, NewFrozenError(a, provider)
+		}
+
+		a.members = append(a.members, args...)
+		return a, nil
+	}))
+
+	a.AddMethod(NewNativeMethod("<<", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		a := self.(*Array)
+		if a.IsFrozen() {
+			return nil, NewFrozenError(a, provider)
+		}
+
+		a.members = append(a.members, args[0])
+		return a, nil
+	}))
+
 	a.AddMethod(NewNativeMethod("include?", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		a := self.(*Array)
 		for _, m := range a.members {
@@ -49,45 +77,100 @@ func NewArrayClass(provider Provider) Class {
 		return provider.SingletonProvider().SingletonWithName("false"), nil
 	}))
 
+	a.AddMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		other, ok := args[0].(*Array)
+		if !ok || len(other.members) != len(self.(*Array).members) {
+			return booleanValue(false, provider), nil
+		}
+
+		for index, member := range self.(*Array).members {
+			equal, err := valuesAreEqual(member, other.members[index], provider)
+			if err != nil {
+				return nil, err
+			}
+			if !equal {
+				return booleanValue(false, provider), nil
+			}
+		}
+
+		return booleanValue(true, provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("+", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		other, err := arrayOperand(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		members := append([]Value{}, self.(*Array).members...)
+		return newArrayOf(append(members, other.members...), provider), nil
+	}))
 	a.AddMethod(NewNativeMethod("-", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		a := self.(*Array)
-		argAsArray, ok := args[0].(*Array)
-		if !ok {
-			return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Array", args[0].Class().String()))
+		other, err := arrayOperand(args[0])
+		if err != nil {
+			return nil, err
 		}
 
-		selfAsArray := self.(*Array)
-		indicesToRemove := map[int]bool{}
-		for _, otherMember := range argAsArray.members {
-			for index, member := range selfAsArray.members {
-				equalMethod := member.Method("==")
-				if equalMethod == nil {
-					return nil, NewNoMethodError("==", member.String(), member.Class().String(), provider.StackProvider().CurrentStack())
-				}
-				equal, err := equalMethod.Execute(member, block, otherMember)
-				if err != nil {
-					return nil, err
-				}
+		members := []Value{}
+		for _, member := range self.(*Array).members {
+			excluded, err := containsEqualValue(other.members, member, provider)
+			if err != nil {
+				return nil, err
+			}
+			if !excluded {
+				members = append(members, member)
+			}
+		}
 
-				if equal.IsTruthy() {
-					indicesToRemove[index] = true
-				}
+		return newArrayOf(members, provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("&", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		other, err := arrayOperand(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		members := []Value{}
+		for _, member := range self.(*Array).members {
+			shared, err := containsEqualValue(other.members, member, provider)
+			if err != nil {
+				return nil, err
+			}
+			seen, err := containsEqualValue(members, member, provider)
+			if err != nil {
+				return nil, err
+			}
+			if shared && !seen {
+				members = append(members, member)
 			}
 		}
 
-		newMembers := []Value{}
-		for index, element := range a.members {
-			_, exists := indicesToRemove[index]
-			if !exists {
-				newMembers = append(newMembers, element)
+		return newArrayOf(members, provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("|", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		other, err := arrayOperand(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		members := []Value{}
+		for _, member := range append(append([]Value{}, self.(*Array).members...), other.members...) {
+			seen, err := containsEqualValue(members, member, provider)
+			if err != nil {
+				return nil, err
+			}
+			if !seen {
+				members = append(members, member)
 			}
 		}
 
-		a.members = newMembers
-		return self, nil
+		return newArrayOf(members, provider), nil
 	}))
 
 	a.AddMethod(NewNativeMethod("select", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "select", provider), nil
+		}
+
 		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
 		filteredArray := arr.(*Array)
 		selfAsArray := self.(*Array)
@@ -114,6 +197,8 @@ func NewArrayClass(provider Provider) Class {
 
 		if len(args) == 1 {
 			mapper = args[0].(*Proc)
+		} else if block == nil {
+			return NewEnumerator(self, "map", provider), nil
 		} else {
 			mapper = block
 		}
@@ -130,6 +215,10 @@ func NewArrayClass(provider Provider) Class {
 		return newArray, nil
 	}))
 	a.AddMethod(NewNativeMethod("each", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "each", provider), nil
+		}
+
 		selfAsArray := self.(*Array)
 		for _, element := range selfAsArray.members {
 			_, err := block.Call(element)
@@ -141,15 +230,25 @@ func NewArrayClass(provider Provider) Class {
 		return selfAsArray, nil
 	}))
 	a.AddMethod(NewNativeMethod("join", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		selfAsArray := self.(*Array)
-		separator := args[0].(*StringValue).value
-		pieces := make([]string, len(selfAsArray.members))
+		return NewString(joinMembers(self.(*Array), args[0].(*StringValue).value), provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("*", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if separator, ok := args[0].(*StringValue); ok {
+			return NewString(joinMembers(self.(*Array), separator.value), provider), nil
+		}
+
+		count, err := arrayCountArgument(args[0], "negative argument", provider)
+		if err != nil {
+			return nil, err
+		}
 
-		for index, element := range selfAsArray.members {
-			pieces[index] = element.String()
+		members := self.(*Array).members
+		repeated := make([]Value, 0, len(members)*int(count))
+		for i := int64(0); i < count; i++ {
+			repeated = append(repeated, members...)
 		}
 
-		return NewString(strings.Join(pieces, separator), provider), nil
+		return newArrayOf(repeated, provider), nil
 	}))
 	a.AddMethod(NewNativeMethod("any?", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		selfAsArray := self.(*Array)
@@ -166,18 +265,362 @@ func NewArrayClass(provider Provider) Class {
 
 		return provider.SingletonProvider().SingletonWithName("false"), nil
 	}))
+	a.AddMethod(NewNativeMethod("min", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return extremeMember(self.(*Array), -1, nil, provider)
+	}))
+	a.AddMethod(NewNativeMethod("max", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return extremeMember(self.(*Array), 1, nil, provider)
+	}))
+	a.AddMethod(NewNativeMethod("min_by", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "min_by", provider), nil
+		}
+
+		return extremeMember(self.(*Array), -1, block, provider)
+	}))
+	a.AddMethod(NewNativeMethod("max_by", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "max_by", provider), nil
+		}
+
+		return extremeMember(self.(*Array), 1, block, provider)
+	}))
+	a.AddMethod(NewNativeMethod("sort", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return sortedArray(self.(*Array).members, func(x, y Value) (int, error) {
+				return compareValues(x, y, provider)
+			}, provider)
+		}
+
+		return sortedArray(self.(*Array).members, func(x, y Value) (int, error) {
+			result, err := block.Call(x, y)
+			if err != nil {
+				return 0, err
+			}
+
+			comparison, ok := result.(*fixnumInstance)
+			if !ok {
+				return 0, arrayArgumentError(fmt.Sprintf("comparison of %s with %s failed", x.Class().String(), y.Class().String()), provider)
+			}
+
+			return int(comparison.value), nil
+		}, provider)
+	}))
+	a.AddMethod(NewNativeMethod("sort_by", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "sort_by", provider), nil
+		}
+
+		keys := map[Value]Value{}
+		for _, member := range self.(*Array).members {
+			key, err := block.Call(member)
+			if err != nil {
+				return nil, err
+			}
+			keys[member] = key
+		}
+
+		return sortedArray(self.(*Array).members, func(x, y Value) (int, error) {
+			return compareValues(keys[x], keys[y], provider)
+		}, provider)
+	}))
+	a.AddMethod(NewNativeMethod("sum", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		var sum Value = NewFixnum(0, provider)
+		if len(args) > 0 {
+			sum = args[0]
+		}
+
+		for _, member := range self.(*Array).members {
+			if block != nil {
+				var err error
+				member, err = block.Call(member)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			plus := sum.Method("+")
+			if plus == nil {
+				return nil, NewNoMethodError("+", sum, provider)
+			}
+
+			var err error
+			sum, err = plus.Execute(sum, nil, member)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return sum, nil
+	}))
+	a.AddMethod(NewNativeMethod("first", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		members := self.(*Array).members
+		if len(args) == 0 {
+			if len(members) == 0 {
+				return provider.SingletonProvider().SingletonWithName("nil"), nil
+			}
+			return members[0], nil
+		}
+
+		count, err := arrayCountArgument(args[0], "negative array size", provider)
+		if err != nil {
+			return nil, err
+		}
+		return newArrayOf(members[:clampCount(count, len(members))], provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("take", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, arrayArgumentError(fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)), provider)
+		}
+
+		members := self.(*Array).members
+		count, err := arrayCountArgument(args[0], "attempt to take negative size", provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return newArrayOf(members[:clampCount(count, len(members))], provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("drop", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, arrayArgumentError(fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)), provider)
+		}
+
+		members := self.(*Array).members
+		count, err := arrayCountArgument(args[0], "attempt to drop negative size", provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return newArrayOf(members[clampCount(count, len(members)):], provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("take_while", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "take_while", provider), nil
+		}
+
+		members := self.(*Array).members
+		index, err := indexWhileTruthy(members, block)
+		if err != nil {
+			return nil, err
+		}
+
+		return newArrayOf(members[:index], provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("drop_while", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "drop_while", provider), nil
+		}
+
+		members := self.(*Array).members
+		index, err := indexWhileTruthy(members, block)
+		if err != nil {
+			return nil, err
+		}
+
+		return newArrayOf(members[index:], provider), nil
+	}))
+	a.AddMethod(NewNativeMethod("each_slice", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, arrayArgumentError(fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)), provider)
+		}
+
+		members := self.(*Array).members
+		size, err := arrayCountArgument(args[0], "invalid slice size", provider)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return nil, arrayArgumentError("invalid slice size", provider)
+		}
+		if block == nil {
+			return NewEnumerator(self, "each_slice", provider, args...), nil
+		}
+
+		slices := []Value{}
+		for start := 0; start < len(members); start += int(size) {
+			end := clampCount(int64(start)+size, len(members))
+			slices = append(slices, newArrayOf(members[start:end], provider))
+		}
+
+		return yieldEach(self, slices, block)
+	}))
+	a.AddMethod(NewNativeMethod("each_cons", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, arrayArgumentError(fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)), provider)
+		}
+
+		members := self.(*Array).members
+		size, err := arrayCountArgument(args[0], "invalid size", provider)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return nil, arrayArgumentError("invalid size", provider)
+		}
+		if block == nil {
+			return NewEnumerator(self, "each_cons", provider, args...), nil
+		}
+
+		windows := []Value{}
+		for start := 0; int64(start)+size <= int64(len(members)); start++ {
+			windows = append(windows, newArrayOf(members[start:start+int(size)], provider))
+		}
+
+		return yieldEach(self, windows, block)
+	}))
+	a.AddMethod(NewNativeMethod("zip", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		others := []*Array{}
+		for _, arg := range args {
+			other, ok := arg.(*Array)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: wrong argument type %s (must respond to :each)", arg.Class().String()))
+			}
+			others = append(others, other)
+		}
+
+		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		zipped := arr.(*Array)
+		nilValue := provider.SingletonProvider().SingletonWithName("nil")
+
+		for index, member := range self.(*Array).members {
+			tuple, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+			tuple.(*Array).members = append(tuple.(*Array).members, member)
+			for _, other := range others {
+				if index < len(other.members) {
+					tuple.(*Array).members = append(tuple.(*Array).members, other.members[index])
+				} else {
+					tuple.(*Array).members = append(tuple.(*Array).members, nilValue)
+				}
+			}
+
+			if block != nil {
+				_, err := block.Call(tuple)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				zipped.members = append(zipped.members, tuple)
+			}
+		}
+
+		if block != nil {
+			return nilValue, nil
+		}
+
+		return zipped, nil
+	}))
+	a.AddMethod(NewNativeMethod("flatten", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		depth := int64(-1)
+		if len(args) > 0 {
+			arg, ok := args[0].(*fixnumInstance)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", args[0].Class().String()))
+			}
+			depth = arg.value
+		}
+
+		members, err := flatten(self.(*Array), depth, map[*Array]bool{}, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		arr.(*Array).members = members
+		return arr, nil
+	}))
+	a.AddMethod(NewNativeMethod("uniq", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		unique := arr.(*Array)
+
+		seen := map[uint64][]Value{}
+		for _, member := range self.(*Array).members {
+			key := member
+			if block != nil {
+				var err error
+				key, err = block.Call(member)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			hash, err := hashOf(key)
+			if err != nil {
+				return nil, err
+			}
+
+			duplicate := false
+			for _, other := range seen[hash] {
+				duplicate, err = keysAreEql(key, other)
+				if err != nil {
+					return nil, err
+				}
+				if duplicate {
+					break
+				}
+			}
+
+			if !duplicate {
+				seen[hash] = append(seen[hash], key)
+				unique.members = append(unique.members, member)
+			}
+		}
+
+		return unique, nil
+	}))
+	a.AddMethod(NewNativeMethod("compact", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		nilValue := provider.SingletonProvider().SingletonWithName("nil")
+
+		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		compacted := arr.(*Array)
+		for _, member := range self.(*Array).members {
+			if member != nilValue {
+				compacted.members = append(compacted.members, member)
+			}
+		}
+
+		return compacted, nil
+	}))
 
 	return a
 }
 
-func (klass *ArrayClass) AddInstanceMethod(m Method) {
-	klass.instanceMethods = append(klass.instanceMethods, m)
+// flatten returns the members of array with any nested arrays expanded in
+// place, up to depth levels deep (or all of them, for a negative depth)
+func flatten(array *Array, depth int64, seen map[*Array]bool, provider Provider) ([]Value, error) {
+	if seen[array] {
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			"tried to flatten recursive array",
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+	seen[array] = true
+	defer delete(seen, array)
+
+	members := []Value{}
+	for _, member := range array.members {
+		nested, ok := member.(*Array)
+		if !ok || depth == 0 {
+			members = append(members, member)
+			continue
+		}
+
+		flattened, err := flatten(nested, depth-1, seen, provider)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, flattened...)
+	}
+
+	return members, nil
 }
 
 func (klass *ArrayClass) New(provider Provider, args ...Value) (Value, error) {
 	a := &Array{}
 	a.initialize()
 	a.setStringer(a.String)
+	a.setPrettyPrinter(a.PrettyPrint)
 	a.class = klass
 
 	return a, nil
@@ -194,6 +637,20 @@ func (array *ArrayClass) String() string {
 type Array struct {
 	valueStub
 	members []Value
+
+	// set while the array is being hashed or compared, so that an array
+	// containing itself does not recurse forever
+	hashing   bool
+	comparing bool
+}
+
+// newArrayOf returns a new ruby array holding a copy of the given members
+func newArrayOf(members []Value, provider Provider) *Array {
+	arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+	array := arr.(*Array)
+	array.members = append(array.members, members...)
+
+	return array
 }
 
 func (array *Array) Append(v Value) {
@@ -204,6 +661,187 @@ func (array *Array) Members() []Value {
 	return array.members
 }
 
+func (array *Array) Dup() Value {
+	dup := &Array{}
+	dup.initialize()
+	dup.setStringer(dup.String)
+	dup.setPrettyPrinter(dup.PrettyPrint)
+	dup.class = array.class
+	dup.members = append([]Value{}, array.members...)
+	dup.copyInstanceStateFrom(&array.valueStub)
+
+	return dup
+}
+
 func (array *Array) String() string {
 	return "Array"
 }
+
+func (array *Array) PrettyPrint() string {
+	if !array.beginInspecting() {
+		return "[...]"
+	}
+	defer array.endInspecting()
+
+	pieces := []string{}
+	for _, member := range array.members {
+		pieces = append(pieces, inspectOrPrettyPrint(member))
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(pieces, ", "))
+}
+
+// extremeMember finds the smallest (direction -1) or largest (direction 1)
+// member of an array, comparing the members themselves or, when a block is
+// given, the values the block returns for them. Empty arrays give nil.
+func extremeMember(array *Array, direction int, block Block, provider Provider) (Value, error) {
+	var extreme, extremeKey Value
+	for _, member := range array.members {
+		key := member
+		if block != nil {
+			var err error
+			key, err = block.Call(member)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if extreme == nil {
+			extreme, extremeKey = member, key
+			continue
+		}
+
+		comparison, err := compareValues(key, extremeKey, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		if comparison*direction > 0 {
+			extreme, extremeKey = member, key
+		}
+	}
+
+	if extreme == nil {
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}
+
+	return extreme, nil
+}
+
+// arrayCountArgument validates the count given to methods like take, drop and
+// each_slice, raising an ArgumentError with the given message when negative
+func arrayCountArgument(arg Value, negativeMessage string, provider Provider) (int64, error) {
+	count, ok := arg.(*fixnumInstance)
+	if !ok {
+		return 0, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", arg.Class().String()))
+	}
+	if count.value < 0 {
+		return 0, arrayArgumentError(negativeMessage, provider)
+	}
+
+	return count.value, nil
+}
+
+// counts larger than the array are treated as the whole array
+func clampCount(count int64, length int) int {
+	if count > int64(length) {
+		return length
+	}
+
+	return int(count)
+}
+
+// returns the index of the first member for which the block is falsy
+func indexWhileTruthy(members []Value, block Block) (int, error) {
+	for index, member := range members {
+		result, err := block.Call(member)
+		if err != nil {
+			return 0, err
+		}
+
+		if !result.IsTruthy() {
+			return index, nil
+		}
+	}
+
+	return len(members), nil
+}
+
+// yields each of the groups to the block and returns self
+func yieldEach(self Value, groups []Value, block Block) (Value, error) {
+	for _, group := range groups {
+		_, err := block.Call(group)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return self, nil
+}
+
+// the argument to the array operators, which must itself be an array
+func arrayOperand(arg Value) (*Array, error) {
+	array, ok := arg.(*Array)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Array", arg.Class().String()))
+	}
+
+	return array, nil
+}
+
+func containsEqualValue(members []Value, value Value, provider Provider) (bool, error) {
+	for _, member := range members {
+		equal, err := valuesAreEqual(member, value, provider)
+		if err != nil {
+			return false, err
+		}
+		if equal {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// sortedArray returns a new array of the members, ordered by compare
+// the first error from compare stops the sort and is returned instead
+func sortedArray(members []Value, compare func(x, y Value) (int, error), provider Provider) (Value, error) {
+	sorted := append([]Value{}, members...)
+
+	var sortErr error
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sortErr != nil {
+			return false
+		}
+
+		comparison, err := compare(sorted[i], sorted[j])
+		if err != nil {
+			sortErr = err
+			return false
+		}
+
+		return comparison < 0
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	return newArrayOf(sorted, provider), nil
+}
+
+func joinMembers(array *Array, separator string) string {
+	pieces := make([]string, len(array.members))
+	for index, element := range array.members {
+		pieces[index] = element.String()
+	}
+
+	return strings.Join(pieces, separator)
+}
+
+func arrayArgumentError(message string, provider Provider) error {
+	return NewException(
+		provider.ClassProvider().ClassWithName("ArgumentError"),
+		message,
+		provider.StackProvider().CurrentStack(),
+	)
+}
@@ -0,0 +1,281 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+type bignumClass struct {
+	valueStub
+	classStub
+}
+
+func NewBignumClass(provider Provider) Class {
+	class := &bignumClass{}
+	class.initialize()
+	class.setStringer(class.String)
+	class.class = provider.ClassProvider().ClassWithName("Class")
+	class.superClass = provider.ClassProvider().ClassWithName("Integer")
+
+	class.AddMethod(NewNativeMethod("even?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return booleanValue(self.(*BignumValue).value.Bit(0) == 0, provider), nil
+	}))
+
+	class.AddMethod(NewNativeMethod("%", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		divisor, ok := bigIntegerOf(args[0])
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: %s can't be coerced into Bignum", args[0].Class().String()))
+		}
+		if divisor.Sign() == 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ZeroDivisionError"),
+				"divided by 0",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		_, modulo := flooredDivision(self.(*BignumValue).value, divisor)
+		return NewInteger(modulo, provider), nil
+	}))
+
+	class.AddMethod(NewNativeMethod("gcd", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return integerDivisors("gcd", self, args, provider)
+	}))
+
+	class.AddMethod(NewNativeMethod("lcm", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return integerDivisors("lcm", self, args, provider)
+	}))
+
+	class.AddMethod(NewNativeMethod("digits", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return integerDigits(self, args, provider)
+	}))
+
+	class.AddMethod(NewNativeMethod("-@", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewInteger(new(big.Int).Neg(self.(*BignumValue).value), provider), nil
+	}))
+
+	return class
+}
+
+func (c *bignumClass) String() string {
+	return "Bignum"
+}
+
+func (c *bignumClass) Name() string {
+	return "Bignum"
+}
+
+func (c *bignumClass) New(provider Provider, args ...Value) (Value, error) {
+	return nil, errors.New("undefined method 'new' for Bignum:Class")
+}
+
+// BignumValue holds the integers that do not fit in a Fixnum
+// arithmetic on fixnums is promoted to a bignum as soon as it overflows,
+// and results small enough are demoted again, so that a bignum is never
+// exactly equal to an int64
+type BignumValue struct {
+	value *big.Int
+	valueStub
+}
+
+func (b *BignumValue) Value() *big.Int {
+	return new(big.Int).Set(b.value)
+}
+
+func (b *BignumValue) String() string {
+	return b.value.String()
+}
+
+// NewInteger returns value as a Fixnum when it fits in one, and a Bignum otherwise
+func NewInteger(value *big.Int, provider Provider) Value {
+	if value.IsInt64() {
+		return NewFixnum(value.Int64(), provider)
+	}
+
+	b := &BignumValue{value: value}
+	b.class = provider.ClassProvider().ClassWithName("Bignum")
+	b.initialize()
+	b.setStringer(b.String)
+	b.Freeze()
+	return b
+}
+
+func bigIntegerOf(value Value) (*big.Int, bool) {
+	switch value := value.(type) {
+	case *fixnumInstance:
+		return big.NewInt(value.value), true
+	case *BignumValue:
+		return value.value, true
+	default:
+		return nil, false
+	}
+}
+
+func isInteger(value Value) bool {
+	_, ok := bigIntegerOf(value)
+	return ok
+}
+
+// the quotient and modulo of x and y, rounded towards negative infinity as in ruby
+func flooredDivision(x, y *big.Int) (*big.Int, *big.Int) {
+	quotient, remainder := new(big.Int).QuoRem(x, y, new(big.Int))
+	if remainder.Sign() != 0 && remainder.Sign() != y.Sign() {
+		quotient.Sub(quotient, big.NewInt(1))
+		remainder.Add(remainder, y)
+	}
+
+	return quotient, remainder
+}
+
+// integerDivisors is gcd and lcm for both fixnums and bignums, which are
+// always positive and worked out with math/big so that lcm cannot overflow
+func integerDivisors(name string, self Value, args []Value, provider Provider) (Value, error) {
+	if len(args) != 1 {
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	other, ok := bigIntegerOf(args[0])
+	if !ok {
+		return nil, NewTypeError("not an integer", provider)
+	}
+
+	x, _ := bigIntegerOf(self)
+	x = new(big.Int).Abs(x)
+	y := new(big.Int).Abs(other)
+
+	gcd := new(big.Int).GCD(nil, nil, x, y)
+	if name == "gcd" {
+		return NewInteger(gcd, provider), nil
+	}
+	if gcd.Sign() == 0 {
+		return NewFixnum(0, provider), nil
+	}
+
+	return NewInteger(new(big.Int).Mul(new(big.Int).Quo(x, gcd), y), provider), nil
+}
+
+// integerDigits is digits for both fixnums and bignums: the digits in the
+// given base (10 by default), least significant first
+func integerDigits(self Value, args []Value, provider Provider) (Value, error) {
+	base := big.NewInt(10)
+	if len(args) > 0 {
+		arg, ok := bigIntegerOf(args[0])
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: wrong argument type %s (expected Integer)", args[0].Class().String()))
+		}
+		base = arg
+	}
+
+	if base.Sign() < 0 {
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			"negative radix",
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+	if base.Cmp(big.NewInt(2)) < 0 {
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			fmt.Sprintf("invalid radix %s", base.String()),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	value, _ := bigIntegerOf(self)
+	if value.Sign() < 0 {
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("Math::DomainError"),
+			"out of domain",
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	digits, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+	value = new(big.Int).Set(value)
+	for {
+		digit := new(big.Int)
+		value.QuoRem(value, base, digit)
+		digits.(*Array).Append(NewInteger(digit, provider))
+		if value.Sign() == 0 {
+			break
+		}
+	}
+
+	return digits, nil
+}
+
+// integerArithmetic works on int64 values while the result fits,
+// and falls back to math/big when either operand or the result does not
+func integerArithmetic(name string, self, other Value, provider Provider) (Value, error) {
+	selfFixnum, selfIsFixnum := self.(*fixnumInstance)
+	otherFixnum, otherIsFixnum := other.(*fixnumInstance)
+	if selfIsFixnum && otherIsFixnum {
+		if result, ok := fixnumArithmetic(name, selfFixnum.value, otherFixnum.value); ok {
+			return NewFixnum(result, provider), nil
+		}
+	}
+
+	x, _ := bigIntegerOf(self)
+	y, _ := bigIntegerOf(other)
+
+	result := new(big.Int)
+	switch name {
+	case "+":
+		result.Add(x, y)
+	case "-":
+		result.Sub(x, y)
+	case "*":
+		result.Mul(x, y)
+	case "/":
+		if y.Sign() == 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ZeroDivisionError"),
+				"divided by 0",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+		result, _ = flooredDivision(x, y)
+	case "**":
+		result.Exp(x, y, nil)
+	}
+
+	return NewInteger(result, provider), nil
+}
+
+// ok is false when the result would overflow, or for division by zero
+func fixnumArithmetic(name string, x, y int64) (int64, bool) {
+	switch name {
+	case "+":
+		sum := x + y
+		return sum, (x^sum)&(y^sum) >= 0
+	case "-":
+		difference := x - y
+		return difference, (x^y)&(x^difference) >= 0
+	case "*":
+		if x == 0 || y == 0 {
+			return 0, true
+		}
+		if (x == -1 && y == math.MinInt64) || (y == -1 && x == math.MinInt64) {
+			return 0, false
+		}
+		product := x * y
+		return product, product/y == x
+	case "/":
+		if y == 0 || (x == math.MinInt64 && y == -1) {
+			return 0, false
+		}
+		quotient := x / y
+		if x%y != 0 && (x < 0) != (y < 0) {
+			quotient--
+		}
+		return quotient, true
+	default:
+		return 0, false
+	}
+}
@@ -26,6 +26,18 @@ type blockImpl struct {
 }
 
 func (b *blockImpl) Call(args ...Value) (Value, error) {
+	// like procs in ruby, a single array yielded to a block that takes
+	// several arguments is spread over them (eg: |key, value| for a pair),
+	// and yielded values without a matching argument are ignored
+	if len(args) == 1 && len(b.args) > 1 {
+		if array, ok := args[0].(*Array); ok {
+			args = array.members
+		}
+	}
+	if len(args) > len(b.args) {
+		args = args[:len(b.args)]
+	}
+
 	invocationArgs := make([]BlockArg, 0, len(args))
 	for index, providedArg := range args {
 		blockArg := BlockArg{
@@ -42,11 +54,31 @@ func (b *blockImpl) setContext(newContext Value) {
 	b.Context = newContext
 }
 
-func NewBlock(Context Value, args []ast.MethodParam, body []ast.Node, evaluator BlockEvaluator) Block {
-	return &blockImpl{
+func NewBlock(Context Value, args []ast.MethodParam, body []ast.Node, evaluator BlockEvaluator, provider Provider) Block {
+	block := &blockImpl{
 		Context:   Context,
 		args:      args,
 		body:      body,
 		evaluator: evaluator,
 	}
+	block.class = provider.ClassProvider().ClassWithName("Proc")
+	block.initialize()
+	block.setStringer(block.String)
+	return block
+}
+
+func (b *blockImpl) String() string {
+	return "#<Proc>"
 }
+
+// a block implemented in go, for builtins that need to pass a block
+// to another method (eg: Enumerable methods calling `each`)
+type nativeBlock struct {
+	body func(args ...Value) (Value, error)
+}
+
+func (b *nativeBlock) Call(args ...Value) (Value, error) {
+	return b.body(args...)
+}
+
+func (b *nativeBlock) setContext(newContext Value) {}
@@ -3,7 +3,6 @@ package builtins
 type trueClass struct {
 	valueStub
 	classStub
-	instanceMethods []Method
 }
 
 func NewTrueClass(provider Provider) Class {
@@ -15,10 +14,6 @@ func NewTrueClass(provider Provider) Class {
 	return o
 }
 
-func (t *trueClass) AddInstanceMethod(m Method) {
-	t.instanceMethods = append(t.instanceMethods, m)
-}
-
 func (t *trueClass) String() string {
 	return "True"
 }
@@ -40,6 +35,7 @@ func (obj *trueClass) New(provider Provider, args ...Value) (Value, error) {
 	o.initialize()
 	o.setStringer(o.String)
 	o.class = obj
+	o.Freeze()
 
 	return o, nil
 }
@@ -47,7 +43,6 @@ func (obj *trueClass) New(provider Provider, args ...Value) (Value, error) {
 type falseClass struct {
 	valueStub
 	classStub
-	instanceMethods []Method
 }
 
 func NewFalseClass(provider Provider) Class {
@@ -59,10 +54,6 @@ func NewFalseClass(provider Provider) Class {
 	return o
 }
 
-func (f *falseClass) AddInstanceMethod(m Method) {
-	f.instanceMethods = append(f.instanceMethods, m)
-}
-
 func (f *falseClass) String() string {
 	return "False"
 }
@@ -84,6 +75,7 @@ func (obj *falseClass) New(provider Provider, args ...Value) (Value, error) {
 	o.initialize()
 	o.setStringer(o.String)
 	o.class = obj
+	o.Freeze()
 
 	return o, nil
 }
@@ -91,3 +83,12 @@ func (obj *falseClass) New(provider Provider, args ...Value) (Value, error) {
 func (f *falseInstance) IsTruthy() bool {
 	return false
 }
+
+// booleanValue returns the ruby true or false singleton for a go bool
+func booleanValue(value bool, provider Provider) Value {
+	if value {
+		return provider.SingletonProvider().SingletonWithName("true")
+	}
+
+	return provider.SingletonProvider().SingletonWithName("false")
+}
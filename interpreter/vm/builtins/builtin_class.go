@@ -29,5 +29,9 @@ func (c *genericClass) Name() string {
 }
 
 func (c *genericClass) New(provider Provider, args ...Value) (Value, error) {
+	if isExceptionClass(c) {
+		return NewException(c, "", ""), nil
+	}
+
 	panic("unimplemented")
 }
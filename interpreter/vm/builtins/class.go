@@ -36,16 +36,22 @@ func NewClassClass(provider Provider) Class {
 	c.provider = provider
 
 	c.AddMethod(NewNativeMethod("new", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		instance, err := self.(Class).New(provider, args...)
-		if err != nil {
-			return nil, err
+		var instance Value
+		if userDefined, ok := self.(*UserDefinedClass); ok {
+			instance = userDefined.allocate(provider)
+		} else {
+			var err error
+			instance, err = self.(Class).New(provider, args...)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		method := instance.Method("initialize")
 		if method != nil {
-			_, err = method.Execute(instance, block, args...)
+			_, err := method.Execute(instance, block, args...)
 			if err != nil {
-				panic(err)
+				return nil, err
 			}
 		}
 
@@ -118,6 +124,10 @@ func NewUserDefinedClass(name, superclassName string, provider Provider) Class {
 	}
 
 	c.AddMethod(NewNativeMethod("include", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
 		for _, arg := range args {
 			c.Include(arg.(Module))
 		}
@@ -126,6 +136,10 @@ func NewUserDefinedClass(name, superclassName string, provider Provider) Class {
 	}))
 
 	c.AddMethod(NewNativeMethod("extend", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
 		for _, module := range args {
 			for _, method := range module.(Module).InstanceMethods() {
 				self.AddMethod(method)
@@ -137,6 +151,10 @@ func NewUserDefinedClass(name, superclassName string, provider Provider) Class {
 
 	//FIXME : these should be on module
 	c.AddMethod(NewNativeMethod("attr_accessor", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
 		for _, arg := range args {
 			symbol, ok := arg.(*SymbolValue)
 			if !ok {
@@ -160,6 +178,10 @@ func NewUserDefinedClass(name, superclassName string, provider Provider) Class {
 		return nil, nil
 	}))
 	c.AddMethod(NewNativeMethod("attr_reader", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
 		for _, arg := range args {
 			symbol, ok := arg.(*SymbolValue)
 			if !ok {
@@ -179,6 +201,10 @@ func NewUserDefinedClass(name, superclassName string, provider Provider) Class {
 		return nil, nil
 	}))
 	c.AddMethod(NewNativeMethod("attr_writer", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
 		for _, arg := range args {
 			symbol, ok := arg.(*SymbolValue)
 			if !ok {
@@ -198,22 +224,7 @@ func NewUserDefinedClass(name, superclassName string, provider Provider) Class {
 }
 
 func (c *UserDefinedClass) New(provider Provider, args ...Value) (Value, error) {
-	instance := &UserDefinedClassInstance{}
-	instance.initialize()
-	instance.setStringer(instance.String)
-	instance.provider = provider
-	instance.attrs = make(map[string]Value)
-	instance.class = c
-
-	for _, m := range c.instanceMethods {
-		instance.AddMethod(m)
-	}
-
-	for _, module := range c.includedModules() {
-		for _, method := range module.(Module).InstanceMethods() {
-			instance.AddMethod(method)
-		}
-	}
+	instance := c.allocate(provider)
 
 	method := instance.Method("initialize")
 	if method != nil {
@@ -226,10 +237,48 @@ func (c *UserDefinedClass) New(provider Provider, args ...Value) (Value, error)
 	return instance, nil
 }
 
+// allocate creates an instance without initializing it, which is left to
+// New or to Class#new (which also passes its block along to initialize)
+func (c *UserDefinedClass) allocate(provider Provider) Value {
+	if isExceptionClass(c) {
+		return NewException(c, "", "")
+	}
+
+	instance := &UserDefinedClassInstance{}
+	instance.initialize()
+	instance.setStringer(instance.String)
+	instance.provider = provider
+	instance.attrs = make(map[string]Value)
+	instance.class = c
+	provider.ObjectSpaceProvider().Track(instance)
+
+	return instance
+}
+
+func (i *UserDefinedClassInstance) Dup() Value {
+	dup := &UserDefinedClassInstance{}
+	dup.initialize()
+	dup.setStringer(dup.String)
+	dup.provider = i.provider
+	dup.attrs = make(map[string]Value)
+	dup.class = i.class
+	dup.copyInstanceStateFrom(&i.valueStub)
+	i.provider.ObjectSpaceProvider().Track(dup)
+
+	return dup
+}
+
 func (c UserDefinedClass) Name() string {
 	return c.name
 }
 
+// anonymous classes take the name of the first constant they are assigned to
+func (c *UserDefinedClass) SetName(name string) {
+	if c.name == "" {
+		c.name = name
+	}
+}
+
 func (c UserDefinedClass) String() string {
 	return c.name
 }
@@ -237,3 +286,23 @@ func (c UserDefinedClass) String() string {
 func (c *UserDefinedClass) Class() Class {
 	return c.class
 }
+
+// class methods (def self.foo) are looked up on the class itself first,
+// then on each user defined superclass, so that subclasses inherit them
+// even when they are defined after the subclass was declared
+func (c *UserDefinedClass) Method(name string) Method {
+	if method, ok := c.eigenclass_methods[name]; ok {
+		return method
+	}
+
+	super, ok := c.superClass.(*UserDefinedClass)
+	for ok {
+		if method, found := super.eigenclass_methods[name]; found {
+			return method
+		}
+
+		super, ok = super.superClass.(*UserDefinedClass)
+	}
+
+	return c.valueStub.Method(name)
+}
@@ -14,6 +14,7 @@ func (classStub *classStub) SuperClass() Class {
 
 func (classStub *classStub) Include(module Module) {
 	classStub._included_modules = append(classStub._included_modules, module)
+	methodCacheOf(classStub.superClass).changed()
 }
 
 func (classStub *classStub) includedModules() []Module {
@@ -35,3 +36,51 @@ func (classStub *classStub) setClassVariable(name string, value Value) {
 
 	classStub._classVars[name] = value
 }
+
+// ClassVariableScope returns the class whose class variables are visible
+// from code running with the given value as self: a class body or class
+// method sees its own, and an instance method sees those of its class
+func ClassVariableScope(value Value) Class {
+	if class, ok := value.(Class); ok {
+		return class
+	}
+
+	return value.Class()
+}
+
+// LookupClassVariable finds a class variable on the class or its ancestors,
+// which all share the same variable. It returns nil when there is none.
+func LookupClassVariable(class Class, name string) Value {
+	owner := classVariableOwner(class, name)
+	if owner == nil {
+		return nil
+	}
+
+	return owner.classVariable(name)
+}
+
+// AssignClassVariable sets the class variable on the ancestor that already
+// defines it, or on the class itself when it is new
+func AssignClassVariable(class Class, name string, value Value) {
+	owner := classVariableOwner(class, name)
+	if owner == nil {
+		owner = class
+	}
+
+	owner.setClassVariable(name, value)
+}
+
+func classVariableOwner(class Class, name string) Class {
+	for class != nil {
+		if class.classVariable(name) != nil {
+			return class
+		}
+
+		if class.String() == "BasicObject" {
+			break
+		}
+		class = class.SuperClass()
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package builtins
 
+import "fmt"
+
 func NewComparableModule(provider Provider) Module {
 	m := NewModule("Comparable", provider)
 	m.AddMethod(NewNativeMethod("<", provider, func(self Value, block Block, args ...Value) (Value, error) {
@@ -23,3 +25,28 @@ func NewComparableModule(provider Provider) Module {
 
 	return m
 }
+
+// compareValues calls a's <=> method with b, raising an ArgumentError when
+// the two values cannot be compared (eg: min and max of [1, "a"])
+func compareValues(a, b Value, provider Provider) (int, error) {
+	spaceship := a.Method("<=>")
+	if spaceship == nil {
+		return 0, NewNoMethodError("<=>", a, provider)
+	}
+
+	result, err := spaceship.Execute(a, nil, b)
+	if err != nil {
+		return 0, err
+	}
+
+	comparison, ok := result.(*fixnumInstance)
+	if !ok {
+		return 0, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			fmt.Sprintf("comparison of %s with %s failed", a.Class().String(), b.Class().String()),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	return int(comparison.value), nil
+}
@@ -0,0 +1,170 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strconv"
+)
+
+type complexClass struct {
+	valueStub
+	classStub
+}
+
+func NewComplexClass(provider Provider) Class {
+	class := &complexClass{}
+	class.initialize()
+	class.setStringer(class.String)
+	class.class = provider.ClassProvider().ClassWithName("Class")
+	class.superClass = provider.ClassProvider().ClassWithName("Numeric")
+
+	class.AddMethod(NewNativeMethod("real", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFloat(real(self.(*ComplexValue).value), provider), nil
+	}))
+	imaginary := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFloat(imag(self.(*ComplexValue).value), provider), nil
+	}
+	class.AddMethod(NewNativeMethod("imaginary", provider, imaginary))
+	class.AddMethod(NewNativeMethod("imag", provider, imaginary))
+
+	class.AddMethod(NewNativeMethod("abs", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFloat(cmplx.Abs(self.(*ComplexValue).value), provider), nil
+	}))
+	conjugate := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewComplex(cmplx.Conj(self.(*ComplexValue).value), provider), nil
+	}
+	class.AddMethod(NewNativeMethod("conjugate", provider, conjugate))
+	class.AddMethod(NewNativeMethod("conj", provider, conjugate))
+
+	class.AddMethod(NewNativeMethod("-@", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewComplex(-self.(*ComplexValue).value, provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		other, ok := complexOf(args[0])
+		return booleanValue(ok && other == self.(*ComplexValue).value, provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("to_s", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.String(), provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("inspect", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.PrettyPrint(), provider), nil
+	}))
+
+	return class
+}
+
+func (c *complexClass) String() string {
+	return "Complex"
+}
+
+func (c *complexClass) Name() string {
+	return "Complex"
+}
+
+func (c *complexClass) New(provider Provider, args ...Value) (Value, error) {
+	return nil, errors.New("undefined method 'new' for Complex:Class")
+}
+
+// ComplexValue wraps a complex128, so both of its parts are always floats
+// eg: Complex(1, 2) is (1.0+2.0i)
+type ComplexValue struct {
+	value complex128
+	valueStub
+}
+
+func (c *ComplexValue) Value() complex128 {
+	return c.value
+}
+
+func (c *ComplexValue) String() string {
+	imaginary := imag(c.value)
+	sign := "+"
+	if math.Signbit(imaginary) {
+		sign = "-"
+	}
+
+	return fmt.Sprintf("%s%s%si", floatString(real(c.value)), sign, floatString(math.Abs(imaginary)))
+}
+
+func NewComplex(value complex128, provider Provider) Value {
+	c := &ComplexValue{value: value}
+	c.class = provider.ClassProvider().ClassWithName("Complex")
+	c.initialize()
+	c.setStringer(c.String)
+	c.setPrettyPrinter(func() string { return fmt.Sprintf("(%s)", c.String()) })
+	c.Freeze()
+	return c
+}
+
+// any number as a complex, a real number has no imaginary part
+func complexOf(value Value) (complex128, bool) {
+	if c, ok := value.(*ComplexValue); ok {
+		return c.value, true
+	}
+
+	float, ok := numericValue(value)
+	return complex(float, 0), ok
+}
+
+func isComplex(value Value) bool {
+	_, ok := value.(*ComplexValue)
+	return ok
+}
+
+// complexArithmetic is used when either operand is a complex
+// the other is coerced to a complex when it is a real number
+func complexArithmetic(name string, self, other Value, provider Provider) (Value, error) {
+	x, selfOk := complexOf(self)
+	y, otherOk := complexOf(other)
+	if !selfOk || !otherOk {
+		return nil, errors.New(fmt.Sprintf("TypeError: %s can't be coerced into %s", other.Class().String(), self.Class().String()))
+	}
+
+	switch name {
+	case "+":
+		return NewComplex(x+y, provider), nil
+	case "-":
+		return NewComplex(x-y, provider), nil
+	case "*":
+		return NewComplex(x*y, provider), nil
+	case "/":
+		if y == 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ZeroDivisionError"),
+				"divided by 0",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+		return NewComplex(x/y, provider), nil
+	default:
+		return NewComplex(cmplx.Pow(x, y), provider), nil
+	}
+}
+
+// implements Kernel#Complex for a real and an imaginary part
+// a string is parsed in the same a+bi form as complex numbers are shown
+func convertToComplexPart(arg Value, provider Provider) (complex128, error) {
+	switch arg := arg.(type) {
+	case *StringValue:
+		value, err := strconv.ParseComplex(arg.RawString(), 128)
+		if err != nil {
+			return 0, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("invalid value for convert(): %s", arg.PrettyPrint()),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+		return value, nil
+	case *nilInstance:
+		return 0, errors.New("TypeError: can't convert nil into Complex")
+	}
+
+	value, ok := complexOf(arg)
+	if !ok {
+		return 0, errors.New(fmt.Sprintf("TypeError: can't convert %s into Complex", arg.Class().String()))
+	}
+
+	return value, nil
+}
@@ -0,0 +1,148 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// the strict conversion functions of Kernel: Integer(), Float(), String() and Array()
+// unlike the to_* methods, these raise instead of guessing at what was meant
+
+func convertToInteger(arg Value, base int, provider Provider) (Value, error) {
+	switch arg := arg.(type) {
+	case *fixnumInstance:
+		return arg, nil
+	case *FloatValue:
+		if math.IsNaN(arg.value) || math.IsInf(arg.value, 0) {
+			return nil, errors.New(fmt.Sprintf("FloatDomainError: %s", arg.String()))
+		}
+		return NewFixnum(int64(arg.value), provider), nil
+	case *StringValue:
+		// strconv only accepts underscores and radix prefixes when guessing the base
+		str := strings.TrimSpace(arg.RawString())
+		if base == 16 {
+			str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+		}
+
+		value, ok := parseInteger(str, base, provider)
+		if !ok {
+			return nil, conversionArgumentError("Integer", arg, provider)
+		}
+		return value, nil
+	case *nilInstance:
+		return nil, errors.New("TypeError: can't convert nil into Integer")
+	}
+
+	return sendConversion(arg, "to_i", "Integer", provider)
+}
+
+// parseInteger parses str as strconv does, and when it is too large for a
+// Fixnum, as a Bignum with math/big, which follows the same rules
+func parseInteger(str string, base int, provider Provider) (Value, bool) {
+	value, err := strconv.ParseInt(str, base, 64)
+	if err == nil {
+		return NewFixnum(value, provider), true
+	}
+
+	if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+		if bignum, ok := new(big.Int).SetString(str, base); ok {
+			return NewInteger(bignum, provider), true
+		}
+	}
+
+	return nil, false
+}
+
+func convertToFloat(arg Value, provider Provider) (Value, error) {
+	switch arg := arg.(type) {
+	case *FloatValue:
+		return arg, nil
+	case *fixnumInstance:
+		return NewFloat(float64(arg.value), provider), nil
+	case *StringValue:
+		str := strings.TrimSpace(arg.RawString())
+		value, err := strconv.ParseFloat(strings.Replace(str, "_", "", -1), 64)
+		if err != nil || strings.HasPrefix(str, "_") || strings.HasSuffix(str, "_") ||
+			strings.ContainsAny(str, "xXpP") || strings.Contains(str, "__") {
+			return nil, conversionArgumentError("Float", arg, provider)
+		}
+		return NewFloat(value, provider), nil
+	case *nilInstance:
+		return nil, errors.New("TypeError: can't convert nil into Float")
+	}
+
+	return sendConversion(arg, "to_f", "Float", provider)
+}
+
+func convertToString(arg Value, provider Provider) (Value, error) {
+	if str, ok := arg.(*StringValue); ok {
+		return str, nil
+	}
+
+	str, err := stringify(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewString(str, provider), nil
+}
+
+func convertToArray(arg Value, provider Provider) (Value, error) {
+	switch arg := arg.(type) {
+	case *Array:
+		return arg, nil
+	case *nilInstance:
+		return provider.ClassProvider().ClassWithName("Array").New(provider)
+	case *Hash:
+		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		for _, entry := range arg.entries {
+			pair, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+			pair.(*Array).Append(entry.key)
+			pair.(*Array).Append(entry.value)
+			arr.(*Array).Append(pair)
+		}
+		return arr, nil
+	}
+
+	if arg.Method("to_a") != nil {
+		return sendConversion(arg, "to_a", "Array", provider)
+	}
+
+	arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+	arr.(*Array).Append(arg)
+	return arr, nil
+}
+
+// converts values that are not builtin types by calling their conversion method
+func sendConversion(arg Value, methodName, className string, provider Provider) (Value, error) {
+	method := arg.Method(methodName)
+	if method == nil {
+		return nil, errors.New(fmt.Sprintf("TypeError: can't convert %s into %s", arg.Class().String(), className))
+	}
+
+	result, err := method.Execute(arg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Class() != provider.ClassProvider().ClassWithName(className) {
+		return nil, errors.New(fmt.Sprintf(
+			"TypeError: can't convert %s to %s (%s#%s gives %s)",
+			arg.Class().String(), className, arg.Class().String(), methodName, result.Class().String(),
+		))
+	}
+
+	return result, nil
+}
+
+func conversionArgumentError(className string, arg *StringValue, provider Provider) error {
+	return NewException(
+		provider.ClassProvider().ClassWithName("ArgumentError"),
+		fmt.Sprintf("invalid value for %s(): %s", className, arg.PrettyPrint()),
+		provider.StackProvider().CurrentStack(),
+	)
+}
@@ -10,20 +10,28 @@ type ArgEvaluator interface {
 
 type ClassProvider interface {
 	ClassWithName(string) Class
+	MethodCache() *MethodCache
 }
 
 type SingletonProvider interface {
 	SingletonWithName(string) Value
-	NewSingletonWithName(string, Value)
+	NewSingletonWithName(string, Value) Value
 
 	SymbolWithName(string) Value
-	AddSymbol(Value)
+	AddSymbol(Value) Value
 }
 
 type StackProvider interface {
 	CurrentStack() string
-	UnshiftStackFrame(string, string, int)
-	ShiftStackFrame()
+}
+
+type GlobalProvider interface {
+	SetGlobal(string, Value)
+}
+
+type ObjectSpaceProvider interface {
+	Track(*UserDefinedClassInstance)
+	Objects() []Value
 }
 
 type MethodProvider interface {
@@ -35,5 +43,7 @@ type Provider interface {
 	ClassProvider() ClassProvider
 	SingletonProvider() SingletonProvider
 	StackProvider() StackProvider
+	GlobalProvider() GlobalProvider
+	ObjectSpaceProvider() ObjectSpaceProvider
 	MethodProvider() MethodProvider
 }
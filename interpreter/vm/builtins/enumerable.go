@@ -0,0 +1,124 @@
+package builtins
+
+// NewEnumerableModule provides the methods shared by collections,
+// all of which are implemented in terms of the collection's `each` method
+func NewEnumerableModule(provider Provider) Module {
+	m := NewModule("Enumerable", provider)
+
+	m.AddMethod(NewNativeMethod("group_by", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "group_by", provider), nil
+		}
+
+		hash, _ := provider.ClassProvider().ClassWithName("Hash").New(provider)
+		groups := hash.(*Hash)
+
+		err := eachElement(self, provider, func(element Value) error {
+			key, err := block.Call(element)
+			if err != nil {
+				return err
+			}
+
+			group, ok, err := groups.Get(key)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				group, _ = provider.ClassProvider().ClassWithName("Array").New(provider)
+				if err := groups.Add(key, group); err != nil {
+					return err
+				}
+			}
+			group.(*Array).Append(element)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return groups, nil
+	}))
+
+	m.AddMethod(NewNativeMethod("partition", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "partition", provider), nil
+		}
+
+		matching, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		rest, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+
+		err := eachElement(self, provider, func(element Value) error {
+			result, err := block.Call(element)
+			if err != nil {
+				return err
+			}
+
+			if result.IsTruthy() {
+				matching.(*Array).Append(element)
+			} else {
+				rest.(*Array).Append(element)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		partitioned, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		partitioned.(*Array).Append(matching)
+		partitioned.(*Array).Append(rest)
+		return partitioned, nil
+	}))
+
+	toA := func(self Value, block Block, args ...Value) (Value, error) {
+		elements := []Value{}
+		err := eachElement(self, provider, func(element Value) error {
+			elements = append(elements, element)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return newArrayOf(elements, provider), nil
+	}
+	m.AddMethod(NewNativeMethod("to_a", provider, toA))
+	m.AddMethod(NewNativeMethod("entries", provider, toA))
+
+	m.AddMethod(NewNativeMethod("lazy", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewLazyEnumerator(self, provider), nil
+	}))
+
+	return m
+}
+
+// calls the `each` method of collection, handing every element it yields to
+// the given callback. Several values yielded at once (eg: by Hash#each) are
+// gathered into an array, as in [key, value].
+func eachElement(collection Value, provider Provider, callback func(Value) error) error {
+	each := collection.Method("each")
+	if each == nil {
+		return NewNoMethodError("each", collection, provider)
+	}
+
+	_, err := each.Execute(collection, &nativeBlock{body: func(args ...Value) (Value, error) {
+		return provider.SingletonProvider().SingletonWithName("nil"), callback(yieldedElement(args, provider))
+	}})
+
+	return err
+}
+
+func yieldedElement(args []Value, provider Provider) Value {
+	switch len(args) {
+	case 0:
+		return provider.SingletonProvider().SingletonWithName("nil")
+	case 1:
+		return args[0]
+	default:
+		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		for _, arg := range args {
+			arr.(*Array).Append(arg)
+		}
+		return arr
+	}
+}
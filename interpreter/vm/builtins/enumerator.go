@@ -0,0 +1,301 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+type enumeratorClass struct {
+	valueStub
+	classStub
+}
+
+func NewEnumeratorClass(provider Provider) Class {
+	c := &enumeratorClass{}
+	c.initialize()
+	c.setStringer(c.String)
+	c.class = provider.ClassProvider().ClassWithName("Class")
+	c.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	c.AddInstanceMethod(NewNativeMethod("each", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return self, nil
+		}
+
+		return self.(*Enumerator).each(block)
+	}))
+	c.AddInstanceMethod(NewNativeMethod("next", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.(*Enumerator).next()
+	}))
+	c.AddInstanceMethod(NewNativeMethod("peek", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.(*Enumerator).peek()
+	}))
+	c.AddInstanceMethod(NewNativeMethod("rewind", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		self.(*Enumerator).rewind()
+		return self, nil
+	}))
+
+	// yields each element along with its index, starting from offset
+	withIndex := func(offset int64, block Block) Block {
+		index := offset
+		return &nativeBlock{body: func(args ...Value) (Value, error) {
+			result, err := block.Call(yieldedElement(args, provider), NewFixnum(index, provider))
+			index++
+			return result, err
+		}}
+	}
+	c.AddInstanceMethod(NewNativeMethod("with_index", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		var offset int64
+		if len(args) > 0 {
+			fixnum, ok := args[0].(*fixnumInstance)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", args[0].Class().String()))
+			}
+			offset = fixnum.value
+		}
+
+		if block == nil {
+			return NewEnumerator(self, "with_index", provider, args...), nil
+		}
+
+		return self.(*Enumerator).each(withIndex(offset, block))
+	}))
+	c.AddInstanceMethod(NewNativeMethod("each_with_index", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "each_with_index", provider), nil
+		}
+
+		return self.(*Enumerator).each(withIndex(0, block))
+	}))
+	inspect := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.PrettyPrint(), provider), nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("inspect", provider, inspect))
+	c.AddInstanceMethod(NewNativeMethod("to_s", provider, inspect))
+
+	c.SetConstant("Lazy", newLazyEnumeratorClass(c, provider))
+
+	return c
+}
+
+func (c *enumeratorClass) String() string {
+	return "Enumerator"
+}
+
+func (c *enumeratorClass) Name() string {
+	return "Enumerator"
+}
+
+func (c *enumeratorClass) New(provider Provider, args ...Value) (Value, error) {
+	return nil, errors.New("NoMethodError: undefined method 'new' for Enumerator, call an iterator without a block instead")
+}
+
+// Enumerator is returned by iterators called without a block
+// it iterates by calling that method again on the same receiver, with
+// the block given to each, or one step at a time with next and peek
+type Enumerator struct {
+	valueStub
+
+	receiver   Value
+	methodName string
+	args       []Value
+
+	provider  Provider
+	iteration *externalIteration
+}
+
+func NewEnumerator(receiver Value, methodName string, provider Provider, args ...Value) Value {
+	e := &Enumerator{
+		receiver:   receiver,
+		methodName: methodName,
+		args:       args,
+		provider:   provider,
+	}
+	e.initialize()
+	e.setStringer(e.String)
+	e.setPrettyPrinter(e.PrettyPrint)
+	e.class = provider.ClassProvider().ClassWithName("Enumerator")
+
+	return e
+}
+
+func (e *Enumerator) each(block Block) (Value, error) {
+	method := e.receiver.Method(e.methodName)
+	if method == nil {
+		return nil, NewNoMethodError(e.methodName, e.receiver, e.provider)
+	}
+
+	return method.Execute(e.receiver, block, e.args...)
+}
+
+func (e *Enumerator) next() (Value, error) {
+	value, err := e.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	e.iteration.peeked = nil
+	return value, nil
+}
+
+func (e *Enumerator) peek() (Value, error) {
+	if e.iteration == nil {
+		e.iteration = e.startIteration()
+	}
+
+	return e.iteration.peek(e.provider)
+}
+
+func (e *Enumerator) rewind() {
+	if e.iteration != nil {
+		e.iteration.stop()
+		e.iteration = nil
+	}
+}
+
+// a native method is run in its own goroutine, suspended each time it yields
+// a method defined in ruby shares the stacks of the vm, so it cannot be
+// suspended and all of its elements are gathered up front instead
+// the goroutine does not refer to the enumerator, so that it can be collected
+// while suspended, and the iteration is then stopped by stopAbandonedIterations
+func (e *Enumerator) startIteration() *externalIteration {
+	stopAbandonedIterations(e.provider)
+
+	iteration := &externalIteration{provider: e.provider}
+
+	method := e.receiver.Method(e.methodName)
+	native, ok := method.(*nativeMethod)
+	if !ok {
+		elements := []Value{}
+		_, err := e.each(&nativeBlock{body: func(args ...Value) (Value, error) {
+			elements = append(elements, yieldedElement(args, e.provider))
+			return e.provider.SingletonProvider().SingletonWithName("nil"), nil
+		}})
+
+		iteration.buffered = elements
+		iteration.err = err
+		iteration.done = true
+		return iteration
+	}
+
+	iteration.yielded = make(chan Value)
+	iteration.resume = make(chan bool)
+	iteration.finished = make(chan error, 1)
+	receiver, args, provider := e.receiver, e.args, e.provider
+	go func() {
+		_, err := native.body(receiver, &nativeBlock{body: func(args ...Value) (Value, error) {
+			iteration.yielded <- yieldedElement(args, provider)
+			if !<-iteration.resume {
+				return nil, errIterationStopped
+			}
+
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}}, args...)
+		iteration.finished <- err
+	}()
+
+	runtime.AddCleanup(e, abandonIteration, iteration)
+	return iteration
+}
+
+// iterations whose enumerator was collected before they finished, by the vm
+// running them. they are stopped on that vm's goroutine rather than the one
+// running cleanups, as unwinding them can run methods defined in ruby
+var abandonedIterations = struct {
+	sync.Mutex
+	byProvider map[Provider][]*externalIteration
+}{byProvider: map[Provider][]*externalIteration{}}
+
+func abandonIteration(iteration *externalIteration) {
+	abandonedIterations.Lock()
+	defer abandonedIterations.Unlock()
+
+	abandonedIterations.byProvider[iteration.provider] = append(abandonedIterations.byProvider[iteration.provider], iteration)
+}
+
+func stopAbandonedIterations(provider Provider) {
+	abandonedIterations.Lock()
+	iterations := abandonedIterations.byProvider[provider]
+	delete(abandonedIterations.byProvider, provider)
+	abandonedIterations.Unlock()
+
+	for _, iteration := range iterations {
+		iteration.stop()
+	}
+}
+
+func (e *Enumerator) String() string {
+	return e.PrettyPrint()
+}
+
+func (e *Enumerator) PrettyPrint() string {
+	return fmt.Sprintf("#<Enumerator: %s:%s>", inspectOrPrettyPrint(e.receiver), e.methodName)
+}
+
+var errIterationStopped = errors.New("iteration stopped by Enumerator#rewind")
+
+// the state of an enumerator being iterated with next
+// only one of the enumerator and the goroutine running the iteration is
+// ever running, since each waits for the other on a channel
+type externalIteration struct {
+	provider Provider
+
+	yielded  chan Value
+	resume   chan bool
+	finished chan error
+	started  bool
+
+	buffered []Value
+	peeked   Value
+	done     bool
+	err      error
+}
+
+func (i *externalIteration) peek(provider Provider) (Value, error) {
+	if i.peeked != nil {
+		return i.peeked, nil
+	}
+
+	if len(i.buffered) > 0 {
+		i.peeked = i.buffered[0]
+		i.buffered = i.buffered[1:]
+		return i.peeked, nil
+	}
+
+	if !i.done {
+		if i.started {
+			i.resume <- true
+		}
+		i.started = true
+
+		select {
+		case value := <-i.yielded:
+			i.peeked = value
+			return value, nil
+		case err := <-i.finished:
+			i.done = true
+			i.err = err
+		}
+	}
+
+	if i.err != nil {
+		return nil, i.err
+	}
+
+	return nil, NewException(
+		provider.ClassProvider().ClassWithName("StopIteration"),
+		"iteration reached an end",
+		provider.StackProvider().CurrentStack(),
+	)
+}
+
+// lets a suspended goroutine unwind, rather than waiting forever
+func (i *externalIteration) stop() {
+	if i.started && !i.done {
+		i.resume <- false
+		<-i.finished
+		i.done = true
+	}
+}
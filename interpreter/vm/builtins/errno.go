@@ -0,0 +1,44 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+func NewSystemCallErrorClass(provider Provider) Class {
+	return NewGenericClass("SystemCallError", "StandardError", provider)
+}
+
+func NewErrnoModule(provider Provider) Module {
+	errno := NewGenericModule("Errno", provider)
+	errno.SetConstant("ENOENT", NewGenericClass("Errno::ENOENT", "SystemCallError", provider))
+	errno.SetConstant("EACCES", NewGenericClass("Errno::EACCES", "SystemCallError", provider))
+	errno.SetConstant("EISDIR", NewGenericClass("Errno::EISDIR", "SystemCallError", provider))
+
+	return errno
+}
+
+// systemCallError converts an error returned by the os package into the
+// matching Errno exception, or returns it unchanged if there is none
+func systemCallError(err error, path string, provider Provider) error {
+	var className, description string
+
+	switch {
+	case os.IsNotExist(err):
+		className, description = "Errno::ENOENT", "No such file or directory"
+	case os.IsPermission(err):
+		className, description = "Errno::EACCES", "Permission denied"
+	case errors.Is(err, syscall.EISDIR):
+		className, description = "Errno::EISDIR", "Is a directory"
+	default:
+		return err
+	}
+
+	return NewException(
+		provider.ClassProvider().ClassWithName(className),
+		fmt.Sprintf("%s @ rb_sysopen - %s", description, path),
+		provider.StackProvider().CurrentStack(),
+	)
+}
@@ -1,6 +1,9 @@
 package builtins
 
-import "errors"
+import (
+	"fmt"
+	"strings"
+)
 
 type exceptionClass struct {
 	valueStub
@@ -13,6 +16,43 @@ func NewExceptionClass(provider Provider) Class {
 	n.setStringer(n.String)
 	n.class = provider.ClassProvider().ClassWithName("Class")
 	n.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	n.AddInstanceMethod(NewNativeMethod("initialize", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		e, ok := self.(*ExceptionValue)
+		if ok && len(args) > 0 {
+			e.message = args[0].String()
+		}
+
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}))
+	n.AddInstanceMethod(NewNativeMethod("message", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(exceptionMessage(self), provider), nil
+	}))
+	n.AddInstanceMethod(NewNativeMethod("to_s", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(exceptionMessage(self), provider), nil
+	}))
+	n.AddInstanceMethod(NewNativeMethod("backtrace", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		lines := exceptionBacktrace(self)
+		if lines == nil {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		backtrace, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		for _, line := range lines {
+			backtrace.(*Array).Append(NewString(line, provider))
+		}
+		return backtrace, nil
+	}))
+	// the message, followed by where the exception was raised from
+	n.AddInstanceMethod(NewNativeMethod("full_message", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		message := fmt.Sprintf("%s (%s)", exceptionMessage(self), self.Class().String())
+		for _, line := range exceptionBacktrace(self) {
+			message += "\n\t" + line
+		}
+
+		return NewString(message, provider), nil
+	}))
+
 	return n
 }
 
@@ -24,18 +64,102 @@ func (n *exceptionClass) Name() string {
 	return "Exception"
 }
 
-type exceptionInstance struct {
+func (class *exceptionClass) New(provider Provider, args ...Value) (Value, error) {
+	return NewException(class, "", ""), nil
+}
+
+// isExceptionClass is whether instances of class are exceptions, that is
+// whether it is Exception or one of its subclasses
+func isExceptionClass(class Class) bool {
+	for ; class != nil; class = class.SuperClass() {
+		if _, ok := class.(*exceptionClass); ok {
+			return true
+		}
+		if class.String() == "BasicObject" {
+			break
+		}
+	}
+
+	return false
+}
+
+// ExceptionValue is an instance of Exception (or one of its subclasses),
+// whether it was raised from native code or created by a ruby program.
+// It is both a ruby value and a go error.
+type ExceptionValue struct {
 	valueStub
+
+	message   string
+	callStack string
 }
 
-func (class *exceptionClass) New(provider Provider, args ...Value) (Value, error) {
-	return nil, errors.New("unimplemented")
+func NewException(class Class, message, callStack string) *ExceptionValue {
+	e := &ExceptionValue{message: message, callStack: callStack}
+	e.initialize()
+	e.setStringer(e.String)
+	e.setPrettyPrinter(e.inspect)
+	e.class = class
+	return e
 }
 
-func (n *exceptionInstance) String() string {
-	return ""
+// Message is the message the exception was created with, which like ruby
+// defaults to the name of its class
+func (e *ExceptionValue) Message() string {
+	if e.message == "" {
+		return e.class.Name()
+	}
+	return e.message
 }
 
-func (n *exceptionInstance) IsTruthy() bool {
+func (e *ExceptionValue) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", e.class.Name(), e.Message(), e.callStack)
+}
+
+func (e *ExceptionValue) String() string {
+	return e.class.Name()
+}
+
+func (e *ExceptionValue) inspect() string {
+	return fmt.Sprintf("#<%s: %s>", e.class.Name(), e.Message())
+}
+
+func (e *ExceptionValue) IsTruthy() bool {
 	return true
 }
+
+// every exception has a message, including the errors raised by the vm
+// that are not ExceptionValues (eg: NoMethodError)
+type rubyError interface {
+	Message() string
+}
+
+func exceptionMessage(value Value) string {
+	if e, ok := value.(rubyError); ok {
+		return e.Message()
+	}
+	return value.Class().String()
+}
+
+func exceptionBacktrace(value Value) []string {
+	var callStack string
+	switch e := value.(type) {
+	case *ExceptionValue:
+		callStack = e.callStack
+	case *noMethodError:
+		callStack = e.callstack
+	case *nameError:
+		callStack = e.callStack
+	case *loadError:
+		callStack = e.callstack
+	}
+
+	if callStack == "" {
+		return nil
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimRight(callStack, "\n"), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	return lines
+}
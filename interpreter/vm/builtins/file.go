@@ -1,6 +1,10 @@
 package builtins
 
 import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 )
@@ -8,7 +12,6 @@ import (
 type fileClass struct {
 	valueStub
 	classStub
-	instanceMethods []Method
 }
 
 func NewFileClass(provider Provider) Class {
@@ -51,6 +54,70 @@ func NewFileClass(provider Provider) Class {
 			return provider.SingletonProvider().SingletonWithName("true"), nil
 		}
 	}))
+	f.AddMethod(NewNativeMethod("read", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		path, err := fileStringArg(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, systemCallError(err, path, provider)
+		}
+
+		return NewString(string(contents), provider), nil
+	}))
+	f.AddMethod(NewNativeMethod("write", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		path, err := fileStringArg(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		var contents string
+		if str, ok := args[1].(*StringValue); ok {
+			contents = str.RawString()
+		} else {
+			contents = args[1].String()
+		}
+
+		err = ioutil.WriteFile(path, []byte(contents), 0666)
+		if err != nil {
+			return nil, systemCallError(err, path, provider)
+		}
+
+		return NewFixnum(int64(len(contents)), provider), nil
+	}))
+	f.AddMethod(NewNativeMethod("open", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		value, err := self.(Class).New(provider, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		if block == nil {
+			return value, nil
+		}
+
+		file := value.(*FileValue)
+		defer file.close()
+
+		return block.Call(file)
+	}))
 	f.AddMethod(NewNativeMethod("join", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		pieces := make([]string, len(args))
 		for _, str := range args {
@@ -60,11 +127,89 @@ func NewFileClass(provider Provider) Class {
 		return NewString(filepath.Join(pieces...), provider), nil
 	}))
 
-	return f
-}
+	// File.read and File.write are also class methods, so these need to be
+	// looked up as instance methods rather than on the class itself
+	f.AddInstanceMethod(NewNativeMethod("read", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		file := self.(*FileValue)
+		if err := file.ensureOpen(provider); err != nil {
+			return nil, err
+		}
+
+		contents, err := ioutil.ReadAll(file.reader)
+		if err != nil {
+			return nil, systemCallError(err, file.path, provider)
+		}
+
+		return NewString(string(contents), provider), nil
+	}))
+	f.AddInstanceMethod(NewNativeMethod("each_line", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "each_line", provider), nil
+		}
+
+		file := self.(*FileValue)
+		if err := file.ensureOpen(provider); err != nil {
+			return nil, err
+		}
+
+		for {
+			line, err := file.reader.ReadString('\n')
+			if line != "" {
+				_, blockErr := block.Call(NewString(line, provider))
+				if blockErr != nil {
+					return nil, blockErr
+				}
+			}
+
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, systemCallError(err, file.path, provider)
+			}
+		}
+
+		return file, nil
+	}))
+	f.AddInstanceMethod(NewNativeMethod("write", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		file := self.(*FileValue)
+		if err := file.ensureOpen(provider); err != nil {
+			return nil, err
+		}
 
-func (file *fileClass) AddInstanceMethod(m Method) {
-	file.instanceMethods = append(file.instanceMethods, m)
+		written := 0
+		for _, arg := range args {
+			var contents string
+			if str, ok := arg.(*StringValue); ok {
+				contents = str.RawString()
+			} else {
+				contents = arg.String()
+			}
+
+			count, err := file.file.WriteString(contents)
+			written += count
+			if err != nil {
+				return nil, systemCallError(err, file.path, provider)
+			}
+		}
+
+		return NewFixnum(int64(written), provider), nil
+	}))
+	f.AddInstanceMethod(NewNativeMethod("close", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		self.(*FileValue).close()
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}))
+	f.AddInstanceMethod(NewNativeMethod("closed?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.(*FileValue).closed {
+			return provider.SingletonProvider().SingletonWithName("true"), nil
+		} else {
+			return provider.SingletonProvider().SingletonWithName("false"), nil
+		}
+	}))
+	f.AddInstanceMethod(NewNativeMethod("path", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.(*FileValue).path, provider), nil
+	}))
+
+	return f
 }
 
 func (file *fileClass) Name() string {
@@ -75,6 +220,104 @@ func (file *fileClass) String() string {
 	return "File"
 }
 
-func (file *fileClass) New(provider Provider, args ...Value) (Value, error) {
-	return nil, nil
+func (class *fileClass) New(provider Provider, args ...Value) (Value, error) {
+	if len(args) == 0 {
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			"wrong number of arguments (0 for 1..2)",
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	path, err := fileStringArg(args[0], provider)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := "r"
+	if len(args) > 1 {
+		mode, err = fileStringArg(args[1], provider)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	flags, ok := fileModeFlags[mode]
+	if !ok {
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			fmt.Sprintf("invalid access mode %s", mode),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	osFile, err := os.OpenFile(path, flags, 0666)
+	if err != nil {
+		return nil, systemCallError(err, path, provider)
+	}
+
+	file := &FileValue{path: path, file: osFile, reader: bufio.NewReader(osFile)}
+	file.initialize()
+	file.setStringer(file.String)
+	file.class = class
+
+	return file, nil
+}
+
+// fileStringArg is the path or mode given to File as a string, raising a
+// TypeError for anything that is not one
+func fileStringArg(arg Value, provider Provider) (string, error) {
+	str, ok := arg.(*StringValue)
+	if !ok {
+		return "", NewTypeError(fmt.Sprintf("no implicit conversion of %s into String", arg.Class().String()), provider)
+	}
+
+	return str.RawString(), nil
+}
+
+var fileModeFlags = map[string]int{
+	"r":  os.O_RDONLY,
+	"r+": os.O_RDWR,
+	"w":  os.O_WRONLY | os.O_CREATE | os.O_TRUNC,
+	"w+": os.O_RDWR | os.O_CREATE | os.O_TRUNC,
+	"a":  os.O_WRONLY | os.O_CREATE | os.O_APPEND,
+	"a+": os.O_RDWR | os.O_CREATE | os.O_APPEND,
+}
+
+type FileValue struct {
+	valueStub
+
+	path   string
+	file   *os.File
+	reader *bufio.Reader
+	closed bool
+}
+
+func (file *FileValue) String() string {
+	return fmt.Sprintf("#<File:%s>", file.path)
+}
+
+func (file *FileValue) Writer() io.Writer {
+	return file.file
+}
+
+func (file *FileValue) ensureOpen(provider Provider) error {
+	if !file.closed {
+		return nil
+	}
+
+	return NewException(
+		provider.ClassProvider().ClassWithName("IOError"),
+		"closed stream",
+		provider.StackProvider().CurrentStack(),
+	)
+}
+
+func (file *FileValue) close() {
+	if file.closed {
+		return
+	}
+
+	file.closed = true
+	file.file.Close()
 }
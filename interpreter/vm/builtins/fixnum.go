@@ -27,13 +27,41 @@ func NewFixnumClass(provider Provider) Class {
 		}
 	}))
 
-	class.AddMethod(NewNativeMethod("+", provider, func(self Value, block Block, args ...Value) (Value, error) {
+	class.AddMethod(NewNativeMethod("%", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		arg, ok := args[0].(*fixnumInstance)
 		if !ok {
 			return nil, errors.New(fmt.Sprintf("TypeError: %s can't be coerced into Fixnum", args[0].Class().String()))
 		}
-		asFixnum := self.(*fixnumInstance)
-		return NewFixnum(asFixnum.value+arg.value, provider), nil
+		if arg.value == 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ZeroDivisionError"),
+				"divided by 0",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		// ruby rounds towards negative infinity, so the result takes the sign of the divisor
+		modulo := self.(*fixnumInstance).value % arg.value
+		if modulo != 0 && (modulo < 0) != (arg.value < 0) {
+			modulo += arg.value
+		}
+		return NewFixnum(modulo, provider), nil
+	}))
+
+	class.AddMethod(NewNativeMethod("-@", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return integerArithmetic("-", NewFixnum(0, provider), self, provider)
+	}))
+
+	class.AddMethod(NewNativeMethod("gcd", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return integerDivisors("gcd", self, args, provider)
+	}))
+
+	class.AddMethod(NewNativeMethod("lcm", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return integerDivisors("lcm", self, args, provider)
+	}))
+
+	class.AddMethod(NewNativeMethod("digits", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return integerDigits(self, args, provider)
 	}))
 
 	class.AddMethod(NewNativeMethod("nonzero?", provider, func(self Value, block Block, args ...Value) (Value, error) {
@@ -73,9 +101,9 @@ func NewFixnum(val int64, provider Provider) Value {
 		i.class = provider.ClassProvider().ClassWithName("Fixnum")
 		i.initialize()
 		i.setStringer(i.String)
+		i.Freeze()
 
-		provider.SingletonProvider().NewSingletonWithName(name, i)
-		return i
+		return provider.SingletonProvider().NewSingletonWithName(name, i)
 	} else {
 		return singleton
 	}
@@ -2,7 +2,9 @@ package builtins
 
 import (
 	"errors"
-	"fmt"
+	"math"
+	"strconv"
+	"strings"
 )
 
 type floatClass struct {
@@ -17,6 +19,22 @@ func NewFloatClass(provider Provider) Class {
 	class.class = provider.ClassProvider().ClassWithName("Class")
 	class.superClass = provider.ClassProvider().ClassWithName("Numeric")
 
+	class.AddMethod(NewNativeMethod("-@", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFloat(-self.(*FloatValue).value, provider), nil
+	}))
+
+	// NewFloat cannot be used yet, as the class is still being created
+	constant := func(value float64) Value {
+		f := &FloatValue{value: value}
+		f.class = class
+		f.initialize()
+		f.setStringer(f.String)
+		f.Freeze()
+		return f
+	}
+	class.SetConstant("INFINITY", constant(math.Inf(1)))
+	class.SetConstant("NAN", constant(math.NaN()))
+
 	return class
 }
 
@@ -42,6 +60,7 @@ func NewFloat(val float64, provider Provider) Value {
 	f.class = provider.ClassProvider().ClassWithName("Float")
 	f.initialize()
 	f.setStringer(f.String)
+	f.Freeze()
 	return f
 }
 
@@ -50,5 +69,32 @@ func (FloatValue *FloatValue) ValueAsFloat() float64 {
 }
 
 func (FloatValue *FloatValue) String() string {
-	return fmt.Sprintf("%d", FloatValue.value)
+	return floatString(FloatValue.value)
+}
+
+func floatString(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	}
+
+	// like ruby, always show a decimal point, and switch to scientific notation for large and tiny values
+	abs := math.Abs(value)
+	if abs >= 1e16 || (abs != 0 && abs < 1e-4) {
+		str := strconv.FormatFloat(value, 'e', -1, 64)
+		if !strings.Contains(str, ".") {
+			str = strings.Replace(str, "e", ".0e", 1)
+		}
+		return str
+	}
+
+	str := strconv.FormatFloat(value, 'f', -1, 64)
+	if !strings.Contains(str, ".") {
+		str += ".0"
+	}
+	return str
 }
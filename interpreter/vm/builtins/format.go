@@ -0,0 +1,148 @@
+package builtins
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// formatValues implements the format strings of Kernel#sprintf and String#%
+// by translating each Ruby format specification into one for Go's fmt
+func formatValues(format string, args []Value, provider Provider) (string, error) {
+	var buffer bytes.Buffer
+	argIndex := 0
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			buffer.WriteByte(format[i])
+			continue
+		}
+
+		start := i
+		i++
+		for i < len(format) && strings.IndexByte("-+ 0#", format[i]) >= 0 {
+			i++
+		}
+		for i < len(format) && strings.IndexByte("0123456789.", format[i]) >= 0 {
+			i++
+		}
+
+		if i >= len(format) {
+			return "", formatArgumentError("incomplete format specifier; use %% (double %) instead", provider)
+		}
+
+		verb := format[i]
+		if verb == '%' {
+			buffer.WriteByte('%')
+			continue
+		}
+
+		if argIndex >= len(args) {
+			return "", formatArgumentError("too few arguments", provider)
+		}
+		arg := args[argIndex]
+		argIndex++
+
+		spec := format[start:i]
+		formatted, err := formatValue(spec, verb, arg, provider)
+		if err != nil {
+			return "", err
+		}
+		buffer.WriteString(formatted)
+	}
+
+	if argIndex < len(args) {
+		return "", formatArgumentError("too many arguments for format string", provider)
+	}
+
+	return buffer.String(), nil
+}
+
+func formatValue(spec string, verb byte, arg Value, provider Provider) (string, error) {
+	switch verb {
+	case 'd', 'i', 'u':
+		integer, err := formatInteger(arg)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(spec+"d", integer), nil
+	case 'x', 'X', 'o', 'b', 'B':
+		integer, err := formatInteger(arg)
+		if err != nil {
+			return "", err
+		}
+		if verb == 'B' {
+			verb = 'b'
+		}
+		return fmt.Sprintf(spec+string(verb), integer), nil
+	case 'f', 'e', 'E', 'g', 'G':
+		float, err := formatFloat(arg)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(spec+string(verb), float), nil
+	case 'c':
+		if str, ok := arg.(*StringValue); ok && str.RawString() != "" {
+			return fmt.Sprintf(spec+"c", []rune(str.RawString())[0]), nil
+		}
+
+		integer, err := formatInteger(arg)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(spec+"c", rune(integer.Int64())), nil
+	case 's':
+		str, err := stringify(arg)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(spec+"s", str), nil
+	case 'p':
+		str, err := Inspect(arg)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(spec+"s", str), nil
+	default:
+		return "", formatArgumentError(fmt.Sprintf("malformed format string - %%%c", verb), provider)
+	}
+}
+
+// formatInteger gives a big.Int, which fmt formats like an int64 with any
+// of the verbs for integers, so that bignums can be formatted too
+func formatInteger(arg Value) (*big.Int, error) {
+	switch arg := arg.(type) {
+	case *fixnumInstance:
+		return big.NewInt(arg.value), nil
+	case *BignumValue:
+		return arg.Value(), nil
+	case *FloatValue:
+		return big.NewInt(int64(arg.value)), nil
+	default:
+		return nil, errors.New(fmt.Sprintf("TypeError: can't convert %s into Integer", arg.Class().String()))
+	}
+}
+
+func formatFloat(arg Value) (float64, error) {
+	switch arg := arg.(type) {
+	case *fixnumInstance:
+		return float64(arg.value), nil
+	case *BignumValue:
+		float, _ := new(big.Float).SetInt(arg.value).Float64()
+		return float, nil
+	case *FloatValue:
+		return arg.value, nil
+	default:
+		return 0, errors.New(fmt.Sprintf("TypeError: can't convert %s into Float", arg.Class().String()))
+	}
+}
+
+func formatArgumentError(message string, provider Provider) error {
+	return NewException(
+		provider.ClassProvider().ClassWithName("ArgumentError"),
+		message,
+		provider.StackProvider().CurrentStack(),
+	)
+}
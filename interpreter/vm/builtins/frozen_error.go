@@ -0,0 +1,29 @@
+package builtins
+
+import "fmt"
+
+func NewRuntimeErrorClass(provider Provider) Class {
+	return NewGenericClass("RuntimeError", "StandardError", provider)
+}
+
+func NewFrozenErrorClass(provider Provider) Class {
+	return NewGenericClass("FrozenError", "RuntimeError", provider)
+}
+
+func NewFrozenError(value Value, provider Provider) error {
+	// like ruby, a frozen class or module is described by what it is,
+	// rather than as an instance of Class or Module
+	description := value.Class().String()
+	switch value.(type) {
+	case Class:
+		description = "class"
+	case Module:
+		description = "module"
+	}
+
+	return NewException(
+		provider.ClassProvider().ClassWithName("FrozenError"),
+		fmt.Sprintf("can't modify frozen %s: %s", description, value.PrettyPrint()),
+		provider.StackProvider().CurrentStack(),
+	)
+}
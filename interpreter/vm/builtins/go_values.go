@@ -0,0 +1,177 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// ToGo converts a ruby value into the go value an embedder would expect:
+//
+//	nil             nil
+//	true, false     bool
+//	Fixnum          int
+//	Bignum          *big.Int
+//	Float           float64
+//	String, Symbol  string
+//	Array           []interface{}, each member converted
+//	Hash            map[string]interface{} when every key is a String or a
+//	                Symbol, map[interface{}]interface{} otherwise, with the
+//	                values converted, and the keys too unless they are arrays
+//	                or hashes, which cannot be map keys in go
+//
+// Any other value is returned as it is, as is an array or hash that contains itself.
+func ToGo(value Value) interface{} {
+	return toGo(value, map[Value]bool{})
+}
+
+func toGo(value Value, converting map[Value]bool) interface{} {
+	switch value := value.(type) {
+	case *nilInstance:
+		return nil
+	case *trueInstance:
+		return true
+	case *falseInstance:
+		return false
+	case *fixnumInstance:
+		return int(value.value)
+	case *BignumValue:
+		return value.Value()
+	case *FloatValue:
+		return value.value
+	case *StringValue:
+		return value.RawString()
+	case *SymbolValue:
+		return value.Name()
+	case *Array:
+		if converting[value] {
+			return value
+		}
+		converting[value] = true
+		defer delete(converting, value)
+
+		members := make([]interface{}, 0, len(value.Members()))
+		for _, member := range value.Members() {
+			members = append(members, toGo(member, converting))
+		}
+		return members
+	case *Hash:
+		if converting[value] {
+			return value
+		}
+		converting[value] = true
+		defer delete(converting, value)
+
+		return hashToGo(value, converting)
+	default:
+		return value
+	}
+}
+
+func hashToGo(hash *Hash, converting map[Value]bool) interface{} {
+	stringKeys := true
+	for _, entry := range hash.entries {
+		switch entry.key.(type) {
+		case *StringValue, *SymbolValue:
+		default:
+			stringKeys = false
+		}
+	}
+
+	if stringKeys {
+		converted := make(map[string]interface{}, hash.Len())
+		for _, entry := range hash.entries {
+			converted[toGo(entry.key, converting).(string)] = toGo(entry.value, converting)
+		}
+		return converted
+	}
+
+	converted := make(map[interface{}]interface{}, hash.Len())
+	for _, entry := range hash.entries {
+		var key interface{} = entry.key
+		switch entry.key.(type) {
+		case *Array, *Hash:
+		default:
+			key = toGo(entry.key, converting)
+		}
+
+		converted[key] = toGo(entry.value, converting)
+	}
+	return converted
+}
+
+// FromGo is the inverse of ToGo, converting go values into ruby ones:
+//
+//	nil                      nil
+//	bool                     true or false
+//	any int or uint type     Fixnum, or Bignum when it is too large
+//	*big.Int                 Fixnum or Bignum
+//	float32, float64         Float
+//	string                   String
+//	any slice or array       Array, each member converted
+//	any map                  Hash, each key and value converted
+//	Value                    the value itself
+//
+// An error is returned for anything else, such as a struct or a func.
+func FromGo(value interface{}, provider Provider) (Value, error) {
+	switch value := value.(type) {
+	case nil:
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	case Value:
+		return value, nil
+	case *big.Int:
+		return NewInteger(new(big.Int).Set(value), provider), nil
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Bool:
+		return booleanValue(reflected.Bool(), provider), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NewFixnum(reflected.Int(), provider), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return NewInteger(new(big.Int).SetUint64(reflected.Uint()), provider), nil
+	case reflect.Float32, reflect.Float64:
+		return NewFloat(reflected.Float(), provider), nil
+	case reflect.String:
+		return NewString(reflected.String(), provider), nil
+	case reflect.Slice, reflect.Array:
+		if reflected.Kind() == reflect.Slice && reflected.IsNil() {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		members := make([]Value, 0, reflected.Len())
+		for i := 0; i < reflected.Len(); i++ {
+			member, err := FromGo(reflected.Index(i).Interface(), provider)
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, member)
+		}
+		return newArrayOf(members, provider), nil
+	case reflect.Map:
+		if reflected.IsNil() {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		hash, _ := provider.ClassProvider().ClassWithName("Hash").New(provider)
+		iterator := reflected.MapRange()
+		for iterator.Next() {
+			key, err := FromGo(iterator.Key().Interface(), provider)
+			if err != nil {
+				return nil, err
+			}
+			value, err := FromGo(iterator.Value().Interface(), provider)
+			if err != nil {
+				return nil, err
+			}
+			if err := hash.(*Hash).Add(key, value); err != nil {
+				return nil, err
+			}
+		}
+		return hash, nil
+	default:
+		return nil, errors.New(fmt.Sprintf("cannot convert a %T into a ruby value", value))
+	}
+}
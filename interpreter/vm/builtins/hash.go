@@ -1,6 +1,7 @@
 package builtins
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -10,8 +11,6 @@ type HashClass struct {
 	classStub
 
 	provider Provider
-
-	instanceMethods []Method
 }
 
 func NewHashClass(provider Provider) Class {
@@ -25,8 +24,8 @@ func NewHashClass(provider Provider) Class {
 	class.AddMethod(NewNativeMethod("keys", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		o, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
 		keys := o.(*Array)
-		for key := range self.(*Hash).hash {
-			keys.Append(key)
+		for _, entry := range self.(*Hash).entries {
+			keys.Append(entry.key)
 		}
 
 		return keys, nil
@@ -34,54 +33,240 @@ func NewHashClass(provider Provider) Class {
 	class.AddMethod(NewNativeMethod("values", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		o, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
 		values := o.(*Array)
-		for key := range self.(*Hash).hash {
-			values.Append(self.(*Hash).hash[key])
+		for _, entry := range self.(*Hash).entries {
+			values.Append(entry.value)
 		}
 
 		return values, nil
 	}))
 
-	class.AddMethod(NewNativeMethod("each", provider, func(self Value, block Block, args ...Value) (Value, error) {
+	each := func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "each", provider), nil
+		}
+
 		selfAsHash := self.(*Hash)
-		for key, value := range selfAsHash.hash {
-			_, err := block.Call(key, value)
+		for _, entry := range selfAsHash.entries {
+			_, err := block.Call(entry.pair(provider))
 			if err != nil {
 				return nil, err
 			}
 		}
 
 		return selfAsHash, nil
+	}
+	class.AddMethod(NewNativeMethod("each", provider, each))
+	class.AddMethod(NewNativeMethod("each_pair", provider, each))
+
+	class.AddMethod(NewNativeMethod("map", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "map", provider), nil
+		}
+
+		results := []Value{}
+		for _, entry := range self.(*Hash).entries {
+			result, err := block.Call(entry.pair(provider))
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, result)
+		}
+
+		return newArrayOf(results, provider), nil
+	}))
+
+	class.AddMethod(NewNativeMethod("merge", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		m, _ := provider.ClassProvider().ClassWithName("Hash").New(provider)
+		merged := m.(*Hash)
+		for _, entry := range self.(*Hash).entries {
+			if err := merged.Add(entry.key, entry.value); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, arg := range args {
+			other, ok := arg.(*Hash)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Hash", arg.Class().String()))
+			}
+
+			for _, entry := range other.entries {
+				value := entry.value
+				existing, found, err := merged.Get(entry.key)
+				if err != nil {
+					return nil, err
+				}
+				if found && block != nil {
+					value, err = block.Call(entry.key, existing, entry.value)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				if err := merged.Add(entry.key, value); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return merged, nil
+	}))
+
+	class.AddMethod(NewNativeMethod("fetch", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (given %d, expected 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		value, ok, err := self.(*Hash).Get(args[0])
+		switch {
+		case err != nil:
+			return nil, err
+		case ok:
+			return value, nil
+		case block != nil:
+			return block.Call(args[0])
+		case len(args) > 1:
+			return args[1], nil
+		default:
+			return nil, NewKeyError(args[0], provider)
+		}
+	}))
+
+	class.AddMethod(NewNativeMethod("dig", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				"wrong number of arguments (given 0, expected 1+)",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		nilValue := provider.SingletonProvider().SingletonWithName("nil")
+		value, ok, err := self.(*Hash).Get(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nilValue, nil
+		}
+		if len(args) == 1 || value == nilValue {
+			return value, nil
+		}
+
+		dig := value.Method("dig")
+		if dig == nil {
+			return nil, errors.New(fmt.Sprintf("TypeError: %s does not have #dig method", value.Class().String()))
+		}
+
+		return dig.Execute(value, nil, args[1:]...)
+	}))
+
+	class.AddMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		other, ok := args[0].(*Hash)
+		if !ok || other.Len() != self.(*Hash).Len() {
+			return booleanValue(false, provider), nil
+		}
+
+		for _, entry := range self.(*Hash).entries {
+			otherValue, found, err := other.Get(entry.key)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				return booleanValue(false, provider), nil
+			}
+
+			equal, err := valuesAreEqual(entry.value, otherValue, provider)
+			if err != nil {
+				return nil, err
+			}
+			if !equal {
+				return booleanValue(false, provider), nil
+			}
+		}
+
+		return booleanValue(true, provider), nil
 	}))
 
 	class.AddMethod(NewNativeMethod("[]=", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		self.(*Hash).hash[args[0]] = args[1]
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
+		if err := self.(*Hash).Add(args[0], args[1]); err != nil {
+			return nil, err
+		}
 		return args[1], nil
 	}))
 
 	class.AddMethod(NewNativeMethod("[]", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		selfAsHash := self.(*Hash)
-		value, ok := selfAsHash.hash[args[0]]
+		value, ok, err := selfAsHash.Get(args[0])
+		if err != nil {
+			return nil, err
+		}
 
 		if !ok {
-			return provider.SingletonProvider().SingletonWithName("nil"), nil
+			return selfAsHash.defaultFor(args[0], provider)
 		} else {
 			return value, nil
 		}
 	}))
 
-	return class
-}
+	// Hash.new(default) or Hash.new { |hash, key| ... }
+	class.AddMethod(NewNativeMethod("initialize", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		selfAsHash := self.(*Hash)
+		switch {
+		case block != nil && len(args) > 0:
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (given %d, expected 0)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		case block != nil:
+			selfAsHash.defaultProc = block
+		case len(args) > 0:
+			selfAsHash.defaultValue = args[0]
+		}
 
-func (klass *HashClass) AddInstanceMethod(m Method) {
-	klass.instanceMethods = append(klass.instanceMethods, m)
+		return selfAsHash, nil
+	}))
+	class.AddMethod(NewNativeMethod("default", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		selfAsHash := self.(*Hash)
+		if selfAsHash.defaultProc != nil && len(args) > 0 {
+			return selfAsHash.defaultProc.Call(self, args[0])
+		}
+
+		if selfAsHash.defaultValue == nil {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		return selfAsHash.defaultValue, nil
+	}))
+	class.AddMethod(NewNativeMethod("default=", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
+		self.(*Hash).defaultValue = args[0]
+		self.(*Hash).defaultProc = nil
+		return args[0], nil
+	}))
+
+	return class
 }
 
 func (klass *HashClass) New(provider Provider, args ...Value) (Value, error) {
 	hash := &Hash{}
 	hash.initialize()
 	hash.setStringer(hash.String)
+	hash.setPrettyPrinter(hash.PrettyPrint)
 	hash.class = klass
-	hash.hash = make(map[Value]Value)
+	hash.buckets = make(map[uint64][]*hashEntry)
 
 	return hash, nil
 }
@@ -95,19 +280,154 @@ func (hash *HashClass) String() string {
 }
 
 type Hash struct {
-	hash map[Value]Value
 	valueStub
+
+	// entries are kept in insertion order, and indexed by the hash of their key
+	entries []*hashEntry
+	buckets map[uint64][]*hashEntry
+
+	// what Hash#[] returns for a missing key, the proc wins when both are set
+	defaultValue Value
+	defaultProc  Block
+}
+
+type hashEntry struct {
+	key   Value
+	value Value
+
+	// the hash of the key when it was added, which indexes its bucket
+	code uint64
 }
 
 func (hash *Hash) String() string {
 	pieces := []string{}
-	for key, value := range hash.hash {
-		pieces = append(pieces, fmt.Sprintf("%s => %s", key.String(), value.PrettyPrint()))
+	for _, entry := range hash.entries {
+		pieces = append(pieces, fmt.Sprintf("%s => %s", entry.key.String(), entry.value.PrettyPrint()))
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(pieces, ", "))
+}
+
+func (hash *Hash) PrettyPrint() string {
+	if !hash.beginInspecting() {
+		return "{...}"
+	}
+	defer hash.endInspecting()
+
+	pieces := []string{}
+	for _, entry := range hash.entries {
+		pieces = append(pieces, fmt.Sprintf("%s=>%s", inspectOrPrettyPrint(entry.key), inspectOrPrettyPrint(entry.value)))
 	}
 
 	return fmt.Sprintf("{%s}", strings.Join(pieces, ", "))
 }
 
-func (hash *Hash) Add(key, value Value) {
-	hash.hash[key] = value
+func (hash *Hash) Dup() Value {
+	dup := &Hash{}
+	dup.initialize()
+	dup.setStringer(dup.String)
+	dup.setPrettyPrinter(dup.PrettyPrint)
+	dup.class = hash.class
+	dup.buckets = make(map[uint64][]*hashEntry, len(hash.buckets))
+	for _, entry := range hash.entries {
+		copied := &hashEntry{key: entry.key, value: entry.value, code: entry.code}
+		dup.buckets[entry.code] = append(dup.buckets[entry.code], copied)
+		dup.entries = append(dup.entries, copied)
+	}
+	dup.defaultValue = hash.defaultValue
+	dup.defaultProc = hash.defaultProc
+	dup.copyInstanceStateFrom(&hash.valueStub)
+
+	return dup
+}
+
+// defaultFor is what Hash#[] returns for a key the hash does not have
+func (hash *Hash) defaultFor(key Value, provider Provider) (Value, error) {
+	if hash.defaultProc != nil {
+		return hash.defaultProc.Call(hash, key)
+	}
+
+	if hash.defaultValue == nil {
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}
+
+	return hash.defaultValue, nil
+}
+
+// the [key, value] array yielded by Hash#each and friends
+func (entry *hashEntry) pair(provider Provider) Value {
+	return newArrayOf([]Value{entry.key, entry.value}, provider)
+}
+
+// entry finds the entry for key, and the hash of key, raising whatever its
+// hash method or the eql? method of the keys it is compared with raise
+func (hash *Hash) entry(key Value) (*hashEntry, uint64, error) {
+	code, err := hashOf(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, entry := range hash.buckets[code] {
+		eql, err := keysAreEql(entry.key, key)
+		if err != nil {
+			return nil, 0, err
+		}
+		if eql {
+			return entry, code, nil
+		}
+	}
+
+	return nil, code, nil
+}
+
+func (hash *Hash) Add(key, value Value) error {
+	entry, code, err := hash.entry(key)
+	if err != nil {
+		return err
+	}
+	if entry != nil {
+		entry.value = value
+		return nil
+	}
+
+	entry = &hashEntry{key: key, value: value, code: code}
+	hash.buckets[code] = append(hash.buckets[code], entry)
+	hash.entries = append(hash.entries, entry)
+	return nil
+}
+
+func (hash *Hash) Get(key Value) (Value, bool, error) {
+	entry, _, err := hash.entry(key)
+	if entry == nil || err != nil {
+		return nil, false, err
+	}
+
+	return entry.value, true, nil
+}
+
+func (hash *Hash) Len() int {
+	return len(hash.entries)
+}
+
+func (hash *Hash) Delete(key Value) (Value, bool, error) {
+	entry, _, err := hash.entry(key)
+	if entry == nil || err != nil {
+		return nil, false, err
+	}
+
+	bucket := hash.buckets[entry.code]
+	for index, candidate := range bucket {
+		if candidate == entry {
+			hash.buckets[entry.code] = append(bucket[:index:index], bucket[index+1:]...)
+			break
+		}
+	}
+	for index, candidate := range hash.entries {
+		if candidate == entry {
+			hash.entries = append(hash.entries[:index:index], hash.entries[index+1:]...)
+			break
+		}
+	}
+
+	return entry.value, true, nil
 }
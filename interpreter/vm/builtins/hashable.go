@@ -0,0 +1,191 @@
+package builtins
+
+import (
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// Hashable values are compared by value, rather than identity, when they
+// are used as the keys of a Hash. Values that are Eql must have equal Hashes.
+type Hashable interface {
+	Hash() uint64
+	Eql(Value) bool
+}
+
+// hashOf is the hash of a Hash key: the result of its Ruby hash method when
+// a class defines one, otherwise what builtinHashOf gives. An overriding
+// method that returns something other than an Integer is ignored, but what
+// it raises is raised from whatever was looking up the key.
+func hashOf(value Value) (uint64, error) {
+	if method, ok := value.Method("hash").(*RubyMethod); ok {
+		result, err := method.Execute(value, nil)
+		if err != nil {
+			return 0, err
+		}
+		if code, ok := result.(*fixnumInstance); ok {
+			return uint64(code.value), nil
+		}
+	}
+
+	return builtinHashOf(value)
+}
+
+// builtinHashOf hashes Hashable values by their contents, and everything
+// else by identity, which is what Object#hash returns. an array hashes its
+// members with hashOf, which can run their hash methods
+func builtinHashOf(value Value) (uint64, error) {
+	if array, ok := value.(*Array); ok {
+		return array.hash()
+	}
+	if hashable, ok := value.(Hashable); ok {
+		return hashable.Hash(), nil
+	}
+
+	return uint64(reflect.ValueOf(value).Pointer()), nil
+}
+
+// keysAreEql compares Hash keys with the Ruby eql? method of a when its class
+// defines one, otherwise with builtinKeysAreEql
+func keysAreEql(a, b Value) (bool, error) {
+	if method, ok := a.Method("eql?").(*RubyMethod); ok {
+		result, err := method.Execute(a, nil, b)
+		if err != nil {
+			return false, err
+		}
+		return result.IsTruthy(), nil
+	}
+
+	return builtinKeysAreEql(a, b)
+}
+
+func builtinKeysAreEql(a, b Value) (bool, error) {
+	if array, ok := a.(*Array); ok {
+		return array.eql(b)
+	}
+	if hashable, ok := a.(Hashable); ok {
+		return hashable.Eql(b), nil
+	}
+
+	return a == b, nil
+}
+
+// hashString hashes a string along with a tag, so that values of different
+// types with the same representation (eg: "1" and 1) do not collide
+func hashString(tag, str string) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(tag))
+	hasher.Write([]byte(str))
+	return hasher.Sum64()
+}
+
+func (s *StringValue) Hash() uint64 {
+	return hashString("String", s.value)
+}
+
+func (s *StringValue) Eql(other Value) bool {
+	asStr, ok := other.(*StringValue)
+	return ok && asStr.value == s.value
+}
+
+func (s *SymbolValue) Hash() uint64 {
+	return hashString("Symbol", s.value)
+}
+
+func (s *SymbolValue) Eql(other Value) bool {
+	asSym, ok := other.(*SymbolValue)
+	return ok && asSym.value == s.value
+}
+
+func (i *fixnumInstance) Hash() uint64 {
+	return uint64(i.value) ^ hashString("Fixnum", "")
+}
+
+func (i *fixnumInstance) Eql(other Value) bool {
+	asFixnum, ok := other.(*fixnumInstance)
+	return ok && asFixnum.value == i.value
+}
+
+func (b *BignumValue) Hash() uint64 {
+	return hashString("Bignum", b.value.String())
+}
+
+func (b *BignumValue) Eql(other Value) bool {
+	asBignum, ok := other.(*BignumValue)
+	return ok && asBignum.value.Cmp(b.value) == 0
+}
+
+func (r *RationalValue) Hash() uint64 {
+	return hashString("Rational", r.value.String())
+}
+
+func (r *RationalValue) Eql(other Value) bool {
+	asRational, ok := other.(*RationalValue)
+	return ok && asRational.value.Cmp(r.value) == 0
+}
+
+func (c *ComplexValue) Hash() uint64 {
+	return hashString("Complex", c.String())
+}
+
+func (c *ComplexValue) Eql(other Value) bool {
+	asComplex, ok := other.(*ComplexValue)
+	return ok && asComplex.value == c.value
+}
+
+func (f *FloatValue) Hash() uint64 {
+	value := f.value
+	if value == 0 {
+		value = 0 // -0.0 and 0.0 are eql
+	}
+
+	return math.Float64bits(value) ^ hashString("Float", "")
+}
+
+func (f *FloatValue) Eql(other Value) bool {
+	asFloat, ok := other.(*FloatValue)
+	return ok && asFloat.value == f.value
+}
+
+// an array that contains itself hashes the inner reference to a constant,
+// and compares it as eql, like MRI does
+func (array *Array) hash() (uint64, error) {
+	hash := hashString("Array", "")
+	if array.hashing {
+		return hash, nil
+	}
+	array.hashing = true
+	defer func() { array.hashing = false }()
+
+	for _, member := range array.members {
+		code, err := hashOf(member)
+		if err != nil {
+			return 0, err
+		}
+		hash = hash*31 + code
+	}
+
+	return hash, nil
+}
+
+func (array *Array) eql(other Value) (bool, error) {
+	asArray, ok := other.(*Array)
+	if !ok || len(asArray.members) != len(array.members) {
+		return false, nil
+	}
+
+	if array == asArray || array.comparing {
+		return true, nil
+	}
+	array.comparing = true
+	defer func() { array.comparing = false }()
+
+	for index, member := range array.members {
+		eql, err := keysAreEql(member, asArray.members[index])
+		if err != nil || !eql {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,5 @@
+package builtins
+
+func NewIndexErrorClass(provider Provider) Class {
+	return NewGenericClass("IndexError", "StandardError", provider)
+}
@@ -0,0 +1,141 @@
+package builtins
+
+import (
+	"fmt"
+	"strings"
+)
+
+// implemented by every value through valueStub
+type inspectable interface {
+	Value
+
+	instanceVariableNames() []string
+	attributeNames() []string
+
+	beginInspecting() bool
+	endInspecting()
+}
+
+// Inspect calls the Ruby `inspect` method of value, so that user defined
+// overrides are respected, and falls back to its PrettyPrint otherwise
+func Inspect(value Value) (string, error) {
+	method := value.Method("inspect")
+	if method == nil {
+		return value.PrettyPrint(), nil
+	}
+
+	result, err := method.Execute(value, nil)
+	if err != nil {
+		return "", err
+	}
+
+	str, ok := result.(*StringValue)
+	if !ok {
+		return result.String(), nil
+	}
+
+	return str.RawString(), nil
+}
+
+// like Inspect, but for use in pretty printers, which cannot fail
+func inspectOrPrettyPrint(value Value) string {
+	str, err := Inspect(value)
+	if err != nil {
+		return value.PrettyPrint()
+	}
+
+	return str
+}
+
+// the default inspect for objects: #<Foo:0x... @x=1>
+// an object referenced by one of its own ivars is shown as #<Foo:0x... ...>
+func inspectObject(value inspectable) string {
+	header := fmt.Sprintf("#<%s:%p", value.Class().String(), value)
+	if !value.beginInspecting() {
+		return header + " ...>"
+	}
+	defer value.endInspecting()
+
+	pieces := []string{}
+	for _, name := range value.instanceVariableNames() {
+		pieces = append(pieces, fmt.Sprintf("@%s=%s", name, inspectOrPrettyPrint(value.GetInstanceVariable(name))))
+	}
+	for _, name := range value.attributeNames() {
+		if value.GetInstanceVariable(name) != nil {
+			continue
+		}
+
+		attr, _ := value.GetAttribute(name)
+		pieces = append(pieces, fmt.Sprintf("@%s=%s", name, inspectOrPrettyPrint(attr)))
+	}
+
+	if len(pieces) == 0 {
+		return header + ">"
+	}
+
+	return fmt.Sprintf("%s %s>", header, strings.Join(pieces, ", "))
+}
+
+// the width pp tries to keep its lines within
+const prettyInspectWidth = 80
+
+// PrettyInspect is what Kernel#pp prints: the same as Inspect when that fits
+// on one line, otherwise arrays and hashes are broken up with one member per
+// line, and each member is pretty inspected in turn
+func PrettyInspect(value Value) (string, error) {
+	return prettyInspect(value, 0)
+}
+
+// indent is the column the value starts at
+func prettyInspect(value Value, indent int) (string, error) {
+	str, err := Inspect(value)
+	if err != nil {
+		return "", err
+	}
+
+	if indent+len(str) <= prettyInspectWidth {
+		return str, nil
+	}
+
+	switch value := value.(type) {
+	case *Array:
+		if len(value.members) == 0 || !value.beginInspecting() {
+			return str, nil
+		}
+		defer value.endInspecting()
+
+		pieces := []string{}
+		for _, member := range value.members {
+			piece, err := prettyInspect(member, indent+1)
+			if err != nil {
+				return "", err
+			}
+			pieces = append(pieces, piece)
+		}
+
+		return "[" + strings.Join(pieces, ",\n"+strings.Repeat(" ", indent+1)) + "]", nil
+	case *Hash:
+		if len(value.entries) == 0 || !value.beginInspecting() {
+			return str, nil
+		}
+		defer value.endInspecting()
+
+		pieces := []string{}
+		for _, entry := range value.entries {
+			key, err := prettyInspect(entry.key, indent+1)
+			if err != nil {
+				return "", err
+			}
+
+			piece, err := prettyInspect(entry.value, indent+1+len(key)+len("=>"))
+			if err != nil {
+				return "", err
+			}
+			pieces = append(pieces, key+"=>"+piece)
+		}
+
+		return "{" + strings.Join(pieces, ",\n"+strings.Repeat(" ", indent+1)) + "}", nil
+	default:
+		return str, nil
+	}
+}
@@ -14,6 +14,11 @@ func NewIntegerClass(provider Provider) Class {
 	class.class = provider.ClassProvider().ClassWithName("Class")
 	class.superClass = provider.ClassProvider().ClassWithName("Numeric")
 
+	class.AddMethod(NewNativeMethod("to_r", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		value, _ := bigRationalOf(self)
+		return NewRational(value, provider), nil
+	}))
+
 	return class
 }
 
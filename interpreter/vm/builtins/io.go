@@ -1,9 +1,13 @@
 package builtins
 
+import (
+	"fmt"
+	"io"
+)
+
 type ioClass struct {
 	valueStub
 	classStub
-	instanceMethods []Method
 }
 
 func NewIOClass(provider Provider) Class {
@@ -12,6 +16,48 @@ func NewIOClass(provider Provider) Class {
 	i.setStringer(i.String)
 	i.class = provider.ClassProvider().ClassWithName("Class")
 	i.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	i.AddInstanceMethod(NewNativeMethod("puts", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		err := writeLines(self.(writable).Writer(), args...)
+		if err != nil {
+			return nil, err
+		}
+
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}))
+	i.AddInstanceMethod(NewNativeMethod("print", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		for _, arg := range args {
+			str, err := stringify(arg)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = io.WriteString(self.(writable).Writer(), str)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}))
+	i.AddInstanceMethod(NewNativeMethod("write", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		written := 0
+		for _, arg := range args {
+			str, err := stringify(arg)
+			if err != nil {
+				return nil, err
+			}
+
+			count, err := io.WriteString(self.(writable).Writer(), str)
+			written += count
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return NewFixnum(int64(written), provider), nil
+	}))
+
 	return i
 }
 
@@ -23,10 +69,97 @@ func (io *ioClass) String() string {
 	return "IO"
 }
 
-func (io *ioClass) AddInstanceMethod(m Method) {
-	io.instanceMethods = append(io.instanceMethods, m)
-}
-
 func (io *ioClass) New(provider Provider, args ...Value) (Value, error) {
 	return nil, nil
 }
+
+// writable values (IO and its subclasses) can be written to by IO's methods
+type writable interface {
+	Writer() io.Writer
+}
+
+type IOValue struct {
+	valueStub
+	writer io.Writer
+}
+
+func NewIO(writer io.Writer, provider Provider) Value {
+	i := &IOValue{writer: writer}
+	i.initialize()
+	i.setStringer(i.String)
+	i.class = provider.ClassProvider().ClassWithName("IO")
+	return i
+}
+
+func (i *IOValue) Writer() io.Writer {
+	return i.writer
+}
+
+func (i *IOValue) String() string {
+	return fmt.Sprintf("#<IO:%p>", i)
+}
+
+// writeLines implements puts: each value is written on its own line, and
+// arrays are flattened so that each of their members gets a line too
+func writeLines(writer io.Writer, values ...Value) error {
+	return writeLinesOf(writer, values, map[*Array]bool{})
+}
+
+func writeLinesOf(writer io.Writer, values []Value, seen map[*Array]bool) error {
+	if len(values) == 0 {
+		_, err := io.WriteString(writer, "\n")
+		return err
+	}
+
+	for _, value := range values {
+		var str string
+		if array, ok := value.(*Array); ok {
+			if !seen[array] {
+				seen[array] = true
+				err := writeLinesOf(writer, array.Members(), seen)
+				delete(seen, array)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			str = "[...]"
+		} else {
+			var err error
+			str, err = stringify(value)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(str) == 0 || str[len(str)-1] != '\n' {
+			str += "\n"
+		}
+
+		_, err := io.WriteString(writer, str)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func stringify(value Value) (string, error) {
+	to_s := value.Method("to_s")
+	if to_s == nil {
+		return value.String(), nil
+	}
+
+	str, err := to_s.Execute(value, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if asStr, ok := str.(*StringValue); ok {
+		return asStr.RawString(), nil
+	}
+
+	return str.String(), nil
+}
@@ -0,0 +1,5 @@
+package builtins
+
+func NewIOErrorClass(provider Provider) Class {
+	return NewGenericClass("IOError", "StandardError", provider)
+}
@@ -1,6 +1,12 @@
 package builtins
 
-import "os"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
 
 type kernel struct {
 	valueStub
@@ -14,50 +20,211 @@ func NewGlobalKernelModule(provider Provider) Module {
 	k.setStringer(k.String)
 	k.class = provider.ClassProvider().ClassWithName("Module")
 
-	k.AddMethod(NewNativeMethod("puts", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		for _, arg := range args {
-			var stringified string
-			to_s := arg.Method("to_s")
-			if to_s != nil {
-				value, err := to_s.Execute(arg, nil)
-				if err != nil {
-					return nil, err
-				}
-				stringified = value.String()
-			} else {
-				stringified = arg.String()
+	k.AddMethod(NewNativeMethod("singleton_methods", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		methodsArray, err := provider.ClassProvider().ClassWithName("Array").New(provider)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, method := range self.eigenclassMethods() {
+			if !method.IsPublic() {
+				continue
 			}
 
-			os.Stdout.Write([]byte(stringified + "\n"))
+			methodsArray.(*Array).Append(NewSymbol(method.Name(), provider))
 		}
 
-		return nil, nil
+		return methodsArray, nil
 	}))
 
-	k.AddMethod(NewNativeMethod("singleton_methods", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		methodsArray, err := provider.ClassProvider().ClassWithName("Array").New(provider)
+	sprintf := func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				"too few arguments",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		format, ok := args[0].(*StringValue)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into String", args[0].Class().String()))
+		}
+
+		formatted, err := formatValues(format.RawString(), args[1:], provider)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, method := range self.eigenclassMethods() {
-			if !method.IsPublic() {
-				continue
+		return NewString(formatted, provider), nil
+	}
+	k.AddMethod(NewNativeMethod("sprintf", provider, sprintf))
+	k.AddMethod(NewNativeMethod("format", provider, sprintf))
+
+	k.AddMethod(NewNativeMethod("Integer", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		base := 0
+		if len(args) == 2 {
+			baseArg, ok := args[1].(*fixnumInstance)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", args[1].Class().String()))
+			}
+			if _, ok := args[0].(*StringValue); !ok {
+				return nil, NewException(
+					provider.ClassProvider().ClassWithName("ArgumentError"),
+					"base specified for non string value",
+					provider.StackProvider().CurrentStack(),
+				)
+			}
+			base = int(baseArg.value)
+		}
+
+		return convertToInteger(args[0], base, provider)
+	}))
+	k.AddMethod(NewNativeMethod("Float", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		return convertToFloat(args[0], provider)
+	}))
+	k.AddMethod(NewNativeMethod("Rational", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		numerator, err := convertToRational(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 1 {
+			return NewRational(new(big.Rat).Set(numerator), provider), nil
+		}
+
+		denominator, err := convertToRational(args[1], provider)
+		if err != nil {
+			return nil, err
+		}
+		if denominator.Sign() == 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ZeroDivisionError"),
+				"divided by 0",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		return NewRational(new(big.Rat).Quo(numerator, denominator), provider), nil
+	}))
+	k.AddMethod(NewNativeMethod("Complex", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		value, err := convertToComplexPart(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 2 {
+			imaginary, err := convertToComplexPart(args[1], provider)
+			if err != nil {
+				return nil, err
 			}
+			value += imaginary * 1i
+		}
+
+		return NewComplex(value, provider), nil
+	}))
+	k.AddMethod(NewNativeMethod("String", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		return convertToString(args[0], provider)
+	}))
+	k.AddMethod(NewNativeMethod("Array", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		return convertToArray(args[0], provider)
+	}))
+
+	k.AddMethod(NewNativeMethod("exit", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		status, err := exitStatus(args, 0, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, NewSystemExit(status, provider)
+	}))
+	// unlike exit, exit! skips ensure blocks and at_exit hooks entirely
+	k.AddMethod(NewNativeMethod("exit!", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		status, err := exitStatus(args, 1, provider)
+		if err != nil {
+			return nil, err
+		}
 
-			symbol := provider.SingletonProvider().SymbolWithName(method.Name())
+		return nil, &ImmediateExit{Status: int(status)}
+	}))
 
-			if symbol == nil {
-				symbol = NewSymbol(method.Name(), provider)
-				provider.SingletonProvider().AddSymbol(symbol)
+	// raise accepts a message (raising a RuntimeError), an exception class and
+	// an optional message, or an exception. Its backtrace is where it is raised.
+	k.AddMethod(NewNativeMethod("raise", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		var exception Value
+		switch {
+		case len(args) == 0:
+			exception = NewException(provider.ClassProvider().ClassWithName("RuntimeError"), "unhandled exception", "")
+		case len(args) == 1 && args[0].Class() == provider.ClassProvider().ClassWithName("String"):
+			exception = NewException(provider.ClassProvider().ClassWithName("RuntimeError"), args[0].String(), "")
+		default:
+			exception = args[0]
+			if class, ok := args[0].(Class); ok {
+				var err error
+				exception, err = class.Method("new").Execute(class, nil, args[1:]...)
+				if err != nil {
+					return nil, err
+				}
 			}
+		}
 
-			methodsArray.(*Array).Append(symbol)
+		e, ok := exception.(*ExceptionValue)
+		if !ok {
+			return nil, errors.New("TypeError: exception class/object expected")
 		}
 
-		return methodsArray, nil
+		e.callStack = provider.StackProvider().CurrentStack()
+		return nil, e
 	}))
 
+	addCatchAndThrow(k, provider)
+
 	return k
 }
 
@@ -68,3 +235,46 @@ func (kernel *kernel) String() string {
 func (kernel *kernel) Name() string {
 	return "Kernel"
 }
+
+// NewSleepMethod is Kernel#sleep, which leaves the waiting to wait, so that
+// the vm can stop waiting once the program is interrupted. Without a number
+// of seconds it sleeps forever, which wait is asked for with a negative
+// duration.
+func NewSleepMethod(provider Provider, wait func(time.Duration) error) Method {
+	return NewNativeMethod("sleep", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) > 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 0..1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		if len(args) == 0 {
+			return nil, wait(-1)
+		}
+
+		var seconds float64
+		switch arg := args[0].(type) {
+		case *fixnumInstance:
+			seconds = float64(arg.value)
+		case *FloatValue:
+			seconds = arg.value
+		default:
+			return nil, errors.New(fmt.Sprintf("TypeError: can't convert %s into time interval", args[0].Class().String()))
+		}
+
+		if seconds < 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				"time interval must not be negative",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		if err := wait(time.Duration(seconds * float64(time.Second))); err != nil {
+			return nil, err
+		}
+		return NewFixnum(int64(math.Floor(seconds+0.5)), provider), nil
+	})
+}
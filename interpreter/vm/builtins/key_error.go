@@ -0,0 +1,15 @@
+package builtins
+
+import "fmt"
+
+func NewKeyErrorClass(provider Provider) Class {
+	return NewGenericClass("KeyError", "IndexError", provider)
+}
+
+func NewKeyError(key Value, provider Provider) error {
+	return NewException(
+		provider.ClassProvider().ClassWithName("KeyError"),
+		fmt.Sprintf("key not found: %s", inspectOrPrettyPrint(key)),
+		provider.StackProvider().CurrentStack(),
+	)
+}
@@ -0,0 +1,269 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+)
+
+type lazyEnumeratorClass struct {
+	valueStub
+	classStub
+}
+
+// Enumerator::Lazy, whose superclass is the Enumerator class given
+func newLazyEnumeratorClass(enumerator Class, provider Provider) Class {
+	c := &lazyEnumeratorClass{}
+	c.initialize()
+	c.setStringer(c.String)
+	c.class = provider.ClassProvider().ClassWithName("Class")
+	c.superClass = enumerator
+
+	chain := func(name string) func(self Value, block Block, args ...Value) (Value, error) {
+		return func(self Value, block Block, args ...Value) (Value, error) {
+			if block == nil {
+				return nil, NewException(
+					provider.ClassProvider().ClassWithName("ArgumentError"),
+					fmt.Sprintf("tried to call lazy %s without a block", name),
+					provider.StackProvider().CurrentStack(),
+				)
+			}
+
+			return self.(*LazyEnumerator).chain(lazyStep{name: name, block: block}), nil
+		}
+	}
+	c.AddInstanceMethod(NewNativeMethod("map", provider, chain("map")))
+	c.AddInstanceMethod(NewNativeMethod("collect", provider, chain("map")))
+	c.AddInstanceMethod(NewNativeMethod("select", provider, chain("select")))
+	c.AddInstanceMethod(NewNativeMethod("filter", provider, chain("select")))
+	c.AddInstanceMethod(NewNativeMethod("reject", provider, chain("reject")))
+	c.AddInstanceMethod(NewNativeMethod("take", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		count, err := lazyCountArgument(args, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return self.(*LazyEnumerator).chain(lazyStep{name: "take", count: count}), nil
+	}))
+
+	c.AddInstanceMethod(NewNativeMethod("first", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 {
+			first := provider.SingletonProvider().SingletonWithName("nil")
+			err := self.(*LazyEnumerator).run(func(element Value) error {
+				first = element
+				return errLazyIterationDone
+			})
+
+			return first, err
+		}
+
+		count, err := lazyCountArgument(args, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		elements := []Value{}
+		if count > 0 {
+			err = self.(*LazyEnumerator).run(func(element Value) error {
+				elements = append(elements, element)
+				if int64(len(elements)) == count {
+					return errLazyIterationDone
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return newArrayOf(elements, provider), nil
+	}))
+	force := func(self Value, block Block, args ...Value) (Value, error) {
+		elements := []Value{}
+		err := self.(*LazyEnumerator).run(func(element Value) error {
+			elements = append(elements, element)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return newArrayOf(elements, provider), nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("force", provider, force))
+	c.AddInstanceMethod(NewNativeMethod("to_a", provider, force))
+	c.AddInstanceMethod(NewNativeMethod("each", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return self, nil
+		}
+
+		err := self.(*LazyEnumerator).run(func(element Value) error {
+			_, err := block.Call(element)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return self, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("lazy", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self, nil
+	}))
+	inspect := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.PrettyPrint(), provider), nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("inspect", provider, inspect))
+	c.AddInstanceMethod(NewNativeMethod("to_s", provider, inspect))
+
+	return c
+}
+
+func (c *lazyEnumeratorClass) String() string {
+	return "Enumerator::Lazy"
+}
+
+func (c *lazyEnumeratorClass) Name() string {
+	return "Enumerator::Lazy"
+}
+
+func (c *lazyEnumeratorClass) New(provider Provider, args ...Value) (Value, error) {
+	return nil, errors.New("NoMethodError: undefined method 'new' for Enumerator::Lazy, use Enumerable#lazy instead")
+}
+
+// LazyEnumerator is returned by Enumerable#lazy
+// map, select, reject and take only record a step of the chain, which is
+// applied to each element as the source yields it, so that iterating stops
+// as soon as enough elements have been taken, even from an infinite source
+type LazyEnumerator struct {
+	valueStub
+
+	source Value
+	steps  []lazyStep
+
+	provider Provider
+}
+
+type lazyStep struct {
+	name  string
+	block Block
+	count int64
+}
+
+func NewLazyEnumerator(source Value, provider Provider) Value {
+	return newLazyEnumerator(source, nil, provider)
+}
+
+func newLazyEnumerator(source Value, steps []lazyStep, provider Provider) *LazyEnumerator {
+	l := &LazyEnumerator{source: source, steps: steps, provider: provider}
+	l.initialize()
+	l.setStringer(l.String)
+	l.setPrettyPrinter(l.PrettyPrint)
+	l.class = provider.ClassProvider().ClassWithName("Enumerator::Lazy")
+
+	return l
+}
+
+func (l *LazyEnumerator) chain(step lazyStep) *LazyEnumerator {
+	steps := make([]lazyStep, len(l.steps), len(l.steps)+1)
+	copy(steps, l.steps)
+
+	return newLazyEnumerator(l.source, append(steps, step), l.provider)
+}
+
+// returned to stop iterating over the source once nothing more is needed
+var errLazyIterationDone = errors.New("lazy iteration done")
+
+// run hands each element that makes it through the chain to callback
+func (l *LazyEnumerator) run(callback func(Value) error) error {
+	taken := make([]int64, len(l.steps))
+	err := eachElement(l.source, l.provider, func(element Value) error {
+		return l.pass(element, 0, taken, callback)
+	})
+
+	if err == errLazyIterationDone {
+		return nil
+	}
+
+	return err
+}
+
+func (l *LazyEnumerator) pass(element Value, index int, taken []int64, callback func(Value) error) error {
+	for i := index; i < len(l.steps); i++ {
+		step := l.steps[i]
+
+		switch step.name {
+		case "map":
+			result, err := step.block.Call(element)
+			if err != nil {
+				return err
+			}
+			element = result
+		case "select", "reject":
+			result, err := step.block.Call(element)
+			if err != nil {
+				return err
+			}
+			if result.IsTruthy() != (step.name == "select") {
+				return nil
+			}
+		case "take":
+			if taken[i] >= step.count {
+				return errLazyIterationDone
+			}
+
+			taken[i]++
+			err := l.pass(element, i+1, taken, callback)
+			if err != nil {
+				return err
+			}
+			if taken[i] == step.count {
+				return errLazyIterationDone
+			}
+			return nil
+		}
+	}
+
+	return callback(element)
+}
+
+func (l *LazyEnumerator) String() string {
+	return l.PrettyPrint()
+}
+
+func (l *LazyEnumerator) PrettyPrint() string {
+	str := fmt.Sprintf("#<Enumerator::Lazy: %s>", inspectOrPrettyPrint(l.source))
+	for _, step := range l.steps {
+		if step.name == "take" {
+			str = fmt.Sprintf("#<Enumerator::Lazy: %s:take(%d)>", str, step.count)
+		} else {
+			str = fmt.Sprintf("#<Enumerator::Lazy: %s:%s>", str, step.name)
+		}
+	}
+
+	return str
+}
+
+func lazyCountArgument(args []Value, provider Provider) (int64, error) {
+	if len(args) != 1 {
+		return 0, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			fmt.Sprintf("wrong number of arguments (given %d, expected 1)", len(args)),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	count, ok := args[0].(*fixnumInstance)
+	if !ok {
+		return 0, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", args[0].Class().String()))
+	}
+
+	if count.value < 0 {
+		return 0, NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			"attempt to take negative size",
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	return count.value, nil
+}
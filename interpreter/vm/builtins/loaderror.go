@@ -10,14 +10,30 @@ type loadError struct {
 	valueStub
 }
 
-func NewLoadError(name string, callstack string) *loadError {
-	return &loadError{filename: name, callstack: callstack}
+func NewScriptErrorClass(provider Provider) Class {
+	return NewGenericClass("ScriptError", "Exception", provider)
+}
+
+func NewLoadErrorClass(provider Provider) Class {
+	return NewGenericClass("LoadError", "ScriptError", provider)
+}
+
+func NewLoadError(name string, provider Provider) *loadError {
+	err := &loadError{filename: name, callstack: provider.StackProvider().CurrentStack()}
+	err.initialize()
+	err.setStringer(err.String)
+	err.class = provider.ClassProvider().ClassWithName("LoadError")
+	return err
 }
 
 func (err *loadError) String() string {
 	return "LoadError"
 }
 
+func (err *loadError) Message() string {
+	return fmt.Sprintf("cannot load such file -- %s", err.filename)
+}
+
 func (err *loadError) Error() string {
-	return fmt.Sprintf("LoadError: cannot load such file -- %s\n%s", err.filename, err.callstack)
+	return fmt.Sprintf("LoadError: %s\n%s", err.Message(), err.callstack)
 }
@@ -0,0 +1,13 @@
+package builtins
+
+func NewLocalJumpErrorClass(provider Provider) Class {
+	return NewGenericClass("LocalJumpError", "StandardError", provider)
+}
+
+func NewLocalJumpError(provider Provider) error {
+	return NewException(
+		provider.ClassProvider().ClassWithName("LocalJumpError"),
+		"no block given (yield)",
+		provider.StackProvider().CurrentStack(),
+	)
+}
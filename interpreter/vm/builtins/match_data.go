@@ -0,0 +1,124 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+type matchDataClass struct {
+	valueStub
+	classStub
+}
+
+func NewMatchDataClass(provider Provider) Class {
+	c := &matchDataClass{}
+	c.initialize()
+	c.setStringer(c.String)
+	c.class = provider.ClassProvider().ClassWithName("Class")
+	c.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	c.AddMethod(NewNativeMethod("[]", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		index, ok := args[0].(*fixnumInstance)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", args[0].Class().String()))
+		}
+
+		return self.(*MatchDataValue).Group(int(index.value), provider), nil
+	}))
+	c.AddMethod(NewNativeMethod("to_s", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.(*MatchDataValue).Group(0, provider), nil
+	}))
+	c.AddMethod(NewNativeMethod("pre_match", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		match := self.(*MatchDataValue)
+		return NewString(match.str[:match.indices[0]], provider), nil
+	}))
+	c.AddMethod(NewNativeMethod("post_match", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		match := self.(*MatchDataValue)
+		return NewString(match.str[match.indices[1]:], provider), nil
+	}))
+	c.AddMethod(NewNativeMethod("captures", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return newArrayOf(self.(*MatchDataValue).captures(provider), provider), nil
+	}))
+	c.AddMethod(NewNativeMethod("to_a", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		match := self.(*MatchDataValue)
+		return newArrayOf(append([]Value{match.Group(0, provider)}, match.captures(provider)...), provider), nil
+	}))
+	c.AddMethod(NewNativeMethod("named_captures", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		match := self.(*MatchDataValue)
+		hash, _ := provider.ClassProvider().ClassWithName("Hash").New(provider)
+		for index, name := range match.names {
+			if name != "" {
+				if err := hash.(*Hash).Add(NewString(name, provider), match.Group(index, provider)); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return hash, nil
+	}))
+	c.AddMethod(NewNativeMethod("inspect", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.PrettyPrint(), provider), nil
+	}))
+
+	return c
+}
+
+func (c *matchDataClass) String() string {
+	return "MatchData"
+}
+
+func (c *matchDataClass) Name() string {
+	return "MatchData"
+}
+
+func (c *matchDataClass) New(provider Provider, args ...Value) (Value, error) {
+	return nil, errors.New("undefined method 'new' for MatchData:Class")
+}
+
+// MatchDataValue is the result of a successful regular expression match
+// indices are the byte offsets of the whole match and of each group, as
+// returned by regexp.FindStringSubmatchIndex, with -1 for unmatched groups
+type MatchDataValue struct {
+	valueStub
+
+	str     string
+	indices []int
+	names   []string
+}
+
+func NewMatchData(regex *regexp.Regexp, str string, indices []int, provider Provider) Value {
+	m := &MatchDataValue{str: str, indices: indices, names: regex.SubexpNames()}
+	m.initialize()
+	m.setStringer(m.String)
+	m.setPrettyPrinter(func() string { return fmt.Sprintf("#<MatchData %q>", m.String()) })
+	m.class = provider.ClassProvider().ClassWithName("MatchData")
+
+	return m
+}
+
+func (m *MatchDataValue) String() string {
+	return m.str[m.indices[0]:m.indices[1]]
+}
+
+// Group returns the text of a group, where 0 is the whole match,
+// or nil when that group did not match
+func (m *MatchDataValue) Group(index int, provider Provider) Value {
+	if index < 0 {
+		index += len(m.indices) / 2
+	}
+	if index < 0 || index >= len(m.indices)/2 || m.indices[2*index] < 0 {
+		return provider.SingletonProvider().SingletonWithName("nil")
+	}
+
+	return NewString(m.str[m.indices[2*index]:m.indices[2*index+1]], provider)
+}
+
+func (m *MatchDataValue) captures(provider Provider) []Value {
+	captures := []Value{}
+	for index := 1; index < len(m.indices)/2; index++ {
+		captures = append(captures, m.Group(index, provider))
+	}
+
+	return captures
+}
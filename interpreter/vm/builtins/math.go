@@ -0,0 +1,8 @@
+package builtins
+
+func NewMathModule(provider Provider) Module {
+	math := NewGenericModule("Math", provider)
+	math.SetConstant("DomainError", NewGenericClass("Math::DomainError", "ArgumentError", provider))
+
+	return math
+}
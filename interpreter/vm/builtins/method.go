@@ -16,6 +16,11 @@ type Method interface {
 	SetVisibility(MethodVisibility)
 
 	methodBody() func(self Value, block Block, args ...Value) (Value, error)
+	methodCache() *MethodCache
+}
+
+func NewMethodClass(provider Provider) Class {
+	return NewGenericClass("Method", "Object", provider)
 }
 
 type nativeMethod struct {
@@ -25,7 +30,15 @@ type nativeMethod struct {
 	body              func(self Value, block Block, args ...Value) (Value, error)
 	classProvider     ClassProvider
 	singletonProvider SingletonProvider
-	stackProvider     StackProvider
+}
+
+// methods are created while the builtin classes are still being
+// bootstrapped, before the Method class exists, so it is looked up lazily
+func (method *nativeMethod) Class() Class {
+	if method.class == nil {
+		method.class = method.classProvider.ClassWithName("Method")
+	}
+	return method.class
 }
 
 func NewNativeMethod(name string, provider Provider, body func(self Value, block Block, args ...Value) (Value, error)) Method {
@@ -41,7 +54,6 @@ func newNativeMethod(name string, visibility MethodVisibility, provider Provider
 		name:              name,
 		body:              body,
 		visibility:        visibility,
-		stackProvider:     provider.StackProvider(),
 		classProvider:     provider.ClassProvider(),
 		singletonProvider: provider.SingletonProvider(),
 	}
@@ -68,9 +80,6 @@ func (method *nativeMethod) IsProtected() bool {
 }
 
 func (method *nativeMethod) Execute(self Value, block Block, args ...Value) (Value, error) {
-	method.stackProvider.UnshiftStackFrame(method.name, "fixme -- filename goes here", -1)
-	defer method.stackProvider.ShiftStackFrame()
-
 	return method.body(self, block, args...)
 }
 
@@ -89,3 +98,7 @@ func (method *nativeMethod) SetVisibility(visibility MethodVisibility) {
 func (method *nativeMethod) methodBody() func(self Value, block Block, args ...Value) (Value, error) {
 	return method.body
 }
+
+func (method *nativeMethod) methodCache() *MethodCache {
+	return method.classProvider.MethodCache()
+}
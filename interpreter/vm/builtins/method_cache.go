@@ -0,0 +1,91 @@
+package builtins
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type methodCacheKey struct {
+	class Class
+	name  string
+}
+
+// MethodCache caches the methods found by instanceMethodOf, which otherwise
+// walks the class, its included modules and its ancestors on every method
+// call. Each vm has its own, so that a program changing its classes only
+// throws away the lookups cached for its own vm.
+type MethodCache struct {
+	// counts the changes made to any method table of the vm: adding or
+	// removing a method on a class, module or object, or including a module.
+	// Each change invalidates every cached lookup.
+	version uint64
+
+	sync.Mutex
+	cachedVersion uint64
+	methods       map[methodCacheKey]Method
+}
+
+func NewMethodCache() *MethodCache {
+	return &MethodCache{methods: map[methodCacheKey]Method{}}
+}
+
+// methodCacheOf finds the cache of the vm the class belongs to through its
+// BasicObject, and is nil for a class that is not (yet) descended from one
+func methodCacheOf(class Class) *MethodCache {
+	for class != nil {
+		if root, ok := class.(*BasicObjectClass); ok {
+			return root.provider.ClassProvider().MethodCache()
+		}
+		class = class.SuperClass()
+	}
+
+	return nil
+}
+
+func methodCacheOfValue(value Value) *MethodCache {
+	if class, ok := value.(Class); ok {
+		return methodCacheOf(class)
+	}
+
+	return methodCacheOf(value.Class())
+}
+
+func (cache *MethodCache) changed() {
+	if cache != nil {
+		atomic.AddUint64(&cache.version, 1)
+	}
+}
+
+func (cache *MethodCache) lookup(class Class, name string) (Method, uint64, bool) {
+	if cache == nil {
+		return nil, 0, false
+	}
+
+	version := atomic.LoadUint64(&cache.version)
+
+	cache.Lock()
+	defer cache.Unlock()
+
+	if cache.cachedVersion != version {
+		return nil, version, false
+	}
+
+	method, ok := cache.methods[methodCacheKey{class, name}]
+	return method, version, ok
+}
+
+// the lookup is only cached when no method table changed while it was made
+func (cache *MethodCache) store(class Class, name string, method Method, version uint64) {
+	if cache == nil || atomic.LoadUint64(&cache.version) != version {
+		return
+	}
+
+	cache.Lock()
+	defer cache.Unlock()
+
+	if cache.cachedVersion != version {
+		cache.cachedVersion = version
+		cache.methods = map[methodCacheKey]Method{}
+	}
+	cache.methods[methodCacheKey{class, name}] = method
+}
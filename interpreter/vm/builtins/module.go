@@ -52,7 +52,7 @@ func NewModuleClass(provider Provider, evaluator Evaluator) Class {
 
 		method := self.Method(methodName.value)
 		if method == nil {
-			return nil, NewNoMethodError(methodName.value, self.String(), self.Class().String(), provider.StackProvider().CurrentStack())
+			return nil, NewNoMethodError(methodName.value, self, provider)
 		}
 
 		self.RemoveMethod(method)
@@ -94,6 +94,57 @@ func NewModuleClass(provider Provider, evaluator Evaluator) Class {
 
 		return provider.SingletonProvider().SingletonWithName("nil"), nil
 	}))
+	c.AddMethod(NewNativeMethod("alias_method", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		names, err := methodNames(args)
+		if err != nil {
+			return nil, err
+		}
+
+		err = AliasMethod(self.(Module), names[0], names[1], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewSymbol(names[0], provider), nil
+	}))
+	c.AddMethod(NewNativeMethod("remove_method", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		names, err := methodNames(args)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			err := RemoveMethod(self.(Module), name, provider)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return self, nil
+	}))
+	c.AddMethod(NewNativeMethod("undef_method", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		names, err := methodNames(args)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			err := UndefMethod(self.(Module), name, provider)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return self, nil
+	}))
 	c.AddMethod(NewNativeMethod("private", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		selfAsModule := self.(Module)
 
@@ -150,6 +201,10 @@ func NewModuleClass(provider Provider, evaluator Evaluator) Class {
 
 	c.AddMethod(NewNativeMethod("include", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		selfAsModule := self.(Module)
+		if selfAsModule.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
 		for _, val := range args {
 			moduleToInclude, ok := val.(Module)
 			if !ok {
@@ -169,6 +224,10 @@ func NewModuleClass(provider Provider, evaluator Evaluator) Class {
 	}))
 
 	c.AddMethod(NewNativeMethod("extend", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
 		for _, module := range args {
 			for _, method := range module.Methods() {
 				self.AddMethod(method)
@@ -198,31 +257,6 @@ func NewModuleClass(provider Provider, evaluator Evaluator) Class {
 		return self, nil
 	}))
 
-	c.AddMethod(NewNativeMethod("alias_method", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		selfAsModule := self.(Module)
-
-		firstSymbol, ok := args[0].(*SymbolValue)
-		if !ok {
-			return nil, errors.New("expected method name to be a symbol")
-		}
-		secondSymbol, ok := args[1].(*SymbolValue)
-		if !ok {
-			return nil, errors.New("expected method name to be a symbol")
-		}
-
-		method, err := selfAsModule.InstanceMethod(secondSymbol.Name())
-		if err != nil {
-			method = self.Method(secondSymbol.Name())
-			if method == nil {
-				return nil, err
-			}
-		}
-
-		selfAsModule.AddInstanceMethod(NewNativeMethod(firstSymbol.Name(), provider, method.methodBody()))
-
-		return nil, nil
-	}))
-
 	c.AddMethod(NewNativeMethod("const_defined?", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		objClass := provider.ClassProvider().ClassWithName("Object")
 		_, err := objClass.Constant(args[0].(*SymbolValue).Name())
@@ -242,6 +276,24 @@ func NewModuleClass(provider Provider, evaluator Evaluator) Class {
 			return provider.SingletonProvider().SingletonWithName("false"), nil
 		}
 	}))
+	c.AddMethod(NewNativeMethod("instance_methods", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		inherited := len(args) == 0 || args[0].IsTruthy()
+
+		names := []Value{}
+		if class, ok := self.(Class); ok {
+			for _, name := range instanceMethodNames(class, inherited) {
+				names = append(names, NewSymbol(name, provider))
+			}
+		} else {
+			for _, method := range self.(Module).InstanceMethods() {
+				if method.IsPublic() || method.IsProtected() {
+					names = append(names, NewSymbol(method.Name(), provider))
+				}
+			}
+		}
+
+		return newArrayOf(names, provider), nil
+	}))
 	c.AddMethod(NewNativeMethod("class_eval", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		block.setContext(self)
 		block.Call()
@@ -288,3 +340,20 @@ func (m RubyModule) Name() string {
 func (m *RubyModule) String() string {
 	return m.name
 }
+
+// the symbols or strings given to alias_method and friends, as names
+func methodNames(args []Value) ([]string, error) {
+	names := []string{}
+	for _, arg := range args {
+		switch arg := arg.(type) {
+		case *SymbolValue:
+			names = append(names, arg.Name())
+		case *StringValue:
+			names = append(names, arg.RawString())
+		default:
+			return nil, errors.New(fmt.Sprintf("TypeError: %s is not a symbol nor a string", arg.String()))
+		}
+	}
+
+	return names, nil
+}
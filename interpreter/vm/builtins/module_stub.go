@@ -35,10 +35,12 @@ func (m *moduleStub) AddInstanceMethod(method Method) {
 	}
 
 	m.instanceMethods[method.Name()] = method
+	method.methodCache().changed()
 }
 
 func (m *moduleStub) RemoveInstanceMethod(method Method) {
 	delete(m.instanceMethods, method.Name())
+	method.methodCache().changed()
 }
 
 func (m *moduleStub) InstanceMethods() []Method {
@@ -87,3 +89,90 @@ func (m *moduleStub) ActiveVisibility() MethodVisibility {
 func (m *moduleStub) SetActiveVisibility(visibility MethodVisibility) {
 	m.methodVisibility = visibility
 }
+
+// AliasMethod copies the method that instances of the module respond to
+// under a new name, so that it survives the original being redefined
+func AliasMethod(module Module, newName, oldName string, provider Provider) error {
+	if module.IsFrozen() {
+		return NewFrozenError(module, provider)
+	}
+
+	var method Method
+	if class, ok := module.(Class); ok {
+		method = instanceMethodOf(class, oldName)
+	} else if method, _ = module.InstanceMethod(oldName); method == nil {
+		// the methods of a module_function live on the module itself
+		method = module.Method(oldName)
+	}
+
+	if method == nil {
+		return NewException(
+			provider.ClassProvider().ClassWithName("NameError"),
+			fmt.Sprintf("undefined method '%s' for class '%s'", oldName, module.String()),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	alias := NewNativeMethod(newName, provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return method.Execute(self, block, args...)
+	})
+	alias.SetVisibility(method.Visibility())
+	module.AddInstanceMethod(alias)
+
+	return nil
+}
+
+// undefinedMethod is the placeholder left by undef, which stops the method
+// lookup from finding the method on the module or any of its ancestors
+type undefinedMethod struct {
+	*nativeMethod
+}
+
+// UndefMethod prevents instances of the module from responding to the named
+// method, even when it is inherited, raising a NameError when there is none
+func UndefMethod(module Module, name string, provider Provider) error {
+	if module.IsFrozen() {
+		return NewFrozenError(module, provider)
+	}
+
+	var method Method
+	if class, ok := module.(Class); ok {
+		method = instanceMethodOf(class, name)
+	} else {
+		method, _ = module.InstanceMethod(name)
+	}
+
+	if method == nil {
+		return NewException(
+			provider.ClassProvider().ClassWithName("NameError"),
+			fmt.Sprintf("undefined method '%s' for class '%s'", name, module.String()),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	placeholder := newNativeMethod(name, Public, provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return nil, NewNoMethodError(name, self, provider)
+	})
+	module.AddInstanceMethod(&undefinedMethod{placeholder.(*nativeMethod)})
+	return nil
+}
+
+// RemoveMethod removes a method defined by the module itself, so that any
+// inherited method of the same name is visible again
+func RemoveMethod(module Module, name string, provider Provider) error {
+	if module.IsFrozen() {
+		return NewFrozenError(module, provider)
+	}
+
+	method, err := module.InstanceMethod(name)
+	if _, undefined := method.(*undefinedMethod); err != nil || undefined {
+		return NewException(
+			provider.ClassProvider().ClassWithName("NameError"),
+			fmt.Sprintf("method '%s' not defined in %s", name, module.String()),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	module.RemoveInstanceMethod(method)
+	return nil
+}
@@ -4,25 +4,35 @@ import "fmt"
 
 type nameError struct {
 	filename  string
-	context   string
-	className string
+	receiver  string
 	callStack string
 	valueStub
 }
 
-func NewNameError(name, context, className string, callStack string) *nameError {
-	return &nameError{
+func NewNameError(name string, receiver Value, provider Provider) *nameError {
+	err := &nameError{
 		filename:  name,
-		context:   context,
-		className: className,
-		callStack: callStack,
+		receiver:  describeReceiver(receiver),
+		callStack: provider.StackProvider().CurrentStack(),
 	}
+	err.initialize()
+	err.setStringer(err.String)
+	err.class = provider.ClassProvider().ClassWithName("NameError")
+	return err
+}
+
+func (err *nameError) Message() string {
+	return fmt.Sprintf("undefined local variable or method '%s' for %s", err.filename, err.receiver)
 }
 
 func (err *nameError) Error() string {
-	return fmt.Sprintf("NameError: undefined local variable or method '%s' for %s:%s\n%s", err.filename, err.context, err.className, err.callStack)
+	return fmt.Sprintf("NameError: %s\n%s", err.Message(), err.callStack)
 }
 
 func (err *nameError) String() string {
 	return "NameError"
 }
+
+func NewNameErrorClass(provider Provider) Class {
+	return NewGenericClass("NameError", "StandardError", provider)
+}
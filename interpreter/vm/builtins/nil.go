@@ -30,7 +30,9 @@ func (class *NilClass) New(provider Provider, args ...Value) (Value, error) {
 	n := &nilInstance{}
 	n.initialize()
 	n.setStringer(n.String)
+	n.setPrettyPrinter(n.PrettyPrint)
 	n.class = class
+	n.Freeze()
 
 	return n, nil
 }
@@ -39,6 +41,10 @@ func (n *nilInstance) String() string {
 	return ""
 }
 
+func (n *nilInstance) PrettyPrint() string {
+	return "nil"
+}
+
 func (n *nilInstance) IsTruthy() bool {
 	return false
 }
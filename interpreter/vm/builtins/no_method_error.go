@@ -4,25 +4,52 @@ import "fmt"
 
 type noMethodError struct {
 	method    string
-	context   string
-	className string
+	receiver  string
 	callstack string
 	valueStub
 }
 
-func NewNoMethodError(name, context, className, callstack string) *noMethodError {
-	return &noMethodError{
+func NewNoMethodErrorClass(provider Provider) Class {
+	return NewGenericClass("NoMethodError", "NameError", provider)
+}
+
+func NewNoMethodError(name string, receiver Value, provider Provider) *noMethodError {
+	err := &noMethodError{
 		method:    name,
-		context:   context,
-		className: className,
-		callstack: callstack,
+		receiver:  describeReceiver(receiver),
+		callstack: provider.StackProvider().CurrentStack(),
 	}
+	err.initialize()
+	err.setStringer(err.String)
+	err.class = provider.ClassProvider().ClassWithName("NoMethodError")
+	return err
+}
+
+func (err *noMethodError) Message() string {
+	return fmt.Sprintf("undefined method '%s' for %s", err.method, err.receiver)
 }
 
 func (err *noMethodError) Error() string {
-	return fmt.Sprintf("NoMethodError: undefined method '%s' for %s:%s\n%s", err.method, err.context, err.className, err.callstack)
+	return fmt.Sprintf("NoMethodError: %s\n%s", err.Message(), err.callstack)
 }
 
 func (err *noMethodError) String() string {
-	return err.Error()
+	return "NoMethodError"
+}
+
+// describeReceiver names the receiver of a missing method the way ruby does
+// eg: "nil", "class Foo" or "an instance of Foo"
+func describeReceiver(receiver Value) string {
+	switch receiver := receiver.(type) {
+	case Class:
+		return "class " + receiver.String()
+	case Module:
+		return "module " + receiver.String()
+	case *nilInstance:
+		return "nil"
+	case *trueInstance, *falseInstance:
+		return receiver.String()
+	}
+
+	return "an instance of " + receiver.Class().String()
 }
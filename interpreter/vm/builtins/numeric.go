@@ -1,6 +1,11 @@
 package builtins
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
 
 type numericClass struct {
 	valueStub
@@ -14,9 +19,241 @@ func NewNumericClass(provider Provider) Class {
 	class.class = provider.ClassProvider().ClassWithName("Class")
 	class.superClass = provider.ClassProvider().ClassWithName("Object")
 
+	class.AddMethod(NewNativeMethod("<=>", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		comparison, ok := compareNumbers(self, args[0])
+		if !ok {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		return NewFixnum(int64(comparison), provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		comparison, ok := compareNumbers(self, args[0])
+		return booleanValue(ok && comparison == 0, provider), nil
+	}))
+
+	comparisons := map[string]func(int) bool{
+		"<":  func(c int) bool { return c < 0 },
+		"<=": func(c int) bool { return c <= 0 },
+		">":  func(c int) bool { return c > 0 },
+		">=": func(c int) bool { return c >= 0 },
+	}
+	for name, predicate := range comparisons {
+		predicate := predicate
+		class.AddMethod(NewNativeMethod(name, provider, func(self Value, block Block, args ...Value) (Value, error) {
+			comparison, ok := compareNumbers(self, args[0])
+			if !ok {
+				return nil, NewException(
+					provider.ClassProvider().ClassWithName("ArgumentError"),
+					fmt.Sprintf("comparison of %s with %s failed", self.Class().String(), args[0].Class().String()),
+					provider.StackProvider().CurrentStack(),
+				)
+			}
+
+			return booleanValue(predicate(comparison), provider), nil
+		}))
+	}
+
+	arithmetic := map[string]func(a, b float64) float64{
+		"+":  func(a, b float64) float64 { return a + b },
+		"-":  func(a, b float64) float64 { return a - b },
+		"*":  func(a, b float64) float64 { return a * b },
+		"/":  func(a, b float64) float64 { return a / b },
+		"**": math.Pow,
+	}
+	for name, operation := range arithmetic {
+		name, operation := name, operation
+		class.AddMethod(NewNativeMethod(name, provider, func(self Value, block Block, args ...Value) (Value, error) {
+			if isComplex(self) || isComplex(args[0]) {
+				return complexArithmetic(name, self, args[0], provider)
+			}
+
+			a, b, integers, ok := numericOperands(self, args[0])
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: %s can't be coerced into %s", args[0].Class().String(), self.Class().String()))
+			}
+
+			// integer arithmetic is done on the integer values, so as not to lose precision
+			// and a negative exponent makes a rational out of integers
+			if integers && (name != "**" || b >= 0) {
+				return integerArithmetic(name, self, args[0], provider)
+			}
+			if exactOperands(self, args[0]) {
+				result, ok, err := rationalArithmetic(name, self, args[0], provider)
+				if ok {
+					return result, err
+				}
+			}
+
+			return NewFloat(operation(a, b), provider), nil
+		}))
+	}
+
+	class.AddMethod(NewNativeMethod("step", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+		if block == nil {
+			return NewEnumerator(self, "step", provider, args...), nil
+		}
+
+		limit := args[0]
+		step := Value(NewFixnum(1, provider))
+		if len(args) == 2 {
+			step = args[1]
+		}
+
+		if err := numericStep(self, limit, step, block, provider); err != nil {
+			return nil, err
+		}
+
+		return self, nil
+	}))
+
 	return class
 }
 
+// numericStep calls the block with each number from start to limit, step
+// apart, counting down for a negative step. Integers are counted exactly,
+// and as soon as any of the numbers is a float, floats are yielded instead.
+func numericStep(start, limit, step Value, block Block, provider Provider) error {
+	for _, value := range []Value{limit, step} {
+		if _, ok := numericValue(value); !ok {
+			return NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("comparison of %s with %s failed", start.Class().String(), value.Class().String()),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+	}
+
+	if comparison, _ := compareNumbers(step, NewFixnum(0, provider)); comparison == 0 {
+		return NewException(
+			provider.ClassProvider().ClassWithName("ArgumentError"),
+			"step can't be 0",
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	if isInteger(start) && isInteger(limit) && isInteger(step) {
+		current, _ := bigIntegerOf(start)
+		end, _ := bigIntegerOf(limit)
+		increment, _ := bigIntegerOf(step)
+		for current = new(big.Int).Set(current); current.Cmp(end)*increment.Sign() <= 0; current.Add(current, increment) {
+			if _, err := block.Call(NewInteger(new(big.Int).Set(current), provider)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// like ruby, each float is computed from the start rather than added up,
+	// so that rounding errors do not accumulate or skip the limit
+	begin, _ := numericValue(start)
+	end, _ := numericValue(limit)
+	unit, _ := numericValue(step)
+	n := (end - begin) / unit
+	epsilon := math.Nextafter(1, 2) - 1
+	tolerance := (math.Abs(begin) + math.Abs(end) + math.Abs(end-begin)) / math.Abs(unit) * epsilon
+	n = math.Floor(n + math.Min(tolerance, 0.5))
+	for i := 0.0; i <= n; i++ {
+		value := i*unit + begin
+		if (unit >= 0 && value > end) || (unit < 0 && value < end) {
+			value = end
+		}
+
+		if _, err := block.Call(NewFloat(value, provider)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// numericOperands returns the values of two numbers as floats, and whether
+// they were both integers. ok is false when either one is not a number.
+func numericOperands(self, other Value) (a float64, b float64, integers bool, ok bool) {
+	a, ok = numericValue(self)
+	if !ok {
+		return 0, 0, false, false
+	}
+	b, ok = numericValue(other)
+	return a, b, ok && isInteger(self) && isInteger(other), ok
+}
+
+// whether both numbers are integers or rationals, whose arithmetic is exact
+func exactOperands(self, other Value) bool {
+	_, selfIsExact := bigRationalOf(self)
+	_, otherIsExact := bigRationalOf(other)
+	return selfIsExact && otherIsExact
+}
+
+func numericValue(value Value) (float64, bool) {
+	switch value := value.(type) {
+	case *fixnumInstance:
+		return float64(value.value), true
+	case *BignumValue:
+		float, _ := new(big.Float).SetInt(value.value).Float64()
+		return float, true
+	case *RationalValue:
+		float, _ := value.value.Float64()
+		return float, true
+	case *FloatValue:
+		return value.value, true
+	default:
+		return 0, false
+	}
+}
+
+// compareNumbers returns -1, 0 or 1 as self is less than, equal to or
+// greater than other, and false when other is not a number
+func compareNumbers(self, other Value) (int, bool) {
+	a, b, integers, ok := numericOperands(self, other)
+	if !ok {
+		return 0, false
+	}
+
+	if integers {
+		selfFixnum, selfIsFixnum := self.(*fixnumInstance)
+		otherFixnum, otherIsFixnum := other.(*fixnumInstance)
+		if !selfIsFixnum || !otherIsFixnum {
+			x, _ := bigIntegerOf(self)
+			y, _ := bigIntegerOf(other)
+			return x.Cmp(y), true
+		}
+
+		x, y := selfFixnum.value, otherFixnum.value
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	if exactOperands(self, other) {
+		x, _ := bigRationalOf(self)
+		y, _ := bigRationalOf(other)
+		return x.Cmp(y), true
+	}
+
+	switch {
+	case a < b:
+		return -1, true
+	case a > b:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
 func (c *numericClass) String() string {
 	return "Numeric"
 }
@@ -1,6 +1,11 @@
 package builtins
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
 
 type ObjectClass struct {
 	valueStub
@@ -23,6 +28,185 @@ func NewGlobalObjectClass(provider Provider) Class {
 		}
 	}))
 
+	o.AddMethod(NewNativeMethod("!=", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		equal, err := valuesAreEqual(self, args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return booleanValue(!equal, provider), nil
+	}))
+
+	o.AddMethod(NewNativeMethod("class", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.Class(), nil
+	}))
+
+	o.AddMethod(NewNativeMethod("equal?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return booleanValue(self == args[0], provider), nil
+	}))
+
+	// values that can be hash keys compare their type and value, everything else is compared by identity
+	o.AddMethod(NewNativeMethod("eql?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		eql, err := builtinKeysAreEql(self, args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return booleanValue(eql, provider), nil
+	}))
+
+	// stays the same for as long as the object lives, and is equal for eql? values
+	o.AddMethod(NewNativeMethod("hash", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		code, err := builtinHashOf(self)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewFixnum(int64(code), provider), nil
+	}))
+
+	o.AddMethod(NewNativeMethod("inspect", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		switch self := self.(type) {
+		case *object:
+			return NewString(inspectObject(self), provider), nil
+		case *UserDefinedClassInstance:
+			return NewString(inspectObject(self), provider), nil
+		default:
+			return NewString(self.PrettyPrint(), provider), nil
+		}
+	}))
+
+	o.AddMethod(NewNativeMethod("dup", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		duplicable, ok := self.(Duplicable)
+		if !ok {
+			return self, nil
+		}
+
+		return duplicable.Dup(), nil
+	}))
+
+	o.AddMethod(NewNativeMethod("clone", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		duplicable, ok := self.(Duplicable)
+		if !ok {
+			return self, nil
+		}
+
+		clone := duplicable.Dup()
+		for _, method := range self.eigenclassMethods() {
+			clone.AddMethod(method)
+		}
+
+		if self.IsFrozen() {
+			clone.Freeze()
+		}
+
+		return clone, nil
+	}))
+
+	o.AddMethod(NewNativeMethod("freeze", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		self.Freeze()
+		return self, nil
+	}))
+
+	o.AddMethod(NewNativeMethod("frozen?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return provider.SingletonProvider().SingletonWithName("true"), nil
+		} else {
+			return provider.SingletonProvider().SingletonWithName("false"), nil
+		}
+	}))
+
+	o.AddMethod(NewNativeMethod("tap", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return nil, NewLocalJumpError(provider)
+		}
+
+		_, err := block.Call(self)
+		if err != nil {
+			return nil, err
+		}
+
+		return self, nil
+	}))
+
+	yieldSelf := func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return nil, NewLocalJumpError(provider)
+		}
+
+		return block.Call(self)
+	}
+	o.AddMethod(NewNativeMethod("then", provider, yieldSelf))
+	o.AddMethod(NewNativeMethod("yield_self", provider, yieldSelf))
+
+	o.AddMethod(NewNativeMethod("instance_variable_get", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		name, err := instanceVariableName(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		if value := self.GetInstanceVariable(name); value != nil {
+			return value, nil
+		}
+		if value, ok := self.GetAttribute(name); ok {
+			return value, nil
+		}
+
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}))
+
+	o.AddMethod(NewNativeMethod("instance_variable_set", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		name, err := instanceVariableName(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
+		self.SetInstanceVariable(name, args[1])
+		return args[1], nil
+	}))
+
+	o.AddMethod(NewNativeMethod("instance_variables", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		names, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+
+		withIvars, ok := self.(inspectable)
+		if !ok {
+			return names, nil
+		}
+
+		for _, name := range withIvars.instanceVariableNames() {
+			names.(*Array).Append(NewSymbol("@"+name, provider))
+		}
+		for _, name := range withIvars.attributeNames() {
+			if self.GetInstanceVariable(name) == nil {
+				names.(*Array).Append(NewSymbol("@"+name, provider))
+			}
+		}
+
+		return names, nil
+	}))
+
+	o.AddMethod(NewNativeMethod("methods", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		names := []Value{}
+		seen := map[string]bool{}
+		for _, method := range self.eigenclassMethods() {
+			if method.IsPublic() || method.IsProtected() {
+				seen[method.Name()] = true
+				names = append(names, NewSymbol(method.Name(), provider))
+			}
+		}
+		for _, name := range instanceMethodNames(self.Class(), true) {
+			if !seen[name] {
+				names = append(names, NewSymbol(name, provider))
+			}
+		}
+
+		return newArrayOf(names, provider), nil
+	}))
+
 	o.AddMethod(NewNativeMethod("=~", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		// intended to be implemented by subclasses
 		return provider.SingletonProvider().SingletonWithName("nil"), nil
@@ -31,6 +215,47 @@ func NewGlobalObjectClass(provider Provider) Class {
 	return o
 }
 
+// valuesAreEqual calls the ruby == method of a with b
+func valuesAreEqual(a, b Value, provider Provider) (bool, error) {
+	equalMethod := a.Method("==")
+	if equalMethod == nil {
+		return a == b, nil
+	}
+
+	equal, err := equalMethod.Execute(a, nil, b)
+	if err != nil {
+		return false, err
+	}
+
+	return equal.IsTruthy(), nil
+}
+
+// validates the name given to instance_variable_get and friends (eg: :@foo),
+// returning it without its leading "@", as instance variables are stored
+func instanceVariableName(arg Value, provider Provider) (string, error) {
+	var name string
+	switch arg := arg.(type) {
+	case *SymbolValue:
+		name = arg.Name()
+	case *StringValue:
+		name = arg.RawString()
+	default:
+		return "", errors.New(fmt.Sprintf("TypeError: %s is not a symbol nor a string", arg.String()))
+	}
+
+	if !validInstanceVariableName.MatchString(name) {
+		return "", NewException(
+			provider.ClassProvider().ClassWithName("NameError"),
+			fmt.Sprintf("'%s' is not allowed as an instance variable name", name),
+			provider.StackProvider().CurrentStack(),
+		)
+	}
+
+	return strings.TrimPrefix(name, "@"), nil
+}
+
+var validInstanceVariableName = regexp.MustCompile(`^@[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 func (c *ObjectClass) SetSuperClass() {
 	class := c.provider.ClassProvider().ClassWithName("Class")
 	if class == nil {
@@ -70,3 +295,13 @@ func (obj *ObjectClass) New(provider Provider, args ...Value) (Value, error) {
 
 	return o, nil
 }
+
+func (o *object) Dup() Value {
+	dup := &object{}
+	dup.initialize()
+	dup.setStringer(dup.String)
+	dup.class = o.class
+	dup.copyInstanceStateFrom(&o.valueStub)
+
+	return dup
+}
@@ -0,0 +1,132 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"weak"
+)
+
+// LiveObjects keeps track of the instances of user defined classes for
+// ObjectSpace. Builtin values (strings, numbers, classes...) are left out,
+// as most of them are created by the interpreter rather than the program.
+// The objects are held through weak pointers (which need Go 1.24), so that
+// tracking them does not keep them alive, and those that were collected are
+// forgotten as they are found.
+type LiveObjects struct {
+	pointers []weak.Pointer[UserDefinedClassInstance]
+}
+
+// Track forgets the collected objects when the pointers are full, and makes
+// room for as many again as are still alive, so that a program making many
+// short lived objects neither grows them forever nor prunes them on every call
+func (l *LiveObjects) Track(instance *UserDefinedClassInstance) {
+	if len(l.pointers) == cap(l.pointers) {
+		l.prune()
+		l.pointers = slices.Grow(l.pointers, len(l.pointers))
+	}
+	l.pointers = append(l.pointers, weak.Make(instance))
+}
+
+func (l *LiveObjects) Objects() []Value {
+	l.prune()
+
+	objects := []Value{}
+	for _, pointer := range l.pointers {
+		if instance := pointer.Value(); instance != nil {
+			objects = append(objects, instance)
+		}
+	}
+
+	return objects
+}
+
+func (l *LiveObjects) prune() {
+	l.pointers = slices.DeleteFunc(l.pointers, func(pointer weak.Pointer[UserDefinedClassInstance]) bool {
+		return pointer.Value() == nil
+	})
+}
+
+func NewObjectSpaceModule(provider Provider) Module {
+	module := NewGenericModule("ObjectSpace", provider)
+
+	module.AddMethod(NewNativeMethod("each_object", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "each_object", provider, args...), nil
+		}
+
+		var module Module
+		if len(args) > 0 {
+			var ok bool
+			module, ok = args[0].(Module)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: class or module required, not %s", args[0].Class().String()))
+			}
+		}
+
+		count := 0
+		for _, object := range provider.ObjectSpaceProvider().Objects() {
+			if module != nil && !IsKindOf(object, module) {
+				continue
+			}
+
+			if _, err := block.Call(object); err != nil {
+				return nil, err
+			}
+			count++
+		}
+
+		return NewFixnum(int64(count), provider), nil
+	}))
+
+	module.AddMethod(NewNativeMethod("count_objects", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		count := NewFixnum(int64(len(provider.ObjectSpaceProvider().Objects())), provider)
+
+		hash, _ := provider.ClassProvider().ClassWithName("Hash").New(provider)
+		for _, key := range []string{"TOTAL", "T_OBJECT"} {
+			if err := hash.(*Hash).Add(NewSymbol(key, provider), count); err != nil {
+				return nil, err
+			}
+		}
+		return hash, nil
+	}))
+
+	module.AddMethod(NewNativeMethod("garbage_collect", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		runtime.GC()
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}))
+
+	return module
+}
+
+func NewGCModule(provider Provider) Module {
+	module := NewGenericModule("GC", provider)
+
+	module.AddMethod(NewNativeMethod("start", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		runtime.GC()
+		return provider.SingletonProvider().SingletonWithName("nil"), nil
+	}))
+
+	return module
+}
+
+// IsKindOf is whether the class of value is module, inherits from it or includes it
+func IsKindOf(value Value, module Module) bool {
+	for class := value.Class(); class != nil; class = class.SuperClass() {
+		if Module(class) == module {
+			return true
+		}
+		for _, included := range class.includedModules() {
+			if included == module {
+				return true
+			}
+		}
+
+		if class.String() == "BasicObject" {
+			break
+		}
+	}
+
+	return false
+}
@@ -1,9 +1,10 @@
 package builtins
 
+import "fmt"
+
 type ProcClass struct {
 	valueStub
 	classStub
-	instanceMethods []Method
 
 	singletonProvider SingletonProvider
 }
@@ -34,9 +35,15 @@ func NewProcInstance(methodName string, provider Provider) *Proc {
 	proc := &Proc{methodName: methodName}
 	proc.class = provider.ClassProvider().ClassWithName("Proc")
 	proc.provider = provider
+	proc.initialize()
+	proc.setStringer(proc.String)
 	return proc
 }
 
+func (proc *Proc) String() string {
+	return fmt.Sprintf("#<Proc:(&:%s)>", proc.methodName)
+}
+
 type Proc struct {
 	valueStub
 
@@ -47,7 +54,7 @@ type Proc struct {
 func (proc *Proc) Call(args ...Value) (Value, error) {
 	method := args[0].Method(proc.methodName)
 	if method == nil {
-		return nil, NewNoMethodError(proc.methodName, args[0].String(), args[0].Class().String(), proc.provider.StackProvider().CurrentStack())
+		return nil, NewNoMethodError(proc.methodName, args[0], proc.provider)
 	}
 
 	return method.Execute(args[0], nil)
@@ -0,0 +1,163 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+type rangeClass struct {
+	valueStub
+	classStub
+}
+
+func NewRangeClass(provider Provider) Class {
+	c := &rangeClass{}
+	c.initialize()
+	c.setStringer(c.String)
+	c.class = provider.ClassProvider().ClassWithName("Class")
+	c.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	c.AddMethod(NewNativeMethod("new", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) < 2 {
+			return nil, errors.New(fmt.Sprintf("ArgumentError: wrong number of arguments (%d for 2..3)", len(args)))
+		}
+
+		exclusive := len(args) > 2 && args[2].IsTruthy()
+		return NewRange(args[0], args[1], exclusive, provider), nil
+	}))
+
+	c.AddInstanceMethod(NewNativeMethod("each", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "each", provider), nil
+		}
+
+		selfAsRange := self.(*Range)
+		start, end, err := selfAsRange.integerBounds()
+		if err != nil {
+			return nil, err
+		}
+
+		for i := start; i <= end; i++ {
+			_, err := block.Call(NewFixnum(i, provider))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return self, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("to_a", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.(*Range).isEndless() {
+			return nil, errors.New("RangeError: cannot convert endless range to an array")
+		}
+
+		start, end, err := self.(*Range).integerBounds()
+		if err != nil {
+			return nil, err
+		}
+
+		arr, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		for i := start; i <= end; i++ {
+			arr.(*Array).Append(NewFixnum(i, provider))
+		}
+
+		return arr, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("first", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.(*Range).start, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("last", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.(*Range).end, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("exclude_end?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.(*Range).exclusive {
+			return provider.SingletonProvider().SingletonWithName("true"), nil
+		}
+
+		return provider.SingletonProvider().SingletonWithName("false"), nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("to_s", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.String(), provider), nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("inspect", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.PrettyPrint(), provider), nil
+	}))
+
+	return c
+}
+
+func (c *rangeClass) String() string {
+	return "Range"
+}
+
+func (c *rangeClass) Name() string {
+	return "Range"
+}
+
+func (c *rangeClass) New(provider Provider, args ...Value) (Value, error) {
+	return NewRange(args[0], args[1], false, provider), nil
+}
+
+type Range struct {
+	valueStub
+
+	start     Value
+	end       Value
+	exclusive bool
+}
+
+func NewRange(start, end Value, exclusive bool, provider Provider) Value {
+	r := &Range{start: start, end: end, exclusive: exclusive}
+	r.initialize()
+	r.setStringer(r.String)
+	r.setPrettyPrinter(r.PrettyPrint)
+	r.class = provider.ClassProvider().ClassWithName("Range")
+
+	return r
+}
+
+func (r *Range) operator() string {
+	if r.exclusive {
+		return "..."
+	}
+
+	return ".."
+}
+
+func (r *Range) String() string {
+	return r.start.String() + r.operator() + r.end.String()
+}
+
+func (r *Range) PrettyPrint() string {
+	return inspectOrPrettyPrint(r.start) + r.operator() + inspectOrPrettyPrint(r.end)
+}
+
+// only ranges of integers can be iterated over for now
+// a range ending in Float::INFINITY iterates until the fixnums run out
+func (r *Range) integerBounds() (int64, int64, error) {
+	start, ok := r.start.(*fixnumInstance)
+	if !ok {
+		return 0, 0, errors.New(fmt.Sprintf("TypeError: can't iterate from %s", r.start.Class().String()))
+	}
+
+	if r.isEndless() {
+		return start.value, math.MaxInt64, nil
+	}
+
+	end, ok := r.end.(*fixnumInstance)
+	if !ok {
+		return 0, 0, errors.New(fmt.Sprintf("TypeError: can't iterate to %s", r.end.Class().String()))
+	}
+
+	if r.exclusive {
+		return start.value, end.value - 1, nil
+	}
+
+	return start.value, end.value, nil
+}
+
+func (r *Range) isEndless() bool {
+	end, ok := r.end.(*FloatValue)
+	return ok && math.IsInf(end.value, 1)
+}
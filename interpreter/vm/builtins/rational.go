@@ -0,0 +1,177 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+type rationalClass struct {
+	valueStub
+	classStub
+}
+
+func NewRationalClass(provider Provider) Class {
+	class := &rationalClass{}
+	class.initialize()
+	class.setStringer(class.String)
+	class.class = provider.ClassProvider().ClassWithName("Class")
+	class.superClass = provider.ClassProvider().ClassWithName("Numeric")
+
+	class.AddMethod(NewNativeMethod("numerator", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewInteger(new(big.Int).Set(self.(*RationalValue).value.Num()), provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("denominator", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewInteger(new(big.Int).Set(self.(*RationalValue).value.Denom()), provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("to_f", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		float, _ := self.(*RationalValue).value.Float64()
+		return NewFloat(float, provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("to_i", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		value := self.(*RationalValue).value
+		return NewInteger(new(big.Int).Quo(value.Num(), value.Denom()), provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("to_r", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self, nil
+	}))
+	class.AddMethod(NewNativeMethod("-@", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewRational(new(big.Rat).Neg(self.(*RationalValue).value), provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("to_s", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.String(), provider), nil
+	}))
+	class.AddMethod(NewNativeMethod("inspect", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.PrettyPrint(), provider), nil
+	}))
+
+	return class
+}
+
+func (c *rationalClass) String() string {
+	return "Rational"
+}
+
+func (c *rationalClass) Name() string {
+	return "Rational"
+}
+
+func (c *rationalClass) New(provider Provider, args ...Value) (Value, error) {
+	return nil, errors.New("undefined method 'new' for Rational:Class")
+}
+
+// RationalValue is an exact fraction, always kept in its lowest terms
+// unlike integers, a rational is never demoted, so Rational(4, 2) is (2/1)
+type RationalValue struct {
+	value *big.Rat
+	valueStub
+}
+
+func (r *RationalValue) Value() *big.Rat {
+	return new(big.Rat).Set(r.value)
+}
+
+func (r *RationalValue) String() string {
+	return r.value.String()
+}
+
+func NewRational(value *big.Rat, provider Provider) Value {
+	r := &RationalValue{value: value}
+	r.class = provider.ClassProvider().ClassWithName("Rational")
+	r.initialize()
+	r.setStringer(r.String)
+	r.setPrettyPrinter(func() string { return fmt.Sprintf("(%s)", r.String()) })
+	r.Freeze()
+	return r
+}
+
+// the exact value of an integer or a rational
+func bigRationalOf(value Value) (*big.Rat, bool) {
+	if rational, ok := value.(*RationalValue); ok {
+		return rational.value, true
+	}
+
+	integer, ok := bigIntegerOf(value)
+	if !ok {
+		return nil, false
+	}
+
+	return new(big.Rat).SetInt(integer), true
+}
+
+// rationalArithmetic is used when both operands are exact and at least one
+// of them is a rational. A power is only exact for an integer exponent,
+// ok is false for any other, and the caller should use floats instead.
+func rationalArithmetic(name string, self, other Value, provider Provider) (result Value, ok bool, err error) {
+	x, _ := bigRationalOf(self)
+	y, _ := bigRationalOf(other)
+
+	value := new(big.Rat)
+	switch name {
+	case "+":
+		value.Add(x, y)
+	case "-":
+		value.Sub(x, y)
+	case "*":
+		value.Mul(x, y)
+	case "/":
+		if y.Sign() == 0 {
+			return nil, true, NewException(
+				provider.ClassProvider().ClassWithName("ZeroDivisionError"),
+				"divided by 0",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+		value.Quo(x, y)
+	case "**":
+		if !y.IsInt() {
+			return nil, false, nil
+		}
+		return rationalPower(x, y.Num(), provider)
+	}
+
+	return NewRational(value, provider), true, nil
+}
+
+func rationalPower(base *big.Rat, exponent *big.Int, provider Provider) (Value, bool, error) {
+	numerator := new(big.Int).Exp(base.Num(), new(big.Int).Abs(exponent), nil)
+	denominator := new(big.Int).Exp(base.Denom(), new(big.Int).Abs(exponent), nil)
+	if exponent.Sign() < 0 {
+		if numerator.Sign() == 0 {
+			return nil, true, NewException(
+				provider.ClassProvider().ClassWithName("ZeroDivisionError"),
+				"divided by 0",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+		numerator, denominator = denominator, numerator
+	}
+
+	return NewRational(new(big.Rat).SetFrac(numerator, denominator), provider), true, nil
+}
+
+// implements Kernel#Rational, and only accepts exact numbers and strings
+func convertToRational(arg Value, provider Provider) (*big.Rat, error) {
+	if value, ok := bigRationalOf(arg); ok {
+		return value, nil
+	}
+
+	switch arg := arg.(type) {
+	case *FloatValue:
+		value := new(big.Rat)
+		if value.SetFloat64(arg.value) == nil {
+			return nil, errors.New(fmt.Sprintf("FloatDomainError: %s", arg.String()))
+		}
+		return value, nil
+	case *StringValue:
+		value, ok := new(big.Rat).SetString(arg.RawString())
+		if !ok {
+			return nil, conversionArgumentError("Rational", arg, provider)
+		}
+		return value, nil
+	case *nilInstance:
+		return nil, errors.New("TypeError: can't convert nil into Rational")
+	default:
+		return nil, errors.New(fmt.Sprintf("TypeError: can't convert %s into Rational", arg.Class().String()))
+	}
+}
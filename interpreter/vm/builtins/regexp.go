@@ -5,12 +5,12 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 )
 
 type regexpClass struct {
 	valueStub
 	classStub
-	instanceMethods []Method
 }
 
 func NewRegexpClass(provider Provider) Class {
@@ -27,19 +27,28 @@ func NewRegexpClass(provider Provider) Class {
 		return NewString(strings.Replace(quoted, " ", "\\ ", -1), provider), nil
 	}))
 	c.AddMethod(NewNativeMethod("match", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		selfAsRegexp := self.(*Regexp)
 		str := args[0].(*StringValue)
 
-		regex, err := regexp.Compile(selfAsRegexp.expression)
+		regex, err := compileRegexp(self.(*Regexp))
 		if err != nil {
-			return nil, errors.New(fmt.Sprintf("something wrong with your regexp, bub -- %s", selfAsRegexp.expression))
+			return nil, err
 		}
 
-		if regex.MatchString(str.String()) {
-			return provider.SingletonProvider().SingletonWithName("true"), nil
-		} else {
-			return provider.SingletonProvider().SingletonWithName("false"), nil
+		return match(regex, str.value, provider), nil
+	}))
+
+	c.AddMethod(NewNativeMethod("=~", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		str, ok := args[0].(*StringValue)
+		if !ok {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		regex, err := compileRegexp(self.(*Regexp))
+		if err != nil {
+			return nil, err
 		}
+
+		return matchIndex(regex, str.value, provider), nil
 	}))
 
 	return c
@@ -79,3 +88,47 @@ func NewRegexp(provider Provider, expr string) Value {
 
 	return a
 }
+
+func compileRegexp(r *Regexp) (*regexp.Regexp, error) {
+	regex, err := regexp.Compile(r.expression)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("something wrong with your regexp, bub -- %s", r.expression))
+	}
+
+	return regex, nil
+}
+
+// the pattern given to String#match, #scan and friends
+// a string matches itself literally, rather than as a regular expression
+func patternOf(arg Value) (*regexp.Regexp, error) {
+	switch arg := arg.(type) {
+	case *Regexp:
+		return compileRegexp(arg)
+	case *StringValue:
+		return regexp.MustCompile(regexp.QuoteMeta(arg.value)), nil
+	default:
+		return nil, errors.New(fmt.Sprintf("TypeError: wrong argument type %s (expected Regexp)", arg.Class().String()))
+	}
+}
+
+// the MatchData for the first match of regex in str, or nil
+// like in ruby, the result is also kept in $~
+func match(regex *regexp.Regexp, str string, provider Provider) Value {
+	var result Value = provider.SingletonProvider().SingletonWithName("nil")
+	if indices := regex.FindStringSubmatchIndex(str); indices != nil {
+		result = NewMatchData(regex, str, indices, provider)
+	}
+
+	provider.GlobalProvider().SetGlobal("~", result)
+	return result
+}
+
+// the character index of the first match of regex in str, or nil
+func matchIndex(regex *regexp.Regexp, str string, provider Provider) Value {
+	result, ok := match(regex, str, provider).(*MatchDataValue)
+	if !ok {
+		return provider.SingletonProvider().SingletonWithName("nil")
+	}
+
+	return NewFixnum(int64(utf8.RuneCountInString(str[:result.indices[0]])), provider)
+}
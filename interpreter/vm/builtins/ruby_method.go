@@ -3,6 +3,7 @@ package builtins
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/grubby/grubby/ast"
 )
@@ -58,6 +59,13 @@ func NewRubyMethod(
 	return m
 }
 
+func (method *RubyMethod) Class() Class {
+	if method.class == nil {
+		method.class = method.classProvider.ClassWithName("Method")
+	}
+	return method.class
+}
+
 func NewPrivateRubyMethod(
 	name string,
 	lineNumber int,
@@ -109,13 +117,33 @@ func (method *RubyMethod) Args() []methodArg {
 	return method.invocationArgs
 }
 
+func (method *RubyMethod) Params() []ast.MethodParam {
+	return method.args
+}
+
 func (method *RubyMethod) Body() []ast.Node {
 	return method.unevaluatedBody
 }
 
 func (method *RubyMethod) Execute(self Value, block Block, args ...Value) (Value, error) {
+	err := method.BindArgs(self, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { method.invocationArgs = nil }()
+
+	return method.body(self, method)
+}
+
+// BindArgs matches the args given to the parameters of the method, so that
+// they are available from Args() while its body runs
+func (method *RubyMethod) BindArgs(self Value, args ...Value) error {
+	positional, keywords := method.partitionParams()
+	args, kwargs := method.extractKeywordArgs(keywords, args)
+
 	method.invocationArgs = make([]methodArg, 0, len(args))
-	for index, arg := range method.args {
+	for index, arg := range positional {
 
 		var (
 			argValue Value
@@ -125,7 +153,7 @@ func (method *RubyMethod) Execute(self Value, block Block, args ...Value) (Value
 		if arg.IsSplat {
 			argValue, err = method.classProvider.ClassWithName("Array").New(method.provider)
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			for i := index; i < len(args); i++ {
@@ -136,10 +164,10 @@ func (method *RubyMethod) Execute(self Value, block Block, args ...Value) (Value
 				if arg.DefaultValue != nil {
 					argValue, err = method.evaluator.EvaluateArgInContext(arg.DefaultValue, self)
 					if err != nil {
-						return nil, err
+						return err
 					}
 				} else {
-					return nil, errors.New(fmt.Sprintf("expected to invoke a method '%s' on '%s' with %d args, but we were only provided %d", method, self, len(method.args), len(args)))
+					return errors.New(fmt.Sprintf("expected to invoke a method '%s' on '%s' with %d args, but we were only provided %d", method, self, len(method.args), len(args)))
 				}
 			} else {
 				argValue = args[index]
@@ -153,11 +181,112 @@ func (method *RubyMethod) Execute(self Value, block Block, args ...Value) (Value
 		method.invocationArgs = append(method.invocationArgs, argument)
 	}
 
-	method.stackProvider.UnshiftStackFrame(method.name, "fixme -- method name goes here", method.lineNumber)
-	defer method.stackProvider.ShiftStackFrame()
-	defer func() { method.invocationArgs = nil }()
+	keywordArgs, err := method.bindKeywordArgs(self, keywords, kwargs)
+	if err != nil {
+		return err
+	}
+	method.invocationArgs = append(method.invocationArgs, keywordArgs...)
 
-	return method.body(self, method)
+	return nil
+}
+
+func (method *RubyMethod) partitionParams() ([]ast.MethodParam, []ast.MethodParam) {
+	positional := []ast.MethodParam{}
+	keywords := []ast.MethodParam{}
+	for _, param := range method.args {
+		if param.IsKeyword {
+			keywords = append(keywords, param)
+		} else {
+			positional = append(positional, param)
+		}
+	}
+
+	return positional, keywords
+}
+
+// a trailing hash with only symbol keys is treated as the keyword arguments
+// when the method declares any keywords
+func (method *RubyMethod) extractKeywordArgs(keywords []ast.MethodParam, args []Value) ([]Value, *Hash) {
+	if len(keywords) == 0 || len(args) == 0 {
+		return args, nil
+	}
+
+	hash, ok := args[len(args)-1].(*Hash)
+	if !ok {
+		return args, nil
+	}
+
+	for _, entry := range hash.entries {
+		if _, ok := entry.key.(*SymbolValue); !ok {
+			return args, nil
+		}
+	}
+
+	return args[:len(args)-1], hash
+}
+
+func (method *RubyMethod) bindKeywordArgs(self Value, keywords []ast.MethodParam, kwargs *Hash) ([]methodArg, error) {
+	bound := []methodArg{}
+	missing := []string{}
+	known := map[string]bool{}
+
+	for _, keyword := range keywords {
+		known[keyword.Name] = true
+
+		if kwargs != nil {
+			value, ok, err := kwargs.Get(NewSymbol(keyword.Name, method.provider))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				bound = append(bound, methodArg{Name: keyword.Name, Value: value})
+				continue
+			}
+		}
+
+		if keyword.DefaultValue == nil {
+			missing = append(missing, ":"+keyword.Name)
+			continue
+		}
+
+		value, err := method.evaluator.EvaluateArgInContext(keyword.DefaultValue, self)
+		if err != nil {
+			return nil, err
+		}
+		bound = append(bound, methodArg{Name: keyword.Name, Value: value})
+	}
+
+	if len(missing) == 1 {
+		return nil, method.argumentError("missing keyword: " + missing[0])
+	} else if len(missing) > 1 {
+		return nil, method.argumentError("missing keywords: " + strings.Join(missing, ", "))
+	}
+
+	if kwargs != nil {
+		unknown := []string{}
+		for _, entry := range kwargs.entries {
+			name := entry.key.(*SymbolValue).Name()
+			if !known[name] {
+				unknown = append(unknown, ":"+name)
+			}
+		}
+
+		if len(unknown) == 1 {
+			return nil, method.argumentError("unknown keyword: " + unknown[0])
+		} else if len(unknown) > 1 {
+			return nil, method.argumentError("unknown keywords: " + strings.Join(unknown, ", "))
+		}
+	}
+
+	return bound, nil
+}
+
+func (method *RubyMethod) argumentError(message string) error {
+	return NewException(
+		method.classProvider.ClassWithName("ArgumentError"),
+		message,
+		method.stackProvider.CurrentStack(),
+	)
 }
 
 // FIXME: in order to fix this, the method needs to know what it is attached to
@@ -176,3 +305,7 @@ func (method *RubyMethod) SetVisibility(visibility MethodVisibility) {
 func (method *RubyMethod) methodBody() func(self Value, block Block, args ...Value) (Value, error) {
 	return method.Execute
 }
+
+func (method *RubyMethod) methodCache() *MethodCache {
+	return method.classProvider.MethodCache()
+}
@@ -0,0 +1,320 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type setClass struct {
+	valueStub
+	classStub
+}
+
+func NewSetClass(provider Provider) Class {
+	c := &setClass{}
+	c.initialize()
+	c.setStringer(c.String)
+	c.class = provider.ClassProvider().ClassWithName("Class")
+	c.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	c.AddMethod(NewNativeMethod("[]", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		set := newSet(self.(Class), provider)
+		for _, arg := range args {
+			if err := set.add(arg); err != nil {
+				return nil, err
+			}
+		}
+
+		return set, nil
+	}))
+
+	add := func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
+		if err := self.(*Set).add(args[0]); err != nil {
+			return nil, err
+		}
+		return self, nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("add", provider, add))
+	c.AddInstanceMethod(NewNativeMethod("<<", provider, add))
+	c.AddInstanceMethod(NewNativeMethod("add?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
+		included, err := self.(*Set).includes(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if included {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		if err := self.(*Set).add(args[0]); err != nil {
+			return nil, err
+		}
+		return self, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("delete", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if self.IsFrozen() {
+			return nil, NewFrozenError(self, provider)
+		}
+
+		if err := self.(*Set).delete(args[0]); err != nil {
+			return nil, err
+		}
+		return self, nil
+	}))
+
+	includes := func(self Value, block Block, args ...Value) (Value, error) {
+		included, err := self.(*Set).includes(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return booleanValue(included, provider), nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("include?", provider, includes))
+	c.AddInstanceMethod(NewNativeMethod("member?", provider, includes))
+
+	size := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(self.(*Set).elements.Len()), provider), nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("size", provider, size))
+	c.AddInstanceMethod(NewNativeMethod("length", provider, size))
+	c.AddInstanceMethod(NewNativeMethod("empty?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return booleanValue(self.(*Set).elements.Len() == 0, provider), nil
+	}))
+
+	c.AddInstanceMethod(NewNativeMethod("each", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return NewEnumerator(self, "each", provider), nil
+		}
+
+		for _, member := range self.(*Set).members() {
+			_, err := block.Call(member)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return self, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("to_a", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return newArrayOf(self.(*Set).members(), provider), nil
+	}))
+
+	union := func(self Value, block Block, args ...Value) (Value, error) {
+		other, err := setOperand(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		result := newSet(self.Class(), provider)
+		for _, member := range append(self.(*Set).members(), other...) {
+			if err := result.add(member); err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("|", provider, union))
+	c.AddInstanceMethod(NewNativeMethod("+", provider, union))
+	c.AddInstanceMethod(NewNativeMethod("union", provider, union))
+
+	intersection := func(self Value, block Block, args ...Value) (Value, error) {
+		other, err := setOperand(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		result := newSet(self.Class(), provider)
+		for _, member := range other {
+			included, err := self.(*Set).includes(member)
+			if err != nil {
+				return nil, err
+			}
+			if !included {
+				continue
+			}
+			if err := result.add(member); err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("&", provider, intersection))
+	c.AddInstanceMethod(NewNativeMethod("intersection", provider, intersection))
+
+	difference := func(self Value, block Block, args ...Value) (Value, error) {
+		other, err := setOperand(args[0], provider)
+		if err != nil {
+			return nil, err
+		}
+
+		result := newSet(self.Class(), provider)
+		for _, member := range self.(*Set).members() {
+			if err := result.add(member); err != nil {
+				return nil, err
+			}
+		}
+		for _, member := range other {
+			if err := result.delete(member); err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("-", provider, difference))
+	c.AddInstanceMethod(NewNativeMethod("difference", provider, difference))
+
+	c.AddInstanceMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		other, ok := args[0].(*Set)
+		if !ok || other.elements.Len() != self.(*Set).elements.Len() {
+			return booleanValue(false, provider), nil
+		}
+
+		for _, member := range other.members() {
+			included, err := self.(*Set).includes(member)
+			if err != nil {
+				return nil, err
+			}
+			if !included {
+				return booleanValue(false, provider), nil
+			}
+		}
+
+		return booleanValue(true, provider), nil
+	}))
+	inspect := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.PrettyPrint(), provider), nil
+	}
+	c.AddInstanceMethod(NewNativeMethod("inspect", provider, inspect))
+	c.AddInstanceMethod(NewNativeMethod("to_s", provider, inspect))
+
+	return c
+}
+
+func (c *setClass) String() string {
+	return "Set"
+}
+
+func (c *setClass) Name() string {
+	return "Set"
+}
+
+// Set.new takes an optional collection of initial members
+func (c *setClass) New(provider Provider, args ...Value) (Value, error) {
+	set := newSet(c, provider)
+	if len(args) == 0 || args[0] == provider.SingletonProvider().SingletonWithName("nil") {
+		return set, nil
+	}
+
+	members, err := setOperand(args[0], provider)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if err := set.add(member); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+// Set stores its members as the keys of a Hash, so that they are
+// deduplicated using eql? and hash, in insertion order
+type Set struct {
+	valueStub
+
+	provider Provider
+	elements *Hash
+}
+
+func newSet(class Class, provider Provider) *Set {
+	hash, _ := provider.ClassProvider().ClassWithName("Hash").New(provider)
+
+	s := &Set{provider: provider, elements: hash.(*Hash)}
+	s.initialize()
+	s.setStringer(s.String)
+	s.setPrettyPrinter(s.PrettyPrint)
+	s.class = class
+
+	return s
+}
+
+func (s *Set) Dup() Value {
+	dup := newSet(s.class, s.provider)
+	dup.elements = s.elements.Dup().(*Hash)
+	dup.copyInstanceStateFrom(&s.valueStub)
+
+	return dup
+}
+
+func (s *Set) add(member Value) error {
+	return s.elements.Add(member, s.provider.SingletonProvider().SingletonWithName("true"))
+}
+
+func (s *Set) includes(member Value) (bool, error) {
+	_, ok, err := s.elements.Get(member)
+	return ok, err
+}
+
+func (s *Set) delete(member Value) error {
+	_, _, err := s.elements.Delete(member)
+	return err
+}
+
+func (s *Set) members() []Value {
+	members := make([]Value, 0, s.elements.Len())
+	for _, entry := range s.elements.entries {
+		members = append(members, entry.key)
+	}
+
+	return members
+}
+
+func (s *Set) String() string {
+	return s.PrettyPrint()
+}
+
+func (s *Set) PrettyPrint() string {
+	if !s.beginInspecting() {
+		return "#<Set: {...}>"
+	}
+	defer s.endInspecting()
+
+	pieces := []string{}
+	for _, member := range s.members() {
+		pieces = append(pieces, inspectOrPrettyPrint(member))
+	}
+
+	return fmt.Sprintf("#<Set: {%s}>", strings.Join(pieces, ", "))
+}
+
+// the members of the argument given to the set operators, which can be
+// another set, or any collection that responds to each
+func setOperand(arg Value, provider Provider) ([]Value, error) {
+	if set, ok := arg.(*Set); ok {
+		return set.members(), nil
+	}
+
+	if arg.Method("each") == nil {
+		return nil, errors.New(fmt.Sprintf("ArgumentError: value must be enumerable"))
+	}
+
+	members := []Value{}
+	err := eachElement(arg, provider, func(member Value) error {
+		members = append(members, member)
+		return nil
+	})
+
+	return members, err
+}
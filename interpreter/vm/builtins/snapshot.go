@@ -0,0 +1,98 @@
+package builtins
+
+// Snapshot records what a program can change about a value: its methods
+// (and their visibility), instance variables and frozen state, and for a
+// module or class its instance methods, constants, included modules and
+// class variables. The func returned puts all of them back as they were.
+func Snapshot(value Value) func() {
+	restores := []func(){}
+
+	if v, ok := value.(interface{ snapshotValue() func() }); ok {
+		restores = append(restores, v.snapshotValue())
+	}
+	if m, ok := value.(interface{ snapshotModule() func() }); ok {
+		restores = append(restores, m.snapshotModule())
+	}
+	if c, ok := value.(interface{ snapshotClass() func() }); ok {
+		restores = append(restores, c.snapshotClass())
+	}
+
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+		methodCacheOfValue(value).changed()
+	}
+}
+
+func (valueStub *valueStub) snapshotValue() func() {
+	methods := snapshotMethods(valueStub.eigenclass_methods)
+	variables := copyValues(valueStub.instance_variables)
+	names := append([]string{}, valueStub.instance_variable_names...)
+	attrs := copyValues(valueStub.attrs)
+	frozen := valueStub.frozen
+
+	return func() {
+		valueStub.eigenclass_methods = methods()
+		valueStub.instance_variables = copyValues(variables)
+		valueStub.instance_variable_names = append([]string{}, names...)
+		valueStub.attrs = copyValues(attrs)
+		valueStub.frozen = frozen
+	}
+}
+
+func (m *moduleStub) snapshotModule() func() {
+	methods := snapshotMethods(m.instanceMethods)
+	constants := copyValues(m.constants)
+	visibility := m.methodVisibility
+
+	return func() {
+		m.instanceMethods = methods()
+		m.constants = copyValues(constants)
+		m.methodVisibility = visibility
+	}
+}
+
+func (classStub *classStub) snapshotClass() func() {
+	modules := append([]Module{}, classStub._included_modules...)
+	classVars := copyValues(classStub._classVars)
+
+	return func() {
+		classStub._included_modules = append([]Module{}, modules...)
+		classStub._classVars = copyValues(classVars)
+	}
+}
+
+// snapshotMethods returns a func giving a copy of the method table as it
+// is now, with the visibility each method has now
+func snapshotMethods(table map[string]Method) func() map[string]Method {
+	methods := make(map[string]Method, len(table))
+	visibilities := make(map[string]MethodVisibility, len(table))
+	for name, method := range table {
+		methods[name] = method
+		visibilities[name] = method.Visibility()
+	}
+
+	return func() map[string]Method {
+		copied := make(map[string]Method, len(methods))
+		for name, method := range methods {
+			method.SetVisibility(visibilities[name])
+			copied[name] = method
+		}
+
+		return copied
+	}
+}
+
+func copyValues(values map[string]Value) map[string]Value {
+	if values == nil {
+		return nil
+	}
+
+	copied := make(map[string]Value, len(values))
+	for name, value := range values {
+		copied[name] = value
+	}
+
+	return copied
+}
@@ -0,0 +1,5 @@
+package builtins
+
+func NewStopIterationClass(provider Provider) Class {
+	return NewGenericClass("StopIteration", "IndexError", provider)
+}
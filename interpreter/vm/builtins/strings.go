@@ -3,10 +3,12 @@ package builtins
 import (
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
+const rubyWhitespace = " \t\n\v\f\r\x00"
+
 type StringClass struct {
 	valueStub
 	classStub
@@ -28,6 +30,21 @@ func NewStringClass(provider Provider) Class {
 		selfAsStr := self.(*StringValue)
 		return NewString(selfAsStr.value+arg.value, provider), nil
 	}))
+	s.AddMethod(NewNativeMethod("*", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		count, ok := args[0].(*fixnumInstance)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", args[0].Class().String()))
+		}
+		if count.value < 0 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				"negative argument",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		return NewString(strings.Repeat(self.(*StringValue).value, int(count.value)), provider), nil
+	}))
 	s.AddMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		asStr, ok := args[0].(*StringValue)
 		if !ok {
@@ -41,6 +58,14 @@ func NewStringClass(provider Provider) Class {
 			return provider.SingletonProvider().SingletonWithName("false"), nil
 		}
 	}))
+	s.AddMethod(NewNativeMethod("<=>", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		asStr, ok := args[0].(*StringValue)
+		if !ok {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		return NewFixnum(int64(strings.Compare(self.(*StringValue).value, asStr.value)), provider), nil
+	}))
 	s.AddMethod(NewNativeMethod("===", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		asStr, ok := args[0].(*StringValue)
 		if !ok {
@@ -54,38 +79,42 @@ func NewStringClass(provider Provider) Class {
 			return provider.SingletonProvider().SingletonWithName("false"), nil
 		}
 	}))
+	s.AddMethod(NewNativeMethod("%", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		values := args
+		if array, ok := args[0].(*Array); ok {
+			values = array.Members()
+		}
+
+		formatted, err := formatValues(self.(*StringValue).RawString(), values, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewString(formatted, provider), nil
+	}))
 	s.AddMethod(NewNativeMethod("<<", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		arg := args[0].(*StringValue)
 		selfAsStr := self.(*StringValue)
-		if selfAsStr.frozen {
-			return nil, errors.New("RuntimeError: can't modify frozen String")
+		if selfAsStr.IsFrozen() {
+			return nil, NewFrozenError(selfAsStr, provider)
 		}
 
 		selfAsStr.value += arg.value
 		return selfAsStr, nil
 	}))
 	s.AddMethod(NewNativeMethod("to_i", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		selfAsStr := self.(*StringValue)
-
-		intValue, _ := strconv.ParseInt(selfAsStr.value, 0, 64)
-		return NewFixnum(intValue, provider), nil
-	}))
-	s.AddMethod(NewNativeMethod("freeze", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		selfAsStr := self.(*StringValue)
-		selfAsStr.frozen = true
-		return selfAsStr, nil
-	}))
-	s.AddMethod(NewNativeMethod("intern", provider, func(self Value, block Block, args ...Value) (Value, error) {
-		selfAsStr := self.(*StringValue)
-		maybeSymbol := provider.SingletonProvider().SymbolWithName(selfAsStr.value)
-		if maybeSymbol != nil {
-			return maybeSymbol, nil
+		value, ok := parseInteger(self.(*StringValue).value, 0, provider)
+		if !ok {
+			return NewFixnum(0, provider), nil
 		}
 
-		symbolFromString := NewSymbol(selfAsStr.value, provider)
-		provider.SingletonProvider().AddSymbol(symbolFromString)
-		return symbolFromString, nil
+		return value, nil
 	}))
+	intern := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewSymbol(self.(*StringValue).value, provider), nil
+	}
+	s.AddMethod(NewNativeMethod("intern", provider, intern))
+	s.AddMethod(NewNativeMethod("to_sym", provider, intern))
 	s.AddMethod(NewNativeMethod("split", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		selfAsStr := self.(*StringValue)
 		separator := args[0].(*StringValue)
@@ -104,13 +133,362 @@ func NewStringClass(provider Provider) Class {
 
 		return array, nil
 	}))
+	s.AddMethod(NewNativeMethod("match", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		regex, err := patternOf(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return match(regex, self.(*StringValue).value, provider), nil
+	}))
+	s.AddMethod(NewNativeMethod("=~", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if _, ok := args[0].(*StringValue); ok {
+			return nil, errors.New("TypeError: wrong argument type String (expected Regexp)")
+		}
+
+		regex, err := patternOf(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return matchIndex(regex, self.(*StringValue).value, provider), nil
+	}))
+	// without groups, scan finds the matches themselves, and with groups it
+	// finds an array of the groups for each match
+	s.AddMethod(NewNativeMethod("scan", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		regex, err := patternOf(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		str := self.(*StringValue).value
+		results := []Value{}
+		for _, indices := range regex.FindAllStringSubmatchIndex(str, -1) {
+			match := NewMatchData(regex, str, indices, provider).(*MatchDataValue)
+
+			var result Value = match.Group(0, provider)
+			if regex.NumSubexp() > 0 {
+				result = newArrayOf(match.captures(provider), provider)
+			}
+
+			if block != nil {
+				if _, err := block.Call(result); err != nil {
+					return nil, err
+				}
+			}
+			results = append(results, result)
+		}
+
+		if block != nil {
+			return self, nil
+		}
+
+		return newArrayOf(results, provider), nil
+	}))
 	s.AddMethod(NewNativeMethod("encode", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		return self, nil // FIXME
 	}))
+	chomp := func(self Value, block Block, args ...Value) (Value, error) {
+		selfAsStr := self.(*StringValue)
+		str := selfAsStr.value
+
+		if len(args) == 0 {
+			switch {
+			case strings.HasSuffix(str, "\r\n"):
+				str = str[:len(str)-2]
+			case strings.HasSuffix(str, "\n"), strings.HasSuffix(str, "\r"):
+				str = str[:len(str)-1]
+			}
+
+			return NewString(str, provider), nil
+		}
+
+		suffix, ok := args[0].(*StringValue)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into String", args[0].Class().String()))
+		}
+
+		if suffix.value == "" {
+			for strings.HasSuffix(str, "\n") {
+				str = strings.TrimSuffix(strings.TrimSuffix(str, "\n"), "\r")
+			}
+		} else {
+			str = strings.TrimSuffix(str, suffix.value)
+		}
+
+		return NewString(str, provider), nil
+	}
+	s.AddMethod(NewNativeMethod("chomp", provider, chomp))
+	s.AddMethod(NewNativeMethod("chomp!", provider, mutating(provider, chomp)))
+	strip := func(self Value, block Block, args ...Value) (Value, error) {
+		selfAsStr := self.(*StringValue)
+		return NewString(strings.Trim(selfAsStr.value, rubyWhitespace), provider), nil
+	}
+	s.AddMethod(NewNativeMethod("strip", provider, strip))
+	s.AddMethod(NewNativeMethod("strip!", provider, mutating(provider, strip)))
+	lstrip := func(self Value, block Block, args ...Value) (Value, error) {
+		selfAsStr := self.(*StringValue)
+		return NewString(strings.TrimLeft(selfAsStr.value, rubyWhitespace), provider), nil
+	}
+	s.AddMethod(NewNativeMethod("lstrip", provider, lstrip))
+	s.AddMethod(NewNativeMethod("lstrip!", provider, mutating(provider, lstrip)))
+	rstrip := func(self Value, block Block, args ...Value) (Value, error) {
+		selfAsStr := self.(*StringValue)
+		return NewString(strings.TrimRight(selfAsStr.value, rubyWhitespace), provider), nil
+	}
+	s.AddMethod(NewNativeMethod("rstrip", provider, rstrip))
+	s.AddMethod(NewNativeMethod("rstrip!", provider, mutating(provider, rstrip)))
+	upcase := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(strings.ToUpper(self.(*StringValue).value), provider), nil
+	}
+	s.AddMethod(NewNativeMethod("upcase", provider, upcase))
+	s.AddMethod(NewNativeMethod("upcase!", provider, mutating(provider, upcase)))
+	downcase := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(strings.ToLower(self.(*StringValue).value), provider), nil
+	}
+	s.AddMethod(NewNativeMethod("downcase", provider, downcase))
+	s.AddMethod(NewNativeMethod("downcase!", provider, mutating(provider, downcase)))
+	reverse := func(self Value, block Block, args ...Value) (Value, error) {
+		runes := []rune(self.(*StringValue).value)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+
+		return NewString(string(runes), provider), nil
+	}
+	s.AddMethod(NewNativeMethod("reverse", provider, reverse))
+	s.AddMethod(NewNativeMethod("reverse!", provider, mutating(provider, reverse)))
+	// the replacement is either a string, which can refer to the groups of
+	// each match as \0 to \9, or the result of calling the block with the match
+	gsub := func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		regex, err := patternOf(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		var replacement *StringValue
+		if len(args) == 2 {
+			var ok bool
+			replacement, ok = args[1].(*StringValue)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into String", args[1].Class().String()))
+			}
+		}
+
+		str := self.(*StringValue).value
+		result := ""
+		last := 0
+		for _, indices := range regex.FindAllStringSubmatchIndex(str, -1) {
+			result += str[last:indices[0]]
+			last = indices[1]
+
+			if replacement != nil {
+				result += expandReplacement(replacement.value, str, indices)
+				continue
+			}
+
+			match := NewMatchData(regex, str, indices, provider).(*MatchDataValue)
+			provider.GlobalProvider().SetGlobal("~", match)
+			value, err := block.Call(match.Group(0, provider))
+			if err != nil {
+				return nil, err
+			}
+			result += value.String()
+		}
+
+		return NewString(result+str[last:], provider), nil
+	}
+	// with neither a replacement nor a block, gsub enumerates the matches
+	enumeratingMatches := func(name string, method func(Value, Block, ...Value) (Value, error)) func(Value, Block, ...Value) (Value, error) {
+		return func(self Value, block Block, args ...Value) (Value, error) {
+			if len(args) == 1 && block == nil {
+				return NewEnumerator(self, name, provider, args...), nil
+			}
+
+			return method(self, block, args...)
+		}
+	}
+	s.AddMethod(NewNativeMethod("gsub", provider, enumeratingMatches("gsub", gsub)))
+	s.AddMethod(NewNativeMethod("gsub!", provider, enumeratingMatches("gsub!", mutating(provider, gsub))))
+	s.AddMethod(NewNativeMethod("replace", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		selfAsStr := self.(*StringValue)
+		if selfAsStr.IsFrozen() {
+			return nil, NewFrozenError(selfAsStr, provider)
+		}
+
+		arg, ok := args[0].(*StringValue)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into String", args[0].Class().String()))
+		}
+
+		selfAsStr.value = arg.value
+		return selfAsStr, nil
+	}))
+	s.AddMethod(NewNativeMethod("start_with?", provider, matchesAnyAffix(provider, strings.HasPrefix)))
+	s.AddMethod(NewNativeMethod("end_with?", provider, matchesAnyAffix(provider, strings.HasSuffix)))
+	s.AddMethod(NewNativeMethod("ljust", provider, justify(provider, func(padding int) (int, int) {
+		return 0, padding
+	})))
+	s.AddMethod(NewNativeMethod("rjust", provider, justify(provider, func(padding int) (int, int) {
+		return padding, 0
+	})))
+	s.AddMethod(NewNativeMethod("center", provider, justify(provider, func(padding int) (int, int) {
+		return padding / 2, padding - padding/2
+	})))
 
 	return s
 }
 
+// mutating builds the bang version of a method from the version that returns
+// a new string. Like in MRI, the bang version changes the receiver in place
+// and returns it, or returns nil when there was nothing to change.
+func mutating(provider Provider, method func(Value, Block, ...Value) (Value, error)) func(Value, Block, ...Value) (Value, error) {
+	return func(self Value, block Block, args ...Value) (Value, error) {
+		selfAsStr := self.(*StringValue)
+		if selfAsStr.IsFrozen() {
+			return nil, NewFrozenError(selfAsStr, provider)
+		}
+
+		result, err := method(self, block, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := result.(*StringValue).value
+		if changed == selfAsStr.value {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		selfAsStr.value = changed
+		return selfAsStr, nil
+	}
+}
+
+// expandReplacement substitutes the groups of a match for the \0 to \9
+// and \& in a gsub replacement string. \\ is a literal backslash.
+func expandReplacement(replacement, str string, indices []int) string {
+	result := ""
+	for i := 0; i < len(replacement); i++ {
+		if replacement[i] != '\\' || i+1 == len(replacement) {
+			result += replacement[i : i+1]
+			continue
+		}
+
+		i++
+		switch next := replacement[i]; {
+		case next == '&':
+			result += str[indices[0]:indices[1]]
+		case next >= '0' && next <= '9':
+			group := int(next - '0')
+			if 2*group+1 < len(indices) && indices[2*group] >= 0 {
+				result += str[indices[2*group]:indices[2*group+1]]
+			}
+		case next == '\\':
+			result += "\\"
+		default:
+			result += replacement[i-1 : i+1]
+		}
+	}
+
+	return result
+}
+
+// matchesAnyAffix builds start_with? and end_with?, which are true when the
+// string has any of the prefixes (or suffixes) given.
+func matchesAnyAffix(provider Provider, hasAffix func(string, string) bool) func(Value, Block, ...Value) (Value, error) {
+	return func(self Value, block Block, args ...Value) (Value, error) {
+		str := self.(*StringValue).value
+		for _, arg := range args {
+			affix, ok := arg.(*StringValue)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into String", arg.Class().String()))
+			}
+
+			if hasAffix(str, affix.value) {
+				return provider.SingletonProvider().SingletonWithName("true"), nil
+			}
+		}
+
+		return provider.SingletonProvider().SingletonWithName("false"), nil
+	}
+}
+
+// justify builds ljust, rjust and center, which pad a string to a width
+// (counted in runes) with an optional pad string. split decides how much of
+// the padding goes on the left and how much on the right.
+func justify(provider Provider, split func(padding int) (int, int)) func(Value, Block, ...Value) (Value, error) {
+	return func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) < 1 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		width, ok := args[0].(*fixnumInstance)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", args[0].Class().String()))
+		}
+
+		pad := " "
+		if len(args) == 2 {
+			padStr, ok := args[1].(*StringValue)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into String", args[1].Class().String()))
+			}
+			if padStr.value == "" {
+				return nil, NewException(
+					provider.ClassProvider().ClassWithName("ArgumentError"),
+					"zero width padding",
+					provider.StackProvider().CurrentStack(),
+				)
+			}
+			pad = padStr.value
+		}
+
+		str := self.(*StringValue).value
+		padding := int(width.value) - utf8.RuneCountInString(str)
+		if padding <= 0 {
+			return NewString(str, provider), nil
+		}
+
+		left, right := split(padding)
+		return NewString(padWith(pad, left)+str+padWith(pad, right), provider), nil
+	}
+}
+
+// padWith repeats pad until it is length runes long, cutting the last
+// repetition short if needed.
+func padWith(pad string, length int) string {
+	runes := []rune(strings.Repeat(pad, length/utf8.RuneCountInString(pad)+1))
+	return string(runes[:length])
+}
+
 func (c *StringClass) String() string {
 	return "String"
 }
@@ -132,7 +510,6 @@ func (class *StringClass) New(provider Provider, args ...Value) (Value, error) {
 type StringValue struct {
 	value string
 	valueStub
-	frozen bool
 }
 
 func (s *StringValue) String() string {
@@ -147,6 +524,17 @@ func (s *StringValue) RawString() string {
 	return s.value
 }
 
+func (s *StringValue) Dup() Value {
+	dup := &StringValue{value: s.value}
+	dup.initialize()
+	dup.setStringer(dup.String)
+	dup.setPrettyPrinter(dup.PrettyPrint)
+	dup.class = s.class
+	dup.copyInstanceStateFrom(&s.valueStub)
+
+	return dup
+}
+
 func NewString(str string, provider Provider) Value {
 	s, _ := provider.ClassProvider().ClassWithName("String").New(provider)
 	s.(*StringValue).value = str
@@ -0,0 +1,200 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type structClass struct {
+	valueStub
+	classStub
+}
+
+func NewStructClass(provider Provider) Class {
+	s := &structClass{}
+	s.initialize()
+	s.setStringer(s.String)
+	s.class = provider.ClassProvider().ClassWithName("Class")
+	s.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	s.AddMethod(NewNativeMethod("new", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		members := []string{}
+		for _, arg := range args {
+			switch arg := arg.(type) {
+			case *SymbolValue:
+				members = append(members, arg.Name())
+			case *StringValue:
+				members = append(members, arg.RawString())
+			default:
+				return nil, errors.New(fmt.Sprintf("TypeError: %s is not a symbol nor a string", arg.String()))
+			}
+		}
+
+		class := newStructMemberClass(members, provider)
+		if block != nil {
+			block.setContext(class)
+			_, err := block.Call()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return class, nil
+	}))
+
+	return s
+}
+
+func (c *structClass) String() string {
+	return "Struct"
+}
+
+func (c *structClass) Name() string {
+	return "Struct"
+}
+
+func (c *structClass) New(provider Provider, args ...Value) (Value, error) {
+	return nil, errors.New("NoMethodError: undefined method 'new' for Struct, use Struct.new(:member, ...)")
+}
+
+// creates the anonymous class returned by Struct.new
+// its members are plain attributes, so they behave like attr_accessor
+func newStructMemberClass(members []string, provider Provider) Class {
+	c := NewUserDefinedClass("", "Struct", provider)
+
+	c.AddMethod(NewNativeMethod("new", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.(Class).New(provider, args...)
+	}))
+	c.AddMethod(NewNativeMethod("members", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return structMemberSymbols(members, provider), nil
+	}))
+
+	c.AddInstanceMethod(NewNativeMethod("initialize", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) > len(members) {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				"struct size differs",
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		nilValue := provider.SingletonProvider().SingletonWithName("nil")
+		for index, member := range members {
+			if index < len(args) {
+				self.SetAttribute(member, args[index])
+			} else {
+				self.SetAttribute(member, nilValue)
+			}
+		}
+
+		return nilValue, nil
+	}))
+
+	for _, member := range members {
+		name := member
+		c.AddInstanceMethod(NewNativeMethod(name, provider, func(self Value, block Block, args ...Value) (Value, error) {
+			value, ok := self.GetAttribute(name)
+			if !ok {
+				return provider.SingletonProvider().SingletonWithName("nil"), nil
+			}
+
+			return value, nil
+		}))
+		c.AddInstanceMethod(NewNativeMethod(name+"=", provider, func(self Value, block Block, args ...Value) (Value, error) {
+			if self.IsFrozen() {
+				return nil, NewFrozenError(self, provider)
+			}
+
+			self.SetAttribute(name, args[0])
+			return args[0], nil
+		}))
+	}
+
+	c.AddInstanceMethod(NewNativeMethod("members", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return structMemberSymbols(members, provider), nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("to_a", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		array, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+		for _, value := range structValues(self, members, provider) {
+			array.(*Array).Append(value)
+		}
+
+		return array, nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("to_s", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(structString(self, members, provider), provider), nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("inspect", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(structString(self, members, provider), provider), nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		trueValue := provider.SingletonProvider().SingletonWithName("true")
+		falseValue := provider.SingletonProvider().SingletonWithName("false")
+
+		other := args[0]
+		if self == other {
+			return trueValue, nil
+		}
+		if other.Class() != self.Class() {
+			return falseValue, nil
+		}
+
+		otherValues := structValues(other, members, provider)
+		for index, value := range structValues(self, members, provider) {
+			equalMethod := value.Method("==")
+			if equalMethod == nil {
+				return nil, NewNoMethodError("==", value, provider)
+			}
+
+			equal, err := equalMethod.Execute(value, nil, otherValues[index])
+			if err != nil {
+				return nil, err
+			}
+
+			if !equal.IsTruthy() {
+				return falseValue, nil
+			}
+		}
+
+		return trueValue, nil
+	}))
+
+	return c
+}
+
+func structMemberSymbols(members []string, provider Provider) Value {
+	array, _ := provider.ClassProvider().ClassWithName("Array").New(provider)
+	for _, member := range members {
+		array.(*Array).Append(NewSymbol(member, provider))
+	}
+
+	return array
+}
+
+func structValues(self Value, members []string, provider Provider) []Value {
+	values := []Value{}
+	for _, member := range members {
+		value, ok := self.GetAttribute(member)
+		if !ok {
+			value = provider.SingletonProvider().SingletonWithName("nil")
+		}
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func structString(self Value, members []string, provider Provider) string {
+	pieces := []string{}
+	for index, value := range structValues(self, members, provider) {
+		pieces = append(pieces, fmt.Sprintf("%s=%s", members[index], value.PrettyPrint()))
+	}
+
+	name := self.Class().String()
+	if name == "" {
+		return fmt.Sprintf("#<struct %s>", strings.Join(pieces, ", "))
+	}
+
+	return fmt.Sprintf("#<struct %s %s>", name, strings.Join(pieces, ", "))
+}
@@ -3,6 +3,10 @@ package builtins
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 type symbolClass struct {
@@ -25,6 +29,9 @@ func NewSymbolClass(provider Provider) Class {
 		selfAsSymbol := self.(*SymbolValue)
 		return NewString(selfAsSymbol.value, provider), nil
 	}))
+	s.AddMethod(NewNativeMethod("to_sym", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self, nil
+	}))
 	s.AddMethod(NewNativeMethod("===", provider, func(self Value, block Block, args ...Value) (Value, error) {
 		if self == args[0] {
 			return provider.SingletonProvider().SingletonWithName("true"), nil
@@ -32,6 +39,30 @@ func NewSymbolClass(provider Provider) Class {
 			return provider.SingletonProvider().SingletonWithName("false"), nil
 		}
 	}))
+	s.AddMethod(NewNativeMethod("==", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		asSymbol, ok := args[0].(*SymbolValue)
+		return booleanValue(ok && asSymbol.value == self.(*SymbolValue).value, provider), nil
+	}))
+	s.AddMethod(NewNativeMethod("<=>", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		asSymbol, ok := args[0].(*SymbolValue)
+		if !ok {
+			return provider.SingletonProvider().SingletonWithName("nil"), nil
+		}
+
+		return NewFixnum(int64(strings.Compare(self.(*SymbolValue).value, asSymbol.value)), provider), nil
+	}))
+
+	length := func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(utf8.RuneCountInString(self.(*SymbolValue).value)), provider), nil
+	}
+	s.AddMethod(NewNativeMethod("length", provider, length))
+	s.AddMethod(NewNativeMethod("size", provider, length))
+	s.AddMethod(NewNativeMethod("upcase", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewSymbol(strings.ToUpper(self.(*SymbolValue).value), provider), nil
+	}))
+	s.AddMethod(NewNativeMethod("downcase", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewSymbol(strings.ToLower(self.(*SymbolValue).value), provider), nil
+	}))
 
 	return s
 }
@@ -53,18 +84,40 @@ type SymbolValue struct {
 	valueStub
 }
 
+// NewSymbol returns the interned symbol with the given name, creating it if
+// needed, so that there is only ever one symbol for each name
 func NewSymbol(val string, provider Provider) Value {
+	if symbol := provider.SingletonProvider().SymbolWithName(val); symbol != nil {
+		return symbol
+	}
+
 	s := &SymbolValue{value: val}
 	s.class = provider.ClassProvider().ClassWithName("Symbol")
 	s.initialize()
 	s.setStringer(s.String)
-	return s
+	s.setPrettyPrinter(s.PrettyPrint)
+	s.Freeze()
+	return provider.SingletonProvider().AddSymbol(s)
 }
 
 func (SymbolValue *SymbolValue) String() string {
 	return fmt.Sprintf(":%s", SymbolValue.value)
 }
 
+// names that can be written as a symbol without quoting them, eg: :foo?,
+// :@bar, :$baz or :<=>
+var plainSymbolName = regexp.MustCompile(`^([a-zA-Z_][a-zA-Z0-9_]*[?!=]?|@@?[a-zA-Z_][a-zA-Z0-9_]*|\$[a-zA-Z_][a-zA-Z0-9_]*|\[\]=?|[-+]@|\*\*|<=>|===?|=~|![=~]?|<<|>>|[<>]=?|[-+*/%~^&|])$`)
+
+// PrettyPrint quotes the names that are not plain, like :"foo bar", escaping
+// quotes, backslashes and unprintable characters in them
+func (SymbolValue *SymbolValue) PrettyPrint() string {
+	if plainSymbolName.MatchString(SymbolValue.value) {
+		return SymbolValue.String()
+	}
+
+	return ":" + strconv.Quote(SymbolValue.value)
+}
+
 func (SymbolValue *SymbolValue) Name() string {
 	return SymbolValue.value
 }
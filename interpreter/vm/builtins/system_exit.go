@@ -0,0 +1,80 @@
+package builtins
+
+import (
+	"errors"
+	"fmt"
+)
+
+func NewSystemExitClass(provider Provider) Class {
+	c := NewGenericClass("SystemExit", "Exception", provider)
+
+	c.AddInstanceMethod(NewNativeMethod("status", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return self.GetInstanceVariable("status"), nil
+	}))
+	c.AddInstanceMethod(NewNativeMethod("success?", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return booleanValue(self.GetInstanceVariable("status") == NewFixnum(0, provider), provider), nil
+	}))
+
+	return c
+}
+
+// NewSystemExit is raised by Kernel#exit, so that it unwinds the stack
+// (running ensure blocks along the way) before the program terminates
+func NewSystemExit(status int64, provider Provider) error {
+	e := NewException(
+		provider.ClassProvider().ClassWithName("SystemExit"),
+		"exit",
+		provider.StackProvider().CurrentStack(),
+	)
+	e.SetInstanceVariable("status", NewFixnum(status, provider))
+
+	return e
+}
+
+// ImmediateExit is returned by Kernel#exit!, which ends the program at once.
+// Unlike a SystemExit it is not a ruby exception, so nothing rescues it, no
+// ensure blocks run on its way out, and the at_exit blocks are dropped.
+type ImmediateExit struct {
+	Status int
+}
+
+func (err *ImmediateExit) Error() string {
+	return fmt.Sprintf("exit!(%d)", err.Status)
+}
+
+// SystemExitStatus returns the exit status of err, if it was raised by exit
+func SystemExitStatus(err error) (int, bool) {
+	e, ok := err.(*ExceptionValue)
+	if !ok || e.Class().Name() != "SystemExit" {
+		return 0, false
+	}
+
+	status, ok := e.GetInstanceVariable("status").(*fixnumInstance)
+	if !ok {
+		return 1, true
+	}
+
+	return int(status.value), true
+}
+
+// converts the argument given to exit and exit! into a status, where true
+// and false mean success and failure
+func exitStatus(args []Value, defaultStatus int64, provider Provider) (int64, error) {
+	if len(args) == 0 {
+		return defaultStatus, nil
+	}
+
+	switch arg := args[0]; arg {
+	case provider.SingletonProvider().SingletonWithName("true"):
+		return 0, nil
+	case provider.SingletonProvider().SingletonWithName("false"):
+		return 1, nil
+	default:
+		status, ok := arg.(*fixnumInstance)
+		if !ok {
+			return 0, errors.New(fmt.Sprintf("TypeError: no implicit conversion of %s into Integer", arg.Class().String()))
+		}
+
+		return status.value, nil
+	}
+}
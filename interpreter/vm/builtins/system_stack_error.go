@@ -0,0 +1,13 @@
+package builtins
+
+func NewSystemStackErrorClass(provider Provider) Class {
+	return NewGenericClass("SystemStackError", "Exception", provider)
+}
+
+func NewSystemStackError(provider Provider) error {
+	return NewException(
+		provider.ClassProvider().ClassWithName("SystemStackError"),
+		"stack level too deep",
+		provider.StackProvider().CurrentStack(),
+	)
+}
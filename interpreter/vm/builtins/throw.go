@@ -0,0 +1,75 @@
+package builtins
+
+import "fmt"
+
+func NewUncaughtThrowErrorClass(provider Provider) Class {
+	return NewGenericClass("UncaughtThrowError", "ArgumentError", provider)
+}
+
+// thrownTag unwinds the stack from Kernel#throw to the Kernel#catch for its tag
+// it is not a ruby exception, so rescue clauses let it through
+type thrownTag struct {
+	tag   Value
+	value Value
+}
+
+func (t *thrownTag) Error() string {
+	return fmt.Sprintf("uncaught throw %s", t.tag.PrettyPrint())
+}
+
+func addCatchAndThrow(k Module, provider Provider) {
+	// the tags of the catch blocks currently running, innermost last
+	tags := []Value{}
+
+	k.AddMethod(NewNativeMethod("catch", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if block == nil {
+			return nil, NewLocalJumpError(provider)
+		}
+
+		var tag Value
+		if len(args) > 0 {
+			tag = args[0]
+		} else {
+			tag, _ = provider.ClassProvider().ClassWithName("Object").New(provider)
+		}
+
+		tags = append(tags, tag)
+		result, err := block.Call(tag)
+		tags = tags[:len(tags)-1]
+
+		// a tag has to be the very object given to catch, which any symbol
+		// with the same name is, as symbols are interned
+		if thrown, ok := err.(*thrownTag); ok && thrown.tag == tag {
+			return thrown.value, nil
+		}
+
+		return result, err
+	}))
+
+	k.AddMethod(NewNativeMethod("throw", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1..2)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		thrown := &thrownTag{tag: args[0], value: provider.SingletonProvider().SingletonWithName("nil")}
+		if len(args) == 2 {
+			thrown.value = args[1]
+		}
+
+		for _, tag := range tags {
+			if tag == thrown.tag {
+				return nil, thrown
+			}
+		}
+
+		return nil, NewException(
+			provider.ClassProvider().ClassWithName("UncaughtThrowError"),
+			thrown.Error(),
+			provider.StackProvider().CurrentStack(),
+		)
+	}))
+}
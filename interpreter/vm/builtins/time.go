@@ -0,0 +1,163 @@
+package builtins
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type timeClass struct {
+	valueStub
+	classStub
+}
+
+func NewTimeClass(provider Provider) Class {
+	t := &timeClass{}
+	t.initialize()
+	t.setStringer(t.String)
+	t.class = provider.ClassProvider().ClassWithName("Class")
+	t.superClass = provider.ClassProvider().ClassWithName("Object")
+
+	t.AddMethod(NewNativeMethod("now", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewTime(time.Now(), provider), nil
+	}))
+
+	t.AddInstanceMethod(NewNativeMethod("to_i", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(self.(*TimeValue).time.Unix(), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("to_s", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewString(self.String(), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("year", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(self.(*TimeValue).time.Year()), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("month", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(self.(*TimeValue).time.Month()), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("day", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(self.(*TimeValue).time.Day()), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("hour", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(self.(*TimeValue).time.Hour()), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("min", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(self.(*TimeValue).time.Minute()), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("sec", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		return NewFixnum(int64(self.(*TimeValue).time.Second()), provider), nil
+	}))
+	t.AddInstanceMethod(NewNativeMethod("strftime", provider, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) != 1 {
+			return nil, NewException(
+				provider.ClassProvider().ClassWithName("ArgumentError"),
+				fmt.Sprintf("wrong number of arguments (%d for 1)", len(args)),
+				provider.StackProvider().CurrentStack(),
+			)
+		}
+
+		format, ok := args[0].(*StringValue)
+		if !ok {
+			return nil, NewTypeError(fmt.Sprintf("no implicit conversion of %s into String", args[0].Class().String()), provider)
+		}
+
+		return NewString(strftime(self.(*TimeValue).time, format.RawString()), provider), nil
+	}))
+
+	return t
+}
+
+func (t *timeClass) String() string {
+	return "Time"
+}
+
+func (t *timeClass) Name() string {
+	return "Time"
+}
+
+func (t *timeClass) New(provider Provider, args ...Value) (Value, error) {
+	return NewTime(time.Now(), provider), nil
+}
+
+type TimeValue struct {
+	valueStub
+	time time.Time
+}
+
+func NewTime(t time.Time, provider Provider) Value {
+	value := &TimeValue{time: t}
+	value.initialize()
+	value.setStringer(value.String)
+	value.class = provider.ClassProvider().ClassWithName("Time")
+	return value
+}
+
+func (t *TimeValue) Time() time.Time {
+	return t.time
+}
+
+func (t *TimeValue) String() string {
+	return t.time.Format("2006-01-02 15:04:05 -0700")
+}
+
+// strftime expands the ruby (and C) style % directives in format
+// unknown directives are left in place, as MRI does
+func strftime(t time.Time, format string) string {
+	var buffer bytes.Buffer
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			buffer.WriteByte(format[i])
+			continue
+		}
+
+		i++
+		switch format[i] {
+		case 'Y':
+			buffer.WriteString(strconv.Itoa(t.Year()))
+		case 'y':
+			buffer.WriteString(fmt.Sprintf("%02d", t.Year()%100))
+		case 'm':
+			buffer.WriteString(fmt.Sprintf("%02d", int(t.Month())))
+		case 'd':
+			buffer.WriteString(fmt.Sprintf("%02d", t.Day()))
+		case 'e':
+			buffer.WriteString(fmt.Sprintf("%2d", t.Day()))
+		case 'j':
+			buffer.WriteString(fmt.Sprintf("%03d", t.YearDay()))
+		case 'H':
+			buffer.WriteString(fmt.Sprintf("%02d", t.Hour()))
+		case 'I':
+			buffer.WriteString(t.Format("03"))
+		case 'M':
+			buffer.WriteString(fmt.Sprintf("%02d", t.Minute()))
+		case 'S':
+			buffer.WriteString(fmt.Sprintf("%02d", t.Second()))
+		case 'L':
+			buffer.WriteString(fmt.Sprintf("%03d", t.Nanosecond()/int(time.Millisecond)))
+		case 'p':
+			buffer.WriteString(t.Format("PM"))
+		case 'b':
+			buffer.WriteString(t.Format("Jan"))
+		case 'B':
+			buffer.WriteString(t.Format("January"))
+		case 'a':
+			buffer.WriteString(t.Format("Mon"))
+		case 'A':
+			buffer.WriteString(t.Format("Monday"))
+		case 'z':
+			buffer.WriteString(t.Format("-0700"))
+		case 'Z':
+			buffer.WriteString(t.Format("MST"))
+		case 's':
+			buffer.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case '%':
+			buffer.WriteByte('%')
+		default:
+			buffer.WriteByte('%')
+			buffer.WriteByte(format[i])
+		}
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,13 @@
+package builtins
+
+func NewTypeErrorClass(provider Provider) Class {
+	return NewGenericClass("TypeError", "StandardError", provider)
+}
+
+func NewTypeError(message string, provider Provider) error {
+	return NewException(
+		provider.ClassProvider().ClassWithName("TypeError"),
+		message,
+		provider.StackProvider().CurrentStack(),
+	)
+}
@@ -21,6 +21,16 @@ type Value interface {
 
 	IsTruthy() bool
 
+	IsFrozen() bool
+	Freeze()
+
 	GetAttribute(string) (Value, bool)
 	SetAttribute(string, Value)
 }
+
+// Duplicable values can be shallow copied by Object#dup and Object#clone
+// values that are not duplicable (eg: nil, true, Fixnums) dup as themselves
+type Duplicable interface {
+	Value
+	Dup() Value
+}
@@ -1,5 +1,7 @@
 package builtins
 
+import "sort"
+
 // this type repesents the shared behavior and data of all Ruby Values
 // all values will need to store the methods that are defined on them
 // (in addition to their class, and other information)
@@ -10,8 +12,12 @@ type valueStub struct {
 	stringer      func() string
 	prettyPrinter func() string
 
-	instance_variables map[string]Value
-	attrs              map[string]Value
+	instance_variables      map[string]Value
+	instance_variable_names []string
+	attrs                   map[string]Value
+
+	frozen     bool
+	inspecting bool
 }
 
 func (valueStub *valueStub) initialize() {
@@ -43,33 +49,100 @@ func (valueStub *valueStub) Method(name string) Method {
 	//    2. Modules mixed into the singleton class in reverse order of inclusion
 	// FIXME: respect step 2 here
 
+	return instanceMethodOf(valueStub.class, name)
+}
+
+// instanceMethodOf finds the method that instances of the class respond to
+// with the given name, following steps 3 to 5 above
+// a method that was undefined with undef hides any inherited method
+func instanceMethodOf(class Class, name string) Method {
+	cache := methodCacheOf(class)
+	method, version, cached := cache.lookup(class, name)
+	if !cached {
+		method = findInstanceMethod(class, name)
+		if _, undefined := method.(*undefinedMethod); undefined {
+			method = nil
+		}
+		cache.store(class, name, method, version)
+	}
+
+	return method
+}
+
+// SuperMethod finds the method that super calls from method, when it is
+// run on an instance of class: the one inherited by the class defining it
+func SuperMethod(class Class, method Method) Method {
+	owner := class
+	for owner != nil && !definesInstanceMethod(owner, method) {
+		if owner.String() == "BasicObject" {
+			owner = nil
+			break
+		}
+		owner = owner.SuperClass()
+	}
+	if owner == nil {
+		owner = class
+	}
+
+	if owner.SuperClass() == nil {
+		return nil
+	}
+	return instanceMethodOf(owner.SuperClass(), method.Name())
+}
+
+func definesInstanceMethod(class Class, method Method) bool {
+	for _, m := range class.InstanceMethods() {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// HasInstanceMethod is whether instances of the module respond to name,
+// with a method of its own or, for a class, one it inherits
+func HasInstanceMethod(module Module, name string) bool {
+	if class, ok := module.(Class); ok {
+		return instanceMethodOf(class, name) != nil
+	}
+
+	_, err := module.InstanceMethod(name)
+	return err == nil
+}
+
+func findInstanceMethod(class Class, name string) Method {
 	//	  3. Methods defined by the object's class
-	for _, method := range valueStub.class.InstanceMethods() {
+	for _, method := range class.InstanceMethods() {
 		if method.Name() == name {
 			return method
 		}
 	}
 
-	m, ok = valueStub.class.eigenclassMethods()[name]
-	if ok {
-		return m
+	// builtin classes define their instance methods on themselves, but the
+	// methods of a user defined class's singleton (def self.foo) are its own
+	if _, userDefined := class.(*UserDefinedClass); !userDefined {
+		m, ok := class.eigenclassMethods()[name]
+		if ok {
+			return m
+		}
 	}
 
 	//		4. Modules included into the object's class in reverse order of inclusion
 	// FIXME: this should be reversed (should be fixed in Include method)
-	for _, module := range valueStub.class.includedModules() {
-		m, ok := module.eigenclassMethods()[name]
-		if ok {
+	for _, module := range class.includedModules() {
+		if m := includedMethod(module, name); m != nil {
 			return m
 		}
 	}
 
 	//    5. Methods defined by the object's superclass, i.e. inherited methods
-	super := valueStub.class.SuperClass()
+	super := class.SuperClass()
 	for super != nil {
-		m, ok := super.eigenclassMethods()[name]
-		if ok {
-			return m
+		if _, userDefined := super.(*UserDefinedClass); !userDefined {
+			m, ok := super.eigenclassMethods()[name]
+			if ok {
+				return m
+			}
 		}
 
 		m, err := super.InstanceMethod(name)
@@ -78,8 +151,7 @@ func (valueStub *valueStub) Method(name string) Method {
 		}
 
 		for _, module := range super.includedModules() {
-			m, ok := module.eigenclassMethods()[name]
-			if ok {
+			if m := includedMethod(module, name); m != nil {
 				return m
 			}
 		}
@@ -94,6 +166,69 @@ func (valueStub *valueStub) Method(name string) Method {
 	return nil
 }
 
+// includedMethod finds name among the methods a module gives the classes
+// including it: those defined in it with def, or for a builtin module, its own
+func includedMethod(module Module, name string) Method {
+	if m, err := module.InstanceMethod(name); err == nil {
+		return m
+	}
+
+	return module.eigenclassMethods()[name]
+}
+
+// instanceMethodNames lists the public and protected methods that instances of
+// the class respond to, looking through the same tables as findInstanceMethod
+// a name found first hides the methods of the same name further up, even when
+// the method found is private or undefined
+// unless inherited is true, only the methods of the class itself are listed
+func instanceMethodNames(class Class, inherited bool) []string {
+	found := map[string]bool{}
+	names := []string{}
+	add := func(methods []Method) {
+		for _, method := range methods {
+			if found[method.Name()] {
+				continue
+			}
+			found[method.Name()] = true
+
+			if _, undefined := method.(*undefinedMethod); undefined || !(method.IsPublic() || method.IsProtected()) {
+				continue
+			}
+			names = append(names, method.Name())
+		}
+	}
+	addEigenclass := func(value Value) {
+		methods := []Method{}
+		for _, method := range value.eigenclassMethods() {
+			methods = append(methods, method)
+		}
+		add(methods)
+	}
+
+	for class != nil {
+		add(class.InstanceMethods())
+		if _, userDefined := class.(*UserDefinedClass); !userDefined {
+			addEigenclass(class)
+		}
+		if !inherited {
+			break
+		}
+
+		for _, module := range class.includedModules() {
+			add(module.InstanceMethods())
+			addEigenclass(module)
+		}
+
+		if class.String() == "BasicObject" {
+			break
+		}
+		class = class.SuperClass()
+	}
+
+	sort.Strings(names)
+	return names
+}
+
 func (valueStub *valueStub) Methods() []Method {
 	values := make([]Method, 0, len(valueStub.eigenclass_methods))
 	for _, m := range valueStub.eigenclass_methods {
@@ -105,20 +240,37 @@ func (valueStub *valueStub) Methods() []Method {
 
 func (valueStub *valueStub) AddMethod(m Method) {
 	valueStub.eigenclass_methods[m.Name()] = m
+	m.methodCache().changed()
 }
 
 func (valueStub *valueStub) RemoveMethod(m Method) {
 	delete(valueStub.eigenclass_methods, m.Name())
+	m.methodCache().changed()
 }
 
 func (valueStub *valueStub) String() string {
+	if valueStub.stringer == nil {
+		return valueStub.fallbackString()
+	}
 	return valueStub.stringer()
 }
 
 func (valueStub *valueStub) PrettyPrint() string {
+	if valueStub.prettyPrinter == nil {
+		return valueStub.String()
+	}
 	return valueStub.prettyPrinter()
 }
 
+// fallbackString describes values that never set a stringer, so that
+// formatting an error about them can't itself crash
+func (valueStub *valueStub) fallbackString() string {
+	if valueStub.class == nil {
+		return "#<Object>"
+	}
+	return "#<" + valueStub.class.String() + ">"
+}
+
 func (valueStub *valueStub) setStringer(stringer func() string) {
 	valueStub.stringer = stringer
 }
@@ -140,21 +292,75 @@ func (valueStub *valueStub) GetInstanceVariable(name string) Value {
 }
 
 func (valueStub *valueStub) SetInstanceVariable(name string, value Value) {
+	if _, ok := valueStub.instance_variables[name]; !ok {
+		valueStub.instance_variable_names = append(valueStub.instance_variable_names, name)
+	}
+
 	valueStub.instance_variables[name] = value
 }
 
+// instanceVariableNames returns the names of the instance variables in the
+// order they were first assigned
+func (valueStub *valueStub) instanceVariableNames() []string {
+	return valueStub.instance_variable_names
+}
+
+func (valueStub *valueStub) attributeNames() []string {
+	names := []string{}
+	for name := range valueStub.attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// beginInspecting returns false when the value is already being inspected
+func (valueStub *valueStub) beginInspecting() bool {
+	if valueStub.inspecting {
+		return false
+	}
+
+	valueStub.inspecting = true
+	return true
+}
+
+func (valueStub *valueStub) endInspecting() {
+	valueStub.inspecting = false
+}
+
 func (valueStub *valueStub) GetClassVariable(name string) Value {
-	return valueStub.class.classVariable(name)
+	return LookupClassVariable(valueStub.class, name)
 }
 
 func (valueStub *valueStub) SetClassVariable(name string, value Value) {
-	valueStub.class.setClassVariable(name, value)
+	AssignClassVariable(valueStub.class, name, value)
 }
 
 func (v *valueStub) IsTruthy() bool {
 	return true
 }
 
+func (v *valueStub) IsFrozen() bool {
+	return v.frozen
+}
+
+func (v *valueStub) Freeze() {
+	v.frozen = true
+}
+
+// copyInstanceStateFrom gives this value its own copy of the instance
+// variables and attributes of other (used by the values' Dup methods)
+func (v *valueStub) copyInstanceStateFrom(other *valueStub) {
+	for _, name := range other.instance_variable_names {
+		v.SetInstanceVariable(name, other.instance_variables[name])
+	}
+
+	for name, value := range other.attrs {
+		v.attrs[name] = value
+	}
+}
+
 func (v *valueStub) GetAttribute(name string) (Value, bool) {
 	something, ok := v.attrs[name]
 	return something, ok
@@ -0,0 +1,5 @@
+package builtins
+
+func NewZeroDivisionErrorClass(provider Provider) Class {
+	return NewGenericClass("ZeroDivisionError", "StandardError", provider)
+}
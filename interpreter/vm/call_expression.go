@@ -11,32 +11,45 @@ func interpretCallExpressionInContext(
 	callExpr ast.CallExpression,
 	context Value,
 ) (Value, error) {
-	var (
-		err         error
-		target      Value
-		method      Method
-		returnValue Value
-	)
+	if isOperatorAssignment(callExpr) {
+		return interpretOperatorAssignmentInContext(vm, callExpr, context)
+	}
+
+	target, err := callTargetInContext(vm, callExpr, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return invokeCallExpression(vm, callExpr, context, target)
+}
 
+func callTargetInContext(
+	vm *vm,
+	callExpr ast.CallExpression,
+	context Value,
+) (Value, error) {
+	target := context
 	if callExpr.Target != nil {
+		var err error
 		target, err = vm.executeWithContext(context, callExpr.Target)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		target = context
 	}
 
 	if target == nil {
-		nilValue := vm.singletons["nil"]
-		return nil, NewNoMethodError(callExpr.Func.Name, nilValue.String(), nilValue.Class().String(), vm.stack.String())
+		nilValue := vm.SingletonWithName("nil")
+		return nil, NewNoMethodError(callExpr.Func.Name, nilValue, vm)
 	}
 
-	method = target.Method(callExpr.Func.Name)
-	if method == nil {
-		return nil, NewNoMethodError(callExpr.Func.Name, target.PrettyPrint(), target.Class().String(), vm.CurrentStack())
-	}
+	return target, nil
+}
 
+func callArgsInContext(
+	vm *vm,
+	callExpr ast.CallExpression,
+	context Value,
+) ([]Value, error) {
 	args := []Value{}
 	for _, astArgument := range callExpr.Args {
 		arg, err := vm.executeWithContext(context, astArgument)
@@ -47,13 +60,24 @@ func interpretCallExpressionInContext(
 		args = append(args, arg)
 	}
 
-	vm.stack.Unshift(method.Name(), vm.currentFilename, callExpr.LineNumber())
-	didShift := false
-	defer func() {
-		if didShift == false {
-			vm.stack.Shift()
-		}
-	}()
+	return args, nil
+}
+
+func invokeCallExpression(
+	vm *vm,
+	callExpr ast.CallExpression,
+	context Value,
+	target Value,
+) (Value, error) {
+	method := target.Method(callExpr.Func.Name)
+	if method == nil {
+		return nil, NewNoMethodError(callExpr.Func.Name, target, vm)
+	}
+
+	args, err := callArgsInContext(vm, callExpr, context)
+	if err != nil {
+		return nil, err
+	}
 
 	var block Block
 	if callExpr.OptionalBlock.Provided() {
@@ -66,9 +90,25 @@ func interpretCallExpressionInContext(
 		block = blockValue.(Block)
 	}
 
-	returnValue, err = method.Execute(target, block, args...)
-	vm.stack.Shift()
-	didShift = true
+	return vm.invokeMethod(method, target, block, callExpr.LineNumber(), args...)
+}
+
+// invokeMethod calls a method found on the target, in a frame of its own
+func (vm *vm) invokeMethod(
+	method Method,
+	target Value,
+	block Block,
+	lineNumber int,
+	args ...Value,
+) (Value, error) {
+	if vm.calls >= vm.maxStackDepth() {
+		return nil, NewSystemStackError(vm)
+	}
+	vm.calls++
+	defer func() { vm.calls-- }()
+
+	vm.stack.Unshift(method.Name(), vm.currentFilename, lineNumber)
+	defer vm.stack.Shift()
 
-	return returnValue, err
+	return method.Execute(target, block, args...)
 }
@@ -1,9 +1,15 @@
 package vm
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type CallStack struct {
+	// the innermost frame is first. Frames is the end of buffer, which is
+	// filled from the back so that a call need not copy every frame
 	Frames []callStackFrame
+	buffer []callStackFrame
 }
 
 func NewCallStack() *CallStack {
@@ -11,21 +17,30 @@ func NewCallStack() *CallStack {
 }
 
 func (stack *CallStack) Unshift(method, file string, lineNumber int) {
-	frame := callStackFrame{Method: method, File: file, LineNumber: lineNumber}
-	stack.Frames = append([]callStackFrame{frame}, stack.Frames...)
+	start := len(stack.buffer) - len(stack.Frames)
+	if start == 0 {
+		buffer := make([]callStackFrame, 2*len(stack.buffer)+16)
+		start = len(buffer) - copy(buffer[len(buffer)-len(stack.Frames):], stack.Frames)
+		stack.buffer = buffer
+	}
+
+	start--
+	stack.buffer[start] = callStackFrame{Method: method, File: file, LineNumber: lineNumber}
+	stack.Frames = stack.buffer[start:]
 }
 
 func (stack *CallStack) Shift() {
+	stack.Frames[0] = callStackFrame{}
 	stack.Frames = stack.Frames[1:]
 }
 
 func (stack *CallStack) String() string {
-	str := ""
+	var str strings.Builder
 	for _, frame := range stack.Frames {
-		str += fmt.Sprintf("\t%s:%d in `%s'\n", frame.File, frame.LineNumber+1, frame.Method)
+		fmt.Fprintf(&str, "\t%s:%d in `%s'\n", frame.File, frame.LineNumber+1, frame.Method)
 	}
 
-	return str
+	return str.String()
 }
 
 type callStackFrame struct {
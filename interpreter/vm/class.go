@@ -74,12 +74,7 @@ func interpretClassInContext(
 
 		return nil
 	})).OrSome(
-		NewNameError(
-			name,
-			context.String(),
-			context.Class().String(),
-			vm.stack.String(),
-		),
+		NewNameError(name, context, vm),
 	)
 
 	value, ok = maybeTheValue.Value().(Value)
@@ -463,6 +463,72 @@ Bar.new
 		})
 	})
 
+	Describe("super", func() {
+		It("passes along the arguments of the method without any", func() {
+			value, err := vm.Run(`
+class Greeter
+  def greet(name, punctuation)
+    "hello #{name}#{punctuation}"
+  end
+end
+
+class LoudGreeter < Greeter
+  def greet(name, punctuation)
+    super.upcase
+  end
+end
+
+LoudGreeter.new.greet('bob', '!')
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("HELLO BOB!"))
+		})
+
+		It("calls the method of the superclass of the class defining the method", func() {
+			value, err := vm.Run(`
+class A
+  def name(suffix)
+    "a#{suffix}"
+  end
+end
+
+class B < A
+  def name(suffix)
+    "b" + super(suffix + 1)
+  end
+end
+
+class C < B
+  def name(suffix)
+    "c" + super
+  end
+end
+
+C.new.name(1)
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("cba2"))
+		})
+	})
+
+	Describe("initialize", func() {
+		It("is called once for each new instance", func() {
+			value, err := vm.Run(`
+$initialized = 0
+class Counted
+  def initialize
+    $initialized = $initialized + 1
+  end
+end
+
+Counted.new
+$initialized
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+		})
+	})
+
 	Describe("calling a method on the superclass", func() {
 		It("works, simply", func() {
 			value, err := vm.Run(`
@@ -486,4 +552,339 @@ Foo.new.test
 			Expect(value.String()).To(ContainSubstring("value"))
 		})
 	})
+
+	Describe("aliasing methods", func() {
+		BeforeEach(func() {
+			_, err := vm.Run(`
+class Greeter
+  def greet
+    "hi"
+  end
+end
+
+class LoudGreeter < Greeter
+  alias_method :quiet_greet, :greet
+  alias original_greet greet
+
+  def greet
+    quiet_greet + "!"
+  end
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("keeps the original method under the new name after it is redefined", func() {
+			value, err := vm.Run("LoudGreeter.new.greet")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("hi!"))
+
+			value, err = vm.Run("LoudGreeter.new.original_greet")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("hi"))
+		})
+
+		It("raises a NameError for a method that does not exist", func() {
+			_, err := vm.Run(`
+class Greeter
+  alias_method :wave, :nonexistent
+end
+`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("NameError: undefined method 'nonexistent' for class 'Greeter'"))
+
+			_, err = vm.Run(`
+class Greeter
+  alias wave nonexistent
+end
+`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("NameError: undefined method 'nonexistent' for class 'Greeter'"))
+		})
+	})
+
+	Describe("removing methods", func() {
+		BeforeEach(func() {
+			_, err := vm.Run(`
+class Animal
+  def speak
+    "..."
+  end
+end
+
+class Dog < Animal
+  def speak
+    "woof"
+  end
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("reveals the inherited method after remove_method", func() {
+			value, err := vm.Run(`
+class Dog
+  remove_method :speak
+end
+Dog.new.speak
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("..."))
+		})
+
+		It("hides inherited methods with undef", func() {
+			_, err := vm.Run(`
+class Dog
+  undef speak
+end
+Dog.new.speak
+`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("NoMethodError: undefined method 'speak'"))
+
+			value, err := vm.Run("Animal.new.speak")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("..."))
+		})
+
+		It("raises a NameError when the class does not define the method", func() {
+			_, err := vm.Run(`
+class Dog
+  remove_method :bark
+end
+`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("NameError: method 'bark' not defined in Dog"))
+
+			_, err = vm.Run(`
+class Dog
+  undef bark
+end
+`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("NameError: undefined method 'bark' for class 'Dog'"))
+		})
+	})
+
+	Describe("reopening a class", func() {
+		It("adds methods to the existing class, which instances made before see", func() {
+			value, err := vm.Run(`
+greeting = 'hey'
+
+class String
+  def shout
+    upcase + "!"
+  end
+end
+
+[greeting.shout, 'you'.shout]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["HEY!", "YOU!"]`))
+		})
+
+		It("keeps the methods a class declared in the program already had", func() {
+			value, err := vm.Run(`
+class Greeter
+  def hello
+    'hello'
+  end
+end
+greeter = Greeter.new
+
+class Greeter
+  def goodbye
+    'goodbye'
+  end
+end
+
+[greeter.hello, greeter.goodbye]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["hello", "goodbye"]`))
+		})
+
+		It("adds methods to arrays, hashes and booleans", func() {
+			value, err := vm.Run(`
+class Array
+  def doubled
+    map { |n| n * 2 }
+  end
+end
+
+class Hash
+  def names
+    keys
+  end
+end
+
+class TrueClass
+  def to_i
+    1
+  end
+end
+
+class FalseClass
+  def to_i
+    0
+  end
+end
+
+[[1, 2].doubled, {'a' => 3}.names, true.to_i, false.to_i]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[[2, 4], ["a"], 1, 0]`))
+		})
+
+		It("lets instances made before see methods redefined after", func() {
+			value, err := vm.Run(`
+class Greeter
+  def hello
+    'hello'
+  end
+end
+greeter = Greeter.new
+before = greeter.hello
+
+class Greeter
+  def hello
+    'howdy'
+  end
+end
+
+[before, greeter.hello, greeter.dup.hello]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["hello", "howdy", "howdy"]`))
+		})
+
+		It("raises a TypeError when given another superclass", func() {
+			_, err := vm.Run(`
+class Animal; end
+class Dog < Animal; end
+class Dog < String; end
+`)
+			Expect(err).To(MatchError(ContainSubstring("TypeError: superclass mismatch for class Dog")))
+		})
+
+		It("reopens modules too", func() {
+			value, err := vm.Run(`
+module Greetings
+  def self.hello
+    'hello'
+  end
+end
+
+module Greetings
+  def self.goodbye
+    'goodbye'
+  end
+end
+
+[Greetings.hello, Greetings.goodbye]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["hello", "goodbye"]`))
+		})
+	})
+
+	Describe("changing methods after they have been called", func() {
+		It("calls the latest definition", func() {
+			value, err := vm.Run(`
+results = [1.even?]
+
+class Fixnum
+  def even?
+    :redefined
+  end
+end
+
+results << 1.even?
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[false, :redefined]"))
+		})
+
+		It("finds the methods of modules included later", func() {
+			value, err := vm.Run(`
+module Doubled
+  def doubled
+    self + self
+  end
+end
+
+results = [defined?(1.doubled)]
+
+class Fixnum
+  include Doubled
+end
+
+results << 1.doubled
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[nil, 2]"))
+		})
+	})
+
+	Describe("listing methods", func() {
+		BeforeEach(func() {
+			_, err := vm.Run(`
+class Animal
+  def speak
+    'hi'
+  end
+
+  def legs
+    4
+  end
+
+  def secret
+    'shh'
+  end
+  private :secret
+end
+
+class Dog < Animal
+  def speak
+    'woof'
+  end
+
+  def fetch
+    'ball'
+  end
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists just the methods of the class with instance_methods(false)", func() {
+			value, err := vm.Run("Dog.instance_methods(false)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[:fetch, :speak]"))
+		})
+
+		It("lists inherited methods once with instance_methods", func() {
+			value, err := vm.Run("Dog.instance_methods.select { |name| [:speak, :legs].include?(name) }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[:legs, :speak]"))
+		})
+
+		It("does not list private methods", func() {
+			value, err := vm.Run("Dog.instance_methods.include?(:secret)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+		})
+
+		It("lists the singleton and inherited methods of an object with methods", func() {
+			value, err := vm.Run(`
+dog = Dog.new
+def dog.roll
+  'rolled'
+end
+[dog.methods.include?(:roll), dog.methods.include?(:legs), Dog.new.methods.include?(:roll)]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true, false]"))
+		})
+	})
 })
@@ -0,0 +1,28 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+func interpretClassVariableInContext(
+	vm *vm,
+	ref ast.ClassVariable,
+	context Value,
+) (Value, error) {
+
+	scope := ClassVariableScope(context)
+	value := LookupClassVariable(scope, ref.Name)
+	if value == nil {
+		return nil, NewException(
+			vm.ClassWithName("NameError"),
+			fmt.Sprintf("uninitialized class variable @@%s in %s", ref.Name, scope.String()),
+			vm.stack.String(),
+		)
+	}
+
+	return value, nil
+}
@@ -63,4 +63,63 @@ end
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fooInstance.GetClassVariable("foo")).To(Equal(anotherFoo.GetClassVariable("foo")))
 	})
+
+	It("are shared by the class body, class methods and instances", func() {
+		value, err := vm.Run(`
+class Counter
+  @@count = 0
+
+  def self.increment
+    @@count = @@count + 1
+  end
+
+  def count
+    @@count
+  end
+end
+
+Counter.increment
+Counter.increment
+Counter.new.count
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(2, vm)))
+	})
+
+	It("are shared with subclasses", func() {
+		value, err := vm.Run(`
+class Config
+  @@level = 1
+
+  def level
+    @@level
+  end
+end
+
+class LoudConfig < Config
+  def raise_level
+    @@level = @@level + 10
+  end
+end
+
+LoudConfig.new.raise_level
+Config.new.level
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(11, vm)))
+	})
+
+	It("raises a NameError when read before being assigned", func() {
+		_, err := vm.Run(`
+class Empty
+  def read
+    @@missing
+  end
+end
+
+Empty.new.read
+`)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("NameError: uninitialized class variable @@missing in Empty"))
+	})
 })
@@ -0,0 +1,380 @@
+package vm
+
+import (
+	"sync"
+
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// the body of a method is compiled the first time it is called, into a flat
+// list of instructions for a small stack machine, so that calling it again
+// skips the type switches of executeWithContext. nodes that the compiler
+// does not lower are left to the tree walker, by an instruction evaluating them
+type opcode int
+
+const (
+	// push the value of the node, as the tree walker evaluates it
+	opEval opcode = iota
+
+	// stop if the program was interrupted, before each statement
+	opInterrupt
+
+	// push the value of an empty list of statements, which is no value at all
+	opPushNothing
+	// replace no value on top of the stack with nil, as a group does
+	opNilIfNothing
+
+	opPushNil
+	opPushTrue
+	opPushFalse
+	opPushSelf
+	opPushInt
+	opPushString
+
+	// push the local variable, constant or result of the method named
+	opBareReference
+	// assign the value on top of the stack to a local variable, leaving it there
+	opAssign
+
+	opPop
+	opNot
+	// replace the value on top of the stack with nil, unless it is truthy
+	opNilUnlessTruthy
+
+	// find the method the call expression names on the receiver on top of the stack
+	opLookup
+	// call the method found with the receiver and args on top of the stack
+	opCall
+
+	opJump
+	// pop the value on top of the stack, and jump unless it is truthy
+	opJumpUnless
+	// jump if the value on top of the stack is truthy, and pop it otherwise
+	opJumpIfKeep
+
+	opReturn
+)
+
+type instruction struct {
+	op   opcode
+	node ast.Node
+
+	// the number of args to opCall, or where opJump and friends jump to
+	operand int
+
+	// whether an opCall is in tail position
+	tail bool
+}
+
+// compiledBody is the code for the body of one method, shared by every call
+type compiledBody struct {
+	once sync.Once
+	code []instruction
+}
+
+func (body *compiledBody) compile(statements []ast.Node) []instruction {
+	body.once.Do(func() {
+		compiler := &compiler{}
+		compiler.statements(statements, true)
+		body.code = compiler.code
+	})
+
+	return body.code
+}
+
+type compiler struct {
+	code []instruction
+}
+
+func (c *compiler) emit(op opcode, node ast.Node) int {
+	c.code = append(c.code, instruction{op: op, node: node})
+	return len(c.code) - 1
+}
+
+// jumpTo makes the jump emitted at index land on the next instruction
+func (c *compiler) jumpTo(index int) {
+	c.code[index].operand = len(c.code)
+}
+
+// statements leaves the value of the last statement on the stack, like
+// executeWithContext. tail is whether that value is returned from the method
+func (c *compiler) statements(statements []ast.Node, tail bool) {
+	if len(statements) == 0 {
+		c.emit(opInterrupt, nil)
+		c.emit(opPushNothing, nil)
+		return
+	}
+
+	for index, statement := range statements {
+		if index > 0 {
+			c.emit(opPop, nil)
+		}
+
+		c.emit(opInterrupt, nil)
+		c.node(statement, tail && index == len(statements)-1)
+	}
+}
+
+func (c *compiler) node(node ast.Node, tail bool) {
+	switch node := node.(type) {
+	case ast.Self:
+		c.emit(opPushSelf, node)
+	case ast.Nil:
+		c.emit(opPushNil, node)
+	case ast.Boolean:
+		if node.Value {
+			c.emit(opPushTrue, node)
+		} else {
+			c.emit(opPushFalse, node)
+		}
+	case ast.ConstantInt:
+		c.emit(opPushInt, node)
+	case ast.SimpleString:
+		c.emit(opPushString, node)
+	case ast.BareReference:
+		c.emit(opBareReference, node)
+	case ast.Assignment:
+		if _, ok := node.LHS.(ast.BareReference); !ok {
+			c.emit(opEval, node)
+			return
+		}
+
+		c.node(node.RHS, false)
+		c.emit(opAssign, node.LHS)
+	case ast.Group:
+		c.statements(node.Body, false)
+		c.emit(opNilIfNothing, node)
+	case ast.Return:
+		if node.Value == nil {
+			c.emit(opPushNil, node)
+		} else {
+			c.node(node.Value, tail)
+		}
+		c.emit(opReturn, node)
+	case ast.IfBlock:
+		if condition, ok := node.Condition.(ast.Boolean); ok {
+			if condition.Value {
+				c.statements(node.Body, tail)
+			} else {
+				c.statements(node.Else, tail)
+			}
+			return
+		}
+
+		c.node(node.Condition, false)
+		toElse := c.emit(opJumpUnless, node)
+		c.statements(node.Body, tail)
+		toEnd := c.emit(opJump, node)
+		c.jumpTo(toElse)
+		c.statements(node.Else, tail)
+		c.jumpTo(toEnd)
+	case ast.Ternary:
+		c.node(node.Condition, false)
+		toFalse := c.emit(opJumpUnless, node)
+		c.node(node.True, tail)
+		toEnd := c.emit(opJump, node)
+		c.jumpTo(toFalse)
+		c.node(node.False, tail)
+		c.jumpTo(toEnd)
+	case ast.Negation:
+		c.node(node.Target, false)
+		c.emit(opNot, node)
+	case ast.WeakLogicalAnd:
+		c.node(node.LHS, false)
+		toNil := c.emit(opJumpUnless, node)
+		c.node(node.RHS, false)
+		c.emit(opNilUnlessTruthy, node)
+		toEnd := c.emit(opJump, node)
+		c.jumpTo(toNil)
+		c.emit(opPushNil, node)
+		c.jumpTo(toEnd)
+	case ast.WeakLogicalOr:
+		c.node(node.LHS, false)
+		toEnd := c.emit(opJumpIfKeep, node)
+		c.node(node.RHS, false)
+		c.jumpTo(toEnd)
+	case ast.Loop:
+		if node.BodyFirst {
+			c.statements(node.Body, false)
+			c.emit(opPop, node)
+		}
+
+		start := len(c.code)
+		c.node(node.Condition, false)
+		toEnd := c.emit(opJumpUnless, node)
+		c.statements(node.Body, false)
+		c.emit(opPop, node)
+		c.code[c.emit(opJump, node)].operand = start
+		c.jumpTo(toEnd)
+		c.emit(opPushNil, node)
+	case ast.CallExpression:
+		if isOperatorAssignment(node) {
+			if _, ok := node.Target.(ast.CallExpression); ok {
+				c.emit(opEval, node)
+			} else {
+				c.node(expandOperatorAssignment(node), tail)
+			}
+			return
+		}
+
+		if node.OptionalBlock.Provided() {
+			c.emit(opEval, node)
+			return
+		}
+
+		if node.Target == nil {
+			c.emit(opPushSelf, node)
+		} else {
+			c.node(node.Target, false)
+		}
+		c.emit(opLookup, node)
+
+		for _, arg := range node.Args {
+			c.node(arg, false)
+		}
+		call := c.emit(opCall, node)
+		c.code[call].operand = len(node.Args)
+		c.code[call].tail = tail
+	default:
+		c.emit(opEval, node)
+	}
+}
+
+// executeCompiled runs the code compiled for the body of a method. like
+// executeStatementsInTailPosition, a call of the method to itself in tail
+// position is returned rather than made
+func (vm *vm) executeCompiled(
+	self Value,
+	method *RubyMethod,
+	code []instruction,
+) (Value, *tailCall, error) {
+	var (
+		stack   []Value
+		methods []Method
+	)
+
+	pop := func() Value {
+		value := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return value
+	}
+
+	for pc := 0; pc < len(code); pc++ {
+		in := code[pc]
+
+		switch in.op {
+		case opEval:
+			value, err := vm.executeWithContext(self, in.node)
+			if err != nil {
+				return nil, nil, err
+			}
+			stack = append(stack, value)
+		case opInterrupt:
+			if err := vm.interrupted(); err != nil {
+				return nil, nil, err
+			}
+		case opPushNothing:
+			stack = append(stack, nil)
+		case opNilIfNothing:
+			if stack[len(stack)-1] == nil {
+				stack[len(stack)-1] = vm.SingletonWithName("nil")
+			}
+		case opPushNil:
+			stack = append(stack, vm.SingletonWithName("nil"))
+		case opPushTrue:
+			stack = append(stack, vm.SingletonWithName("true"))
+		case opPushFalse:
+			stack = append(stack, vm.SingletonWithName("false"))
+		case opPushSelf:
+			stack = append(stack, self)
+		case opPushInt:
+			stack = append(stack, NewFixnum(in.node.(ast.ConstantInt).Value, vm))
+		case opPushString:
+			str := in.node.(ast.SimpleString)
+			value := NewString(str.Value, vm)
+			if str.Frozen {
+				value.Freeze()
+			}
+			stack = append(stack, value)
+		case opBareReference:
+			// most are variables, which are looked up first anyway
+			ref := in.node.(ast.BareReference)
+			if value, ok := vm.localVariableStack.frames[0][ref.Name]; ok {
+				stack = append(stack, value)
+				continue
+			}
+			if value, ok := vm.objectSpaceValue(ref.Name); ok {
+				stack = append(stack, value)
+				continue
+			}
+
+			value, err := interpretBareReferenceInContext(vm, ref, self)
+			if err != nil {
+				return nil, nil, err
+			}
+			stack = append(stack, value)
+		case opAssign:
+			vm.Set(in.node.(ast.BareReference).Name, stack[len(stack)-1])
+		case opPop:
+			pop()
+		case opNot:
+			if pop().IsTruthy() {
+				stack = append(stack, vm.SingletonWithName("false"))
+			} else {
+				stack = append(stack, vm.SingletonWithName("true"))
+			}
+		case opNilUnlessTruthy:
+			if !stack[len(stack)-1].IsTruthy() {
+				stack[len(stack)-1] = vm.SingletonWithName("nil")
+			}
+		case opLookup:
+			callExpr := in.node.(ast.CallExpression)
+			target := stack[len(stack)-1]
+			if target == nil {
+				return nil, nil, NewNoMethodError(callExpr.Func.Name, vm.SingletonWithName("nil"), vm)
+			}
+
+			found := target.Method(callExpr.Func.Name)
+			if found == nil {
+				return nil, nil, NewNoMethodError(callExpr.Func.Name, target, vm)
+			}
+			methods = append(methods, found)
+		case opCall:
+			args := make([]Value, in.operand)
+			copy(args, stack[len(stack)-in.operand:])
+			stack = stack[:len(stack)-in.operand]
+			target := pop()
+
+			found := methods[len(methods)-1]
+			methods = methods[:len(methods)-1]
+
+			if in.tail && found == Method(method) {
+				return nil, &tailCall{target: target, args: args}, nil
+			}
+
+			value, err := vm.invokeMethod(found, target, nil, in.node.LineNumber(), args...)
+			if err != nil {
+				return nil, nil, err
+			}
+			stack = append(stack, value)
+		case opJump:
+			pc = in.operand - 1
+		case opJumpUnless:
+			if !pop().IsTruthy() {
+				pc = in.operand - 1
+			}
+		case opJumpIfKeep:
+			if stack[len(stack)-1].IsTruthy() {
+				pc = in.operand - 1
+			} else {
+				pop()
+			}
+		case opReturn:
+			return pop(), nil, nil
+		}
+	}
+
+	return pop(), nil, nil
+}
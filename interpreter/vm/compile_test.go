@@ -0,0 +1,130 @@
+package vm_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("compiled methods", func() {
+	var compiled, walked VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		compiled = NewVM(pathToExecutable, "fake-irb-under-test")
+		walked = NewVMWithOptions(pathToExecutable, "fake-irb-under-test", VMOptions{TreeWalk: true})
+	})
+
+	// runs the program on both vms, expecting the same result from each
+	behavesTheSame := func(program string) string {
+		compiledValue, compiledErr := compiled.Run(program)
+		walkedValue, walkedErr := walked.Run(program)
+
+		if walkedErr != nil {
+			Expect(compiledErr).To(HaveOccurred())
+			Expect(compiledErr.Error()).To(Equal(walkedErr.Error()))
+			return walkedErr.Error()
+		}
+
+		Expect(compiledErr).ToNot(HaveOccurred())
+		Expect(compiledValue.String()).To(Equal(walkedValue.String()))
+		return walkedValue.String()
+	}
+
+	It("evaluates loops, conditions and local variables like the tree walker", func() {
+		Expect(behavesTheSame(`
+def collatz(start)
+  n = start
+  steps = 0
+  while n != 1
+    if (n % 2) == 0
+      n = n / 2
+    else
+      n = (3 * n) + 1
+    end
+    steps += 1
+  end
+  steps
+end
+
+[collatz(27), collatz(1)].inspect
+`)).To(Equal("[111, 0]"))
+	})
+
+	It("evaluates boolean operators, negation and ternaries like the tree walker", func() {
+		Expect(behavesTheSame(`
+def classify(n)
+  small = ((n < 10) and (n > 0))
+  label = ((n == 0) or nil) ? 'zero' : 'other'
+  neither = (nil and 1)
+  fallback = (false or 'fallback')
+  [small, label, neither, fallback, !small]
+end
+
+[classify(5), classify(0)].inspect
+`)).To(Equal(`[[true, "other", nil, "fallback", false], [nil, "zero", nil, "fallback", true]]`))
+	})
+
+	It("returns early from nested statements like the tree walker", func() {
+		Expect(behavesTheSame(`
+def find(n)
+  i = 0
+  until i > n
+    return i if (i * i) >= n
+    i += 1
+  end
+  'none'
+end
+
+[find(10), find(-1)].inspect
+`)).To(Equal(`[4, "none"]`))
+	})
+
+	It("makes calls in tail position without growing the stack like the tree walker", func() {
+		Expect(behavesTheSame(`
+def count(n, total)
+  return total if n == 0
+  n.even? ? count(n - 1, total + 2) : count(n - 1, total)
+end
+
+count(20000, 0)
+`)).To(Equal("20000"))
+	})
+
+	It("raises the same errors as the tree walker", func() {
+		Expect(behavesTheSame(`
+def broken(n)
+  n.explode
+end
+
+broken(1)
+`)).To(ContainSubstring("NoMethodError: undefined method 'explode'"))
+	})
+
+	It("sees methods redefined after the first call", func() {
+		Expect(behavesTheSame(`
+def greeting
+  'hello'
+end
+
+def greet
+  greeting
+end
+
+first = greet
+
+def greeting
+  'goodbye'
+end
+
+[first, greet].inspect
+`)).To(Equal(`["hello", "goodbye"]`))
+	})
+})
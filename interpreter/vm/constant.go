@@ -70,12 +70,7 @@ func interpretConstantInContext(
 	if ok {
 		return constant, nil
 	} else {
-		return nil, NewNameError(
-			constantNode.Name,
-			context.String(),
-			context.Class().String(),
-			vm.stack.String(),
-		)
+		return nil, NewNameError(constantNode.Name, context, vm)
 	}
 
 }
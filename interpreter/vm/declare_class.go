@@ -32,7 +32,7 @@ func interpretClassDeclarationInContext(
 	theClass, ok := vm.CurrentClasses[fullClassName]
 	if !ok {
 		theClass = NewUserDefinedClass(classNode.Name, classNode.SuperClass.FullName(), vm)
-		vm.CurrentClasses[fullClassName] = theClass
+		vm.addClass(fullClassName, theClass)
 	} else {
 		superclassName := classNode.SuperClass.FullName()
 		if superclassName != "" && superclassName != theClass.SuperClass().Name() {
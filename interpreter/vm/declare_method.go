@@ -14,6 +14,7 @@ func interpretMethodDeclarationInContext(
 
 	var returnValue Value
 
+	body := &compiledBody{}
 	method := NewRubyMethod(
 		funcNode.MethodName(),
 		funcNode.LineNumber(),
@@ -22,18 +23,11 @@ func interpretMethodDeclarationInContext(
 		vm,
 		vm,
 		func(self Value, method *RubyMethod) (Value, error) {
-			vm.localVariableStack.Unshift()
-			defer vm.localVariableStack.Shift()
-
-			for _, arg := range method.Args() {
-				vm.localVariableStack.Store(arg.Name, arg.Value)
-			}
-
-			return vm.executeWithContext(self, method.Body()...)
+			return vm.executeMethodBody(self, method, body)
 		})
 	returnValue = method
 
-	if context == vm.ObjectSpace["main"] && funcNode.Target == nil {
+	if context == vm.MustGet("main") && funcNode.Target == nil {
 		method = NewPrivateRubyMethod(
 			funcNode.MethodName(),
 			funcNode.LineNumber(),
@@ -42,24 +36,34 @@ func interpretMethodDeclarationInContext(
 			vm,
 			vm,
 			func(self Value, method *RubyMethod) (Value, error) {
-				vm.localVariableStack.Unshift()
-				defer vm.localVariableStack.Shift()
-
-				for _, arg := range method.Args() {
-					vm.localVariableStack.Store(arg.Name, arg.Value)
-				}
-
-				return vm.executeWithContext(self, method.Body()...)
+				return vm.executeMethodBody(self, method, body)
 			})
 		returnValue = method
-		vm.CurrentModules["Kernel"].AddMethod(method)
+
+		kernel, _ := vm.module("Kernel")
+		if replacesBuiltinMethod(kernel, method.Name()) {
+			return nil, NewFrozenError(kernel, vm)
+		}
+		kernel.AddMethod(method)
 	} else if vm.inEigenclassBlock {
+		if err := vm.checkCanDefineSingletonMethods(context); err != nil {
+			return nil, err
+		}
+
 		context.AddMethod(method)
 	} else {
 		switch funcNode.Target.(type) {
 		case ast.Self:
+			if context.IsFrozen() {
+				return nil, NewFrozenError(context, vm)
+			}
+
 			context.AddMethod(method)
 		case nil:
+			if context.IsFrozen() {
+				return nil, NewFrozenError(context, vm)
+			}
+
 			switch context.(Module).ActiveVisibility() {
 			case Public:
 				method.SetVisibility(Public)
@@ -75,6 +79,9 @@ func interpretMethodDeclarationInContext(
 			if err != nil {
 				return nil, err
 			}
+			if err := vm.checkCanDefineSingletonMethods(value); err != nil {
+				return nil, err
+			}
 
 			value.AddMethod(method)
 		}
@@ -82,3 +89,34 @@ func interpretMethodDeclarationInContext(
 
 	return returnValue, nil
 }
+
+// a sandboxed program may still define methods at the top level, which go
+// to Kernel, but not replace the methods Kernel had when it was frozen
+func replacesBuiltinMethod(kernel Module, name string) bool {
+	if !kernel.IsFrozen() {
+		return false
+	}
+
+	for _, method := range kernel.Methods() {
+		if method.Name() == name {
+			_, defined := method.(*RubyMethod)
+			return !defined
+		}
+	}
+
+	return false
+}
+
+// numbers and symbols are immutable values shared by the whole program, so
+// like in MRI they cannot have singleton methods of their own
+func (vm *vm) checkCanDefineSingletonMethods(value Value) error {
+	if IsKindOf(value, vm.CurrentClasses["Numeric"]) || IsKindOf(value, vm.CurrentClasses["Symbol"]) {
+		return NewTypeError("can't define singleton", vm)
+	}
+
+	if value.IsFrozen() {
+		return NewFrozenError(value, vm)
+	}
+
+	return nil
+}
@@ -33,8 +33,12 @@ func interpretModuleDeclarationInContext(
 		fullModuleName = moduleNode.FullName()
 	}
 
-	theModule := NewModule(moduleNode.Name, vm)
-	vm.CurrentModules[fullModuleName] = theModule
+	// a module declared again is reopened, keeping the methods it had
+	theModule, ok := vm.CurrentModules[fullModuleName]
+	if !ok {
+		theModule = NewModule(moduleNode.Name, vm)
+		vm.addModule(fullModuleName, theModule)
+	}
 
 	if currentModule != nil {
 		currentModule.SetConstant(moduleNode.Name, theModule)
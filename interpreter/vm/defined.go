@@ -6,11 +6,90 @@ import (
 	. "github.com/grubby/grubby/interpreter/vm/builtins"
 )
 
+// defined? describes its operand without evaluating it (mostly), and
+// returns nil rather than raising when the operand is not defined
 func interpretDefinedKeyword(vm *vm, defined ast.Defined, context Value) (Value, error) {
-	_, err := vm.executeWithContext(context, defined.Node)
-	if err == nil {
-		return NewString("garbage", vm), nil
-	} else {
+	description := describeDefinedNode(vm, defined.Node, context)
+	if description == "" {
 		return vm.SingletonWithName("nil"), nil
 	}
+
+	return NewString(description, vm), nil
+}
+
+func describeDefinedNode(vm *vm, node ast.Node, context Value) string {
+	switch node := node.(type) {
+	case ast.Group:
+		if len(node.Body) != 1 {
+			return "expression"
+		}
+		return describeDefinedNode(vm, node.Body[0], context)
+	case ast.BareReference:
+		if _, err := vm.localVariableStack.Retrieve(node.Name); err == nil {
+			return "local-variable"
+		}
+		if _, ok := vm.objectSpaceValue(node.Name); ok {
+			return "local-variable"
+		}
+		if context.Method(node.Name) != nil {
+			return "method"
+		}
+		return ""
+	case ast.InstanceVariable:
+		if context.GetInstanceVariable(node.Name) != nil {
+			return "instance-variable"
+		}
+		return ""
+	case ast.ClassVariable:
+		if LookupClassVariable(ClassVariableScope(context), node.Name) != nil {
+			return "class variable"
+		}
+		return ""
+	case ast.GlobalVariable:
+		if _, ok := vm.global(node.Name); ok {
+			return "global-variable"
+		}
+		return ""
+	case ast.Constant:
+		if _, err := interpretConstantInContext(vm, node, context); err == nil {
+			return "constant"
+		}
+		return ""
+	case ast.CallExpression:
+		return describeDefinedCall(vm, node, context)
+	case ast.Assignment, ast.ConditionalAssignment:
+		return "assignment"
+	case ast.Self:
+		return "self"
+	default:
+		return "expression"
+	}
+}
+
+// a method call is defined when its receiver can be evaluated without
+// raising and responds to the method (publicly, if given explicitly)
+func describeDefinedCall(vm *vm, call ast.CallExpression, context Value) string {
+	name := call.Func.Name
+	if call.Target == nil {
+		if context.Method(name) != nil {
+			return "method"
+		}
+		return ""
+	}
+
+	if describeDefinedNode(vm, call.Target, context) == "" {
+		return ""
+	}
+
+	receiver, err := vm.executeWithContext(context, call.Target)
+	if err != nil || receiver == nil {
+		return ""
+	}
+
+	method := receiver.Method(name)
+	if method == nil || !method.IsPublic() {
+		return ""
+	}
+
+	return "method"
 }
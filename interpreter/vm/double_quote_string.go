@@ -1,7 +1,7 @@
 package vm
 
 import (
-	"strings"
+	"bytes"
 
 	"github.com/grubby/grubby/ast"
 	"github.com/tjarratt/gomads"
@@ -9,6 +9,9 @@ import (
 	. "github.com/grubby/grubby/interpreter/vm/builtins"
 )
 
+// the parser leaves each #{...} in the value of the string for us to
+// evaluate, and puts a backslash before any other backslash, or any #
+// that is not the start of one
 func interpretDoubleQuotedStringInContext(
 	vm *vm,
 	stringValue ast.InterpolatedString,
@@ -16,46 +19,62 @@ func interpretDoubleQuotedStringInContext(
 ) (Value, error) {
 
 	str := stringValue.Value
-	currentBytes := []byte{}
-	bytesToInterpret := [][]byte{}
-	insideInterpolation := false
+	var buffer bytes.Buffer
 	for i := 0; i < len(str); i++ {
-		if insideInterpolation {
-			currentBytes = append(currentBytes, str[i])
-		}
+		switch {
+		case str[i] == '\\' && i+1 < len(str):
+			i++
+			buffer.WriteByte(str[i])
+		case str[i] == '#' && i+1 < len(str) && str[i+1] == '{':
+			end := interpolationEnd(str, i+2)
+			rubyValue, err := vm.EvaluateStringInContext(str[i+2:end], context)
+			if err != nil {
+				return nil, err
+			}
+
+			valueAsString := gomads.Maybe(func() interface{} {
+				method := rubyValue.Method("to_s")
+				if method == nil {
+					return nil
+				}
 
-		if str[i] == '}' && i > 0 && str[i-1] != '\\' {
-			insideInterpolation = false
-			bytesToInterpret = append(bytesToInterpret, currentBytes)
-			currentBytes = []byte{}
-		} else if str[i] == '#' && len(str) > i && str[i+1] == '{' {
-			insideInterpolation = true
+				result, err := method.Execute(rubyValue, nil)
+				if err != nil {
+					return nil
+				}
+
+				return result.(*StringValue).RawString()
+			}).OrSome(rubyValue.String()).Value().(string)
+
+			buffer.WriteString(valueAsString)
+			i = end
+		default:
+			buffer.WriteByte(str[i])
 		}
 	}
 
-	for _, bytes := range bytesToInterpret {
-		substringToReplace := string(bytes)
-		rubyValue, err := vm.EvaluateStringInContext(substringToReplace[1:len(substringToReplace)-1], context)
-		if err != nil {
-			return nil, err
-		}
+	value := NewString(buffer.String(), vm)
+	if stringValue.Frozen {
+		value.Freeze()
+	}
 
-		valueAsString := gomads.Maybe(func() interface{} {
-			method := rubyValue.Method("to_s")
-			if method == nil {
-				return nil
-			}
+	return value, nil
+}
 
-			result, err := method.Execute(rubyValue, nil)
-			if err != nil {
-				return nil
+// interpolationEnd finds the } closing the interpolation starting at start
+func interpolationEnd(str string, start int) int {
+	depth := 1
+	for i := start; i < len(str); i++ {
+		switch str[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
 			}
-
-			return result.(*StringValue).RawString()
-		}).OrSome(rubyValue.String()).Value().(string)
-
-		str = strings.Replace(str, "#"+substringToReplace, valueAsString, 1)
+		}
 	}
 
-	return NewString(str, vm), nil
+	return len(str)
 }
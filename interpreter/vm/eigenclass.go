@@ -18,6 +18,10 @@ func interpretEigenclassInContext(
 		return nil, err
 	}
 
+	if err := vm.checkCanDefineSingletonMethods(blockContext); err != nil {
+		return nil, err
+	}
+
 	vm.inEigenclassBlock = true
 	defer func() { vm.inEigenclassBlock = false }()
 
@@ -75,4 +75,54 @@ end
 			Expect(class).To(HaveMethod("my_method"))
 		})
 	})
+
+	Describe("opened by defining a method on self in a class body", func() {
+		BeforeEach(func() {
+			_, err := vm.Run(`
+class Widget
+  def self.create(name)
+    widget = new
+    widget.name = name
+    widget
+  end
+
+  attr_accessor :name
+end
+
+class Gadget < Widget
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("defines a method callable on the class", func() {
+			value, err := vm.Run("Widget.create('knob').name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("knob"))
+		})
+
+		It("does not define the method on instances", func() {
+			_, err := vm.Run("Widget.new.create('knob')")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("undefined method 'create'"))
+		})
+
+		It("is inherited by subclasses, even when defined after them", func() {
+			value, err := vm.Run(`
+class Widget
+  def self.kind
+    :widget
+  end
+end
+
+Gadget.kind
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.Symbols()["widget"]))
+
+			value, err = vm.Run("Gadget.create('lever').name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("lever"))
+		})
+	})
 })
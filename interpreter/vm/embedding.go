@@ -0,0 +1,90 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// GoFunction is a go function that ruby code can call as a method
+// its args are the arguments of the call, any block given is ignored
+type GoFunction func(args ...Value) (Value, error)
+
+// Eval runs the source like Run does, but converts the result into a go
+// value with ToGo, so that eg: an Array is returned as an []interface{}
+func (vm *vm) Eval(input string) (interface{}, error) {
+	value, err := vm.Run(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToGo(value), nil
+}
+
+// SetGo converts the go value into a ruby one with FromGo, and sets the
+// variable to it, as Set does
+func (vm *vm) SetGo(name string, value interface{}) error {
+	converted, err := FromGo(value, vm)
+	if err != nil {
+		return err
+	}
+
+	vm.Set(name, converted)
+	return nil
+}
+
+// DefineMethod makes fn callable from anywhere in ruby, as a method
+// defined at the top level would be
+func (vm *vm) DefineMethod(name string, fn GoFunction) error {
+	method := NewNativePrivateMethod(name, vm, vm.goMethodBody(fn))
+
+	// held from checking for the method until it is added, so that
+	// goroutines defining the same method can't both succeed
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
+	if vm.ObjectSpace["main"].Method(name) != nil {
+		return errors.New(fmt.Sprintf("method '%s' is already defined", name))
+	}
+
+	vm.CurrentModules["Kernel"].AddMethod(method)
+	return nil
+}
+
+// DefineMethodOn makes fn an instance method of the class or module named
+func (vm *vm) DefineMethodOn(moduleName, name string, fn GoFunction) error {
+	method := NewNativeMethod(name, vm, vm.goMethodBody(fn))
+
+	// as in DefineMethod
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
+	var module Module
+	if class, ok := vm.CurrentClasses[moduleName]; ok {
+		module = class
+	} else if mod, ok := vm.CurrentModules[moduleName]; ok {
+		module = mod
+	} else {
+		return errors.New(fmt.Sprintf("Class or module '%s' not found", moduleName))
+	}
+
+	if HasInstanceMethod(module, name) {
+		return errors.New(fmt.Sprintf("method '%s' is already defined for %s", name, moduleName))
+	}
+
+	module.AddInstanceMethod(method)
+	return nil
+}
+
+// a go function returning a nil Value returns nil to ruby
+func (vm *vm) goMethodBody(fn GoFunction) func(self Value, block Block, args ...Value) (Value, error) {
+	return func(self Value, block Block, args ...Value) (Value, error) {
+		result, err := fn(args...)
+		if result == nil && err == nil {
+			return vm.SingletonWithName("nil"), nil
+		}
+
+		return result, err
+	}
+}
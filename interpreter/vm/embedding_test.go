@@ -0,0 +1,640 @@
+package vm_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("embedding the vm", func() {
+	var vm VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+	})
+
+	Describe("evaluating ruby into go values", func() {
+		It("converts the simple values", func() {
+			value, err := vm.Eval("nil")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(BeNil())
+
+			for source, expected := range map[string]interface{}{
+				"1 == 1":  true,
+				"1 == 2":  false,
+				"40 + 2":  42,
+				"1.5 * 3": 4.5,
+				"'hello'": "hello",
+				":sym":    "sym",
+			} {
+				value, err := vm.Eval(source)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal(expected), source)
+			}
+		})
+
+		It("converts arrays and hashes, and what they contain", func() {
+			value, err := vm.Eval("[1, ['two', :three], {'four' => 4.0, :five => [nil]}]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal([]interface{}{
+				1,
+				[]interface{}{"two", "three"},
+				map[string]interface{}{"four": 4.0, "five": []interface{}{nil}},
+			}))
+		})
+
+		It("keeps keys that are not strings or symbols", func() {
+			value, err := vm.Eval("{1 => 'one', 'two' => 2}")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(map[interface{}]interface{}{1: "one", "two": 2}))
+		})
+
+		It("returns any other value as it is", func() {
+			value, err := vm.Eval("class Widget; end; Widget.new")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(BeAssignableToTypeOf(&UserDefinedClassInstance{}))
+
+			value, err = vm.Eval("a = [1]; a << a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.([]interface{})[1]).To(BeAssignableToTypeOf(&Array{}))
+		})
+
+		It("returns the error raised", func() {
+			_, err := vm.Eval("1 + nil")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("TypeError"))
+		})
+	})
+
+	Describe("setting variables to go values", func() {
+		It("converts simple values", func() {
+			Expect(vm.SetGo("name", "grubby")).To(Succeed())
+			Expect(vm.SetGo("count", 3)).To(Succeed())
+			Expect(vm.SetGo("ratio", 0.5)).To(Succeed())
+			Expect(vm.SetGo("enabled", true)).To(Succeed())
+			Expect(vm.SetGo("nothing", nil)).To(Succeed())
+
+			value, err := vm.Run("[name, count + 1, ratio * 2, enabled, nothing].inspect")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString(`["grubby", 4, 1.0, true, nil]`))
+		})
+
+		It("converts nested maps and slices", func() {
+			err := vm.SetGo("config", map[string]interface{}{
+				"hosts":   []string{"a", "b"},
+				"retries": map[string]interface{}{"max": uint8(5)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			value, err := vm.Run("config['hosts']")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(HaveLen(2))
+			Expect(value.(*Array).Members()[1]).To(EqualRubyString("b"))
+
+			value, err = vm.Run("config['retries']['max']")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(5, vm)))
+		})
+
+		It("round trips through Eval", func() {
+			Expect(vm.SetGo("list", []interface{}{1, "two", []interface{}{3.0}})).To(Succeed())
+
+			value, err := vm.Eval("list")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal([]interface{}{1, "two", []interface{}{3.0}}))
+		})
+
+		It("returns an error for values it cannot convert", func() {
+			err := vm.SetGo("callback", func() {})
+			Expect(err).To(MatchError("cannot convert a func() into a ruby value"))
+		})
+	})
+
+	Describe("running with a context", func() {
+		It("stops once the deadline passes, leaving the vm usable", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			_, err := vm.RunWithContext(ctx, `
+count = 0
+while true
+  count += 1
+end
+`)
+			Expect(err).To(BeAssignableToTypeOf(&InterruptedError{}))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(err.Error()).To(Equal("timeout: context deadline exceeded"))
+
+			value, err := vm.Run("count > 0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("stops when the context is canceled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			err := vm.DefineMethod("cancel", func(args ...Value) (Value, error) {
+				cancel()
+				return nil, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = vm.RunWithContext(ctx, "reached = 1; cancel; reached = 2")
+			Expect(err).To(MatchError("interrupted: context canceled"))
+
+			value, err := vm.Run("reached")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+		})
+
+		It("cannot be rescued by the program", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			_, err := vm.RunWithContext(ctx, `
+begin
+  while true
+  end
+rescue
+  'rescued'
+end
+`)
+			Expect(err).To(BeAssignableToTypeOf(&InterruptedError{}))
+		})
+
+		It("wakes the program from sleep once the deadline passes", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			started := time.Now()
+			_, err := vm.RunWithContext(ctx, "sleep(10)")
+			Expect(err).To(MatchError("timeout: context deadline exceeded"))
+			Expect(time.Since(started)).To(BeNumerically("<", time.Second))
+		})
+
+		It("sleeps without a duration until the deadline passes", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			started := time.Now()
+			_, err := vm.RunWithContext(ctx, "sleep")
+			Expect(err).To(MatchError("timeout: context deadline exceeded"))
+			Expect(time.Since(started)).To(BeNumerically(">=", 20*time.Millisecond))
+		})
+
+		It("sleeps without a duration until the context is canceled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(20*time.Millisecond, cancel)
+
+			_, err := vm.RunWithContext(ctx, "sleep()")
+			Expect(err).To(MatchError("interrupted: context canceled"))
+		})
+	})
+
+	Describe("defining methods in go", func() {
+		var received []Value
+
+		BeforeEach(func() {
+			received = nil
+
+			err := vm.DefineMethod("compute", func(args ...Value) (Value, error) {
+				received = args
+				return NewString("computed", vm), nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can be called from the top level with the arguments given", func() {
+			value, err := vm.Run("compute(1, 'two')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("computed"))
+
+			Expect(received).To(HaveLen(2))
+			Expect(received[0]).To(Equal(NewFixnum(1, vm)))
+			Expect(received[1]).To(EqualRubyString("two"))
+		})
+
+		It("can be called from within a method", func() {
+			value, err := vm.Run(`
+class Calculator
+  def run
+    compute
+  end
+end
+
+Calculator.new.run
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("computed"))
+		})
+
+		It("raises the error returned by the function", func() {
+			err := vm.DefineMethod("explode", func(args ...Value) (Value, error) {
+				return nil, errors.New("kaboom")
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = vm.Run("explode")
+			Expect(err).To(MatchError("kaboom"))
+		})
+
+		It("returns nil to ruby when the function returns no value", func() {
+			err := vm.DefineMethod("nothing", func(args ...Value) (Value, error) {
+				return nil, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			value, err := vm.Run("nothing")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("returns an error when the name is already taken", func() {
+			err := vm.DefineMethod("puts", func(args ...Value) (Value, error) {
+				return nil, nil
+			})
+			Expect(err).To(MatchError("method 'puts' is already defined"))
+
+			err = vm.DefineMethod("compute", func(args ...Value) (Value, error) {
+				return nil, nil
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("can define instance methods of a class", func() {
+			err := vm.DefineMethodOn("String", "shout", func(args ...Value) (Value, error) {
+				return NewString("HEY", vm), nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			value, err := vm.Run("'hey'.shout")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("HEY"))
+		})
+
+		It("adds methods to the arrays, hashes and booleans", func() {
+			for _, className := range []string{"Array", "Hash", "TrueClass", "FalseClass"} {
+				className := className
+				err := vm.DefineMethodOn(className, "describe", func(args ...Value) (Value, error) {
+					return NewString(className, vm), nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			value, err := vm.Run("[[1, 2].describe, {}.describe, true.describe, false.describe].inspect")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString(`["Array", "Hash", "TrueClass", "FalseClass"]`))
+		})
+
+		It("does not replace a method a class already has, or inherits", func() {
+			err := vm.DefineMethodOn("String", "strip", func(args ...Value) (Value, error) {
+				return nil, nil
+			})
+			Expect(err).To(MatchError("method 'strip' is already defined for String"))
+
+			err = vm.DefineMethodOn("Fixnum", "step", func(args ...Value) (Value, error) {
+				return nil, nil
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error for a class that does not exist", func() {
+			err := vm.DefineMethodOn("Nonexistent", "anything", func(args ...Value) (Value, error) {
+				return nil, nil
+			})
+			Expect(err).To(MatchError("Class or module 'Nonexistent' not found"))
+		})
+	})
+
+	Describe("sharing the vm between goroutines", func() {
+		It("interns symbols and numbers, and sets variables and globals, safely", func() {
+			symbols := make([]Value, 20)
+			numbers := make([]Value, 20)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					symbols[i] = NewSymbol("shared", vm)
+					numbers[i] = NewFixnum(123456, vm)
+					vm.Set("shared", numbers[i])
+					vm.GlobalProvider().SetGlobal("shared", symbols[i])
+
+					_, err := vm.Get("shared")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vm.Symbols()).To(HaveKey("shared"))
+					Expect(vm.Globals()).To(HaveKey("shared"))
+				}(i)
+			}
+			wg.Wait()
+
+			for i := range symbols {
+				Expect(symbols[i]).To(BeIdenticalTo(vm.Symbols()["shared"]))
+				Expect(numbers[i]).To(BeIdenticalTo(NewFixnum(123456, vm)))
+			}
+		})
+
+		It("looks up classes and modules safely while a program declares them", func() {
+			program := ""
+			for i := 0; i < 20; i++ {
+				program += fmt.Sprintf("class Shared%d; end\nmodule Mixin%d; end\n", i, i)
+			}
+
+			done := make(chan error)
+			go func() {
+				_, err := vm.Run(program)
+				done <- err
+			}()
+
+			for i := 0; i < 200; i++ {
+				vm.GetClass("Shared0")
+				vm.GetModule("Mixin0")
+				vm.ClassProvider().ClassWithName("String")
+				Expect(len(vm.Classes())).To(BeNumerically(">", 0))
+				Expect(len(vm.Modules())).To(BeNumerically(">", 0))
+			}
+
+			Expect(<-done).ToNot(HaveOccurred())
+			Expect(vm.Classes()).To(HaveKey("Shared19"))
+			Expect(vm.Modules()).To(HaveKey("Mixin19"))
+		})
+
+		It("defines a method once when goroutines define it at the same time", func() {
+			var defined, definedOn int32
+
+			start := make(chan struct{})
+			var wg sync.WaitGroup
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-start
+
+					body := func(args ...Value) (Value, error) { return nil, nil }
+					if vm.DefineMethod("racing", body) == nil {
+						atomic.AddInt32(&defined, 1)
+					}
+					if vm.DefineMethodOn("String", "racing_on", body) == nil {
+						atomic.AddInt32(&definedOn, 1)
+					}
+				}()
+			}
+			close(start)
+			wg.Wait()
+
+			Expect(defined).To(Equal(int32(1)))
+			Expect(definedOn).To(Equal(int32(1)))
+		})
+
+		It("reads globals and variables safely while the vm is reset", func() {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for i := 0; i < 5; i++ {
+					vm.Reset()
+				}
+			}()
+
+			for resetting := true; resetting; {
+				select {
+				case <-done:
+					resetting = false
+				default:
+				}
+
+				vm.Get("main")
+				vm.Globals()
+				vm.ClassProvider().ClassWithName("Array")
+			}
+
+			Expect(vm.Globals()).To(HaveKey("LOAD_PATH"))
+			_, err := vm.Get("main")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("running programs from several goroutines", func() {
+		It("runs them one at a time on a shared vm", func() {
+			_, err := vm.Run(`
+def fib(n)
+  n < 2 ? n : fib(n - 1) + fib(n - 2)
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			results := make([]Value, 10)
+			errs := make([]error, 10)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					results[i], errs[i] = vm.Run(fmt.Sprintf("fib(%d)", i+5))
+				}(i)
+			}
+			wg.Wait()
+
+			for i, result := range results {
+				Expect(errs[i]).ToNot(HaveOccurred())
+				Expect(result).To(Equal(NewFixnum(int64([]int{5, 8, 13, 21, 34, 55, 89, 144, 233, 377}[i]), vm)))
+			}
+			Expect(vm.CurrentStack()).To(BeEmpty())
+		})
+
+		It("runs programs on separate vms at the same time", func() {
+			pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+			Expect(err).ToNot(HaveOccurred())
+
+			results := make([]interface{}, 10)
+			errs := make([]error, 10)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					results[i], errs[i] = NewVM(pathToExecutable, "fake-irb-under-test").Eval(fmt.Sprintf("[%d].map { |x| x * 2 }", i))
+				}(i)
+			}
+			wg.Wait()
+
+			for i, result := range results {
+				Expect(errs[i]).ToNot(HaveOccurred())
+				Expect(result).To(Equal([]interface{}{i * 2}))
+			}
+		})
+
+		It("keeps the methods each vm looks up to itself", func() {
+			pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+			Expect(err).ToNot(HaveOccurred())
+
+			other := NewVM(pathToExecutable, "fake-irb-under-test")
+			Expect(other.MethodCache()).ToNot(BeIdenticalTo(vm.MethodCache()))
+
+			done := make(chan error)
+			go func() {
+				_, err := other.Run(`
+i = 0
+while i < 100
+  class String
+    def shout
+      upcase
+    end
+  end
+  i += 1
+end
+`)
+				done <- err
+			}()
+
+			for i := 0; i < 100; i++ {
+				result, err := vm.Eval("'quiet'.upcase")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal("QUIET"))
+			}
+			Expect(<-done).ToNot(HaveOccurred())
+
+			_, err = vm.Run("'quiet'.shout")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("undefined method 'shout'"))
+
+			result, err := other.Eval("'loud'.shout")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal("LOUD"))
+		})
+	})
+
+	Describe("sandboxing", func() {
+		BeforeEach(func() {
+			pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+			if err != nil {
+				panic(err)
+			}
+
+			vm = NewVMWithOptions(pathToExecutable, "fake-irb-under-test", VMOptions{
+				Sandbox:    true,
+				Reopenable: []string{"String"},
+			})
+		})
+
+		It("does not let the program monkeypatch the builtin classes", func() {
+			_, err := vm.Run(`
+class Integer
+  def to_s
+    'hacked'
+  end
+end
+`)
+			Expect(err).To(BeAssignableToTypeOf(&ExceptionValue{}))
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("FrozenError")))
+			Expect(err.Error()).To(ContainSubstring("FrozenError: can't modify frozen class: Integer"))
+
+			value, err := vm.Run("Integer.instance_methods(false).include?(:to_s)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+		})
+
+		It("does not let the program alias, undefine or add singleton methods either", func() {
+			for _, program := range []string{
+				"class Object; alias_method :old_inspect, :inspect; end",
+				"class Array; undef_method :push; end",
+				"module Kernel; def sneaky; end; end",
+				"def Object.sneaky; end",
+				"class Hash; include Comparable; end",
+			} {
+				_, err := vm.Run(program)
+				Expect(err).To(HaveOccurred(), program)
+				Expect(err.Error()).To(ContainSubstring("FrozenError"), program)
+			}
+		})
+
+		It("does not let the program replace the builtin methods and constants at the top level", func() {
+			for _, program := range []string{
+				"def puts(*args); end",
+				"String = 5",
+				"Math ||= 5",
+				"File::FNM_SYSCASE = 3",
+			} {
+				_, err := vm.Run(program)
+				Expect(err).To(HaveOccurred(), program)
+				Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("FrozenError")), program)
+			}
+
+			value, err := vm.Run("LIMIT = 3; def puts_twice(s); puts(s); puts(s); end; [LIMIT, File::FNM_SYSCASE, String]")
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members[0]).To(Equal(NewFixnum(3, vm)))
+			Expect(members[1]).To(Equal(NewFixnum(0, vm)))
+			Expect(members[2]).To(BeIdenticalTo(vm.MustGetClass("String")))
+		})
+
+		It("lets the program reopen the classes it was allowed to", func() {
+			value, err := vm.Run(`
+class String
+  def shout
+    self + '!'
+  end
+end
+'hey'.shout
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("hey!"))
+		})
+
+		It("still lets the program define its own classes and methods", func() {
+			value, err := vm.Run(`
+def double(n)
+  n * 2
+end
+
+class Widget < Object
+  def size
+    double(21)
+  end
+end
+Widget.new.size
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(42, vm)))
+		})
+
+		It("still lets the embedder define methods", func() {
+			err := vm.DefineMethodOn("Integer", "answer", func(args ...Value) (Value, error) {
+				return NewFixnum(42, vm), nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			value, err := vm.Run("1.answer")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(42, vm)))
+		})
+
+		It("freezes the builtins again when the vm is reset", func() {
+			vm.Reset()
+
+			_, err := vm.Run("class Integer; def answer; 42; end; end")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("FrozenError"))
+		})
+	})
+})
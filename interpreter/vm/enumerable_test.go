@@ -36,4 +36,51 @@ var _ = Describe("Enumerable collections", func() {
 			Expect(value.(*Array).Members()).To(ContainElement(NewFixnum(2, vm)))
 		})
 	})
+
+	Describe("group_by", func() {
+		It("groups the elements by the result of the block, in insertion order", func() {
+			value, err := vm.Run("(1..6).group_by { |x| x % 3 }")
+			Expect(err).ToNot(HaveOccurred())
+
+			groups := value.(*Hash)
+			Expect(groups.Len()).To(Equal(3))
+			Expect(groups.PrettyPrint()).To(Equal("{1=>[1, 4], 2=>[2, 5], 0=>[3, 6]}"))
+		})
+
+		It("works on arrays", func() {
+			value, err := vm.Run("['a', 'b ', 'c '].group_by { |s| s.strip == s }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`{true=>["a"], false=>["b ", "c "]}`))
+		})
+
+		It("returns an enumerator without a block", func() {
+			value, err := vm.Run("[1, 2, 3].group_by.with_index { |x, i| i > 0 }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("{false=>[1], true=>[2, 3]}"))
+		})
+	})
+
+	Describe("partition", func() {
+		It("splits the elements into those the block is truthy for, and the rest", func() {
+			value, err := vm.Run("(1..6).partition { |x| x.even? }")
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members).To(HaveLen(2))
+			Expect(members[0].(*Array).Members()).To(Equal([]Value{NewFixnum(2, vm), NewFixnum(4, vm), NewFixnum(6, vm)}))
+			Expect(members[1].(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(3, vm), NewFixnum(5, vm)}))
+		})
+
+		It("yields hash entries as [key, value] pairs", func() {
+			value, err := vm.Run("{:a => 1, :b => 2}.partition { |pair| pair.include?(2) }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[[[:b, 2]], [[:a, 1]]]"))
+		})
+
+		It("returns an enumerator without a block", func() {
+			value, err := vm.Run("(1..3).partition")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("#<Enumerator: 1..3:partition>"))
+		})
+	})
 })
@@ -0,0 +1,206 @@
+package vm_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Enumerator", func() {
+	var vm VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+	})
+
+	It("is returned by iterators called without a block", func() {
+		value, err := vm.Run("[1, 2, 3].each")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.Class().String()).To(Equal("Enumerator"))
+		Expect(value.PrettyPrint()).To(Equal("#<Enumerator: [1, 2, 3]:each>"))
+	})
+
+	It("returns each element in turn from #next", func() {
+		value, err := vm.Run(`
+enumerator = [1, 2, 3].each
+[enumerator.next, enumerator.next, enumerator.next]
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm), NewFixnum(3, vm)}))
+	})
+
+	It("raises StopIteration once the elements run out", func() {
+		_, err := vm.Run(`
+enumerator = [1].each
+enumerator.next
+enumerator.next
+`)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("StopIteration: iteration reached an end"))
+	})
+
+	It("raises a StopIteration that can be rescued", func() {
+		value, err := vm.Run(`
+enumerator = [].each
+result = nil
+begin
+  enumerator.next
+rescue StopIteration
+  result = 'done'
+end
+result
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(EqualRubyString("done"))
+	})
+
+	It("looks at the next element without consuming it with #peek", func() {
+		value, err := vm.Run(`
+enumerator = [1, 2].each
+[enumerator.peek, enumerator.peek, enumerator.next, enumerator.peek]
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(1, vm), NewFixnum(1, vm), NewFixnum(2, vm)}))
+	})
+
+	It("starts over after #rewind", func() {
+		value, err := vm.Run(`
+enumerator = [1, 2].each
+enumerator.next
+enumerator.next
+enumerator.rewind
+enumerator.next
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(1, vm)))
+	})
+
+	It("yields the pairs of a hash", func() {
+		value, err := vm.Run("{a: 1}.each.next")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.PrettyPrint()).To(Equal("[:a, 1]"))
+	})
+
+	It("iterates over ranges", func() {
+		value, err := vm.Run(`
+enumerator = (5..9).each
+enumerator.next
+enumerator.next
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(6, vm)))
+	})
+
+	It("stops iterating once it is collected part way through", func() {
+		before := runtime.NumGoroutine()
+		_, err := vm.Run("(1..50).each { [1, 2].each.next }")
+		Expect(err).ToNot(HaveOccurred())
+
+		// the collection is not exact, so a few may be left for a while
+		Eventually(func() int {
+			runtime.GC()
+			_, err := vm.Run("[1].each.next")
+			Expect(err).ToNot(HaveOccurred())
+			return runtime.NumGoroutine()
+		}).Should(BeNumerically("<", before+10))
+	})
+
+	It("is returned by the array iterators that take a block", func() {
+		value, err := vm.Run(`
+[
+  [3, 1, 2].min_by.with_index { |x, i| i },
+  [3, 1, 2].max_by.with_index { |x, i| i },
+  [3, 1, 2].sort_by.with_index { |x, i| -i },
+  [1, 2, 3].take_while.with_index { |x, i| i < 2 },
+  [1, 2, 3].drop_while.with_index { |x, i| i < 2 },
+  [1, 2, 3].each_slice(2).next,
+  [1, 2, 3].each_cons(2).next
+]
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.PrettyPrint()).To(Equal("[3, 2, [2, 1, 3], [1, 2], [3], [1, 2], [1, 2]]"))
+	})
+
+	Describe("#with_index", func() {
+		It("yields each element with its index", func() {
+			value, err := vm.Run(`
+pairs = []
+[:a, :b].each.with_index do |element, index|
+  pairs << index
+end
+pairs
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(0, vm), NewFixnum(1, vm)}))
+		})
+
+		It("starts counting from the offset given", func() {
+			value, err := vm.Run("[10, 20].each.with_index(1).to_a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[[10, 1], [20, 2]]"))
+		})
+
+		It("returns the result of the iterator", func() {
+			value, err := vm.Run("[10, 20].map.with_index { |x, i| x + i }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(10, vm), NewFixnum(21, vm)}))
+		})
+	})
+
+	Describe("#lazy", func() {
+		It("only takes as many elements from an infinite range as needed", func() {
+			value, err := vm.Run("(1..Float::INFINITY).lazy.map { |x| x * 2 }.first(5)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[2, 4, 6, 8, 10]"))
+		})
+
+		It("chains select, map and take", func() {
+			value, err := vm.Run("(1..Float::INFINITY).lazy.select { |x| x.even? }.map { |x| x * x }.take(3).to_a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[4, 16, 36]"))
+		})
+
+		It("does not call the blocks in the chain until it is forced", func() {
+			value, err := vm.Run(`
+seen = []
+lazy = [1, 2, 3, 4].lazy.map do |x|
+  seen << x
+  x
+end
+seen
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(BeEmpty())
+
+			value, err = vm.Run(`
+lazy.first(2)
+seen
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[1, 2]"))
+		})
+
+		It("returns the first element when #first is not given a count", func() {
+			value, err := vm.Run("(1..Float::INFINITY).lazy.reject { |x| x < 4 }.first")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(4, vm)))
+		})
+
+		It("is inspected as the chain of its steps", func() {
+			value, err := vm.Run("(1..3).lazy.map { |x| x }.take(2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("#<Enumerator::Lazy: #<Enumerator::Lazy: #<Enumerator::Lazy: 1..3>:map>:take(2)>"))
+		})
+	})
+})
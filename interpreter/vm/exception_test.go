@@ -0,0 +1,297 @@
+package vm_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("exceptions", func() {
+	var vm VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+	})
+
+	Describe("raise", func() {
+		It("raises a RuntimeError with a message", func() {
+			_, err := vm.Run("raise 'boom'")
+			Expect(err).To(BeAssignableToTypeOf(&ExceptionValue{}))
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("RuntimeError")))
+			Expect(err.Error()).To(HavePrefix("RuntimeError: boom"))
+		})
+
+		It("raises an instance of the class given, with the message given", func() {
+			_, err := vm.Run("raise ArgumentError, 'wrong'")
+			Expect(err).To(BeAssignableToTypeOf(&ExceptionValue{}))
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("ArgumentError")))
+			Expect(err.Error()).To(HavePrefix("ArgumentError: wrong"))
+		})
+
+		It("raises an exception it is given", func() {
+			_, err := vm.Run("raise IndexError.new('out of range')")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("IndexError: out of range"))
+		})
+
+		It("raises a TypeError for anything else", func() {
+			_, err := vm.Run("raise 1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("TypeError: exception class/object expected"))
+		})
+	})
+
+	Describe("ArgumentError", func() {
+		It("is a StandardError, so a bare rescue handles it and its subclasses", func() {
+			value, err := vm.Run(`
+rescued = []
+begin
+  raise ArgumentError
+rescue => e
+  rescued << e.class
+end
+begin
+  (0 - 1).digits
+rescue => e
+  rescued << e.class
+end
+begin
+  throw :nobody_catches_this
+rescue => e
+  rescued << e.class
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[ArgumentError, Math::DomainError, UncaughtThrowError]"))
+		})
+
+		It("is handled by the rescue modifier", func() {
+			value, err := vm.Run(`
+number = Integer("abc") rescue 0
+[number, ((0 - 1).digits rescue "out of domain")]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[0, "out of domain"]`))
+		})
+	})
+
+	Describe("custom exception classes", func() {
+		BeforeEach(func() {
+			_, err := vm.Run(`
+class MyError < StandardError
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can be raised and rescued", func() {
+			value, err := vm.Run(`
+begin
+  raise MyError, "boom"
+rescue MyError => e
+  rescued = [e.class, e.message]
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[MyError, "boom"]`))
+		})
+
+		It("are rescued as their superclasses", func() {
+			value, err := vm.Run(`
+begin
+  raise MyError, "boom"
+rescue StandardError => e
+  rescued = e.class
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.MustGetClass("MyError")))
+		})
+
+		It("are not rescued as unrelated classes", func() {
+			_, err := vm.Run(`
+begin
+  raise MyError, "boom"
+rescue ArgumentError
+end
+`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("MyError: boom"))
+		})
+
+		It("are rescued by a rescue clause that names no class", func() {
+			value, err := vm.Run(`
+begin
+  raise MyError
+rescue => e
+  rescued = e.message
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("MyError"))
+		})
+	})
+
+	Describe("#message", func() {
+		It("is the message the exception was created with", func() {
+			value, err := vm.Run("StandardError.new('boom').message")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("boom"))
+		})
+
+		It("is the name of the class without a message", func() {
+			value, err := vm.Run("StandardError.new.message")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("StandardError"))
+		})
+
+		It("is available on the errors raised by the vm", func() {
+			value, err := vm.Run(`
+begin
+  nil.explode
+rescue NameError => e
+  rescued = e.message
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("undefined method 'explode' for nil"))
+		})
+	})
+
+	Describe("#full_message", func() {
+		It("includes the message, the class and the backtrace", func() {
+			value, err := vm.Run(`
+def explode
+  raise 'boom'
+end
+
+begin
+  explode()
+rescue => e
+  rescued = e.full_message
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(HavePrefix("boom (RuntimeError)\n"))
+			Expect(value.String()).To(ContainSubstring("in `explode'"))
+		})
+	})
+
+	Describe("#backtrace", func() {
+		It("is nil until the exception is raised", func() {
+			value, err := vm.Run("StandardError.new.backtrace")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("is where the exception was raised, rather than where it was created", func() {
+			value, err := vm.Run(`
+def build
+  StandardError.new('boom')
+end
+
+def throw_it(error)
+  raise error
+end
+
+begin
+  throw_it(build())
+rescue => e
+  rescued = e.backtrace.join(' ')
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(ContainSubstring("in `throw_it'"))
+			Expect(value.String()).ToNot(ContainSubstring("in `build'"))
+		})
+
+		It("has a frame in the file for each call, including those without arguments", func() {
+			value, err := vm.Run(`
+def explode
+  raise 'boom'
+end
+
+def go_on
+  [1].each { explode }
+end
+
+begin
+  go_on
+rescue => e
+  rescued = e.backtrace
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			frames := []string{}
+			for _, frame := range value.(*Array).Members() {
+				frames = append(frames, frame.String())
+			}
+			Expect(frames).To(Equal([]string{
+				"fake-irb-under-test:3 in `raise'",
+				"fake-irb-under-test:7 in `explode'",
+				"fake-irb-under-test:7 in `each'",
+				"fake-irb-under-test:11 in `go_on'",
+				"fake-irb-under-test:1 in `main'",
+			}))
+		})
+	})
+
+	Describe("initialize", func() {
+		It("can be overridden, passing a message to super", func() {
+			value, err := vm.Run(`
+class RetryError < StandardError
+  def initialize(attempts)
+    @attempts = attempts
+    super("gave up after #{attempts} attempts")
+  end
+
+  def attempts
+    @attempts
+  end
+end
+
+begin
+  raise RetryError, 3
+rescue RetryError => e
+  rescued = [e.message, e.attempts]
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["gave up after 3 attempts", 3]`))
+		})
+
+		It("can provide a default message to a bare super", func() {
+			value, err := vm.Run(`
+class QuietError < StandardError
+  def initialize(message = 'shh')
+    super
+  end
+end
+
+[QuietError.new.message, QuietError.new('loud').message]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["shh", "loud"]`))
+		})
+	})
+})
@@ -0,0 +1,219 @@
+package vm_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("File", func() {
+	var (
+		vm     VM
+		tmpdir string
+	)
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+
+		tmpdir, err = ioutil.TempDir("", "grubby-file-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		vm.Set("tmpdir", NewString(tmpdir, vm))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	Describe(".read", func() {
+		It("returns the contents of the file", func() {
+			err := ioutil.WriteFile(filepath.Join(tmpdir, "paleolatry.txt"), []byte("uncurrent\nsubvertical\n"), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			value, err := vm.Run("File.read(File.join(tmpdir, 'paleolatry.txt'))")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("uncurrent\nsubvertical\n"))
+		})
+
+		It("raises Errno::ENOENT when the file does not exist", func() {
+			_, err := vm.Run("File.read(File.join(tmpdir, 'nope.txt'))")
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(BeAssignableToTypeOf(&ExceptionValue{}))
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("Errno::ENOENT")))
+			Expect(err.Error()).To(ContainSubstring("Errno::ENOENT: No such file or directory"))
+		})
+
+		It("can be rescued as Errno::ENOENT", func() {
+			_, err := vm.Run(`
+rescued = false
+begin
+  File.read('/this/path/does/not/exist')
+rescue Errno::ENOENT
+  rescued = true
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.MustGet("rescued")).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("raises an ArgumentError without a path", func() {
+			_, err := vm.Run("File.read")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("ArgumentError")))
+			Expect(err.Error()).To(ContainSubstring("wrong number of arguments (0 for 1)"))
+		})
+
+		It("raises a TypeError when the path is not a string", func() {
+			_, err := vm.Run("File.read(1)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("TypeError")))
+			Expect(err.Error()).To(ContainSubstring("TypeError: no implicit conversion of Fixnum into String"))
+		})
+	})
+
+	Describe(".write", func() {
+		It("writes the contents and returns the number of bytes written", func() {
+			value, err := vm.Run("File.write(File.join(tmpdir, 'out.txt'), 'tetractinal')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("11"))
+
+			contents, err := ioutil.ReadFile(filepath.Join(tmpdir, "out.txt"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("tetractinal"))
+		})
+
+		It("raises Errno::ENOENT when the directory does not exist", func() {
+			_, err := vm.Run("File.write(File.join(tmpdir, 'missing', 'out.txt'), 'x')")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("Errno::ENOENT")))
+		})
+
+		It("raises an ArgumentError without both a path and the contents", func() {
+			_, err := vm.Run("File.write('x')")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("ArgumentError")))
+			Expect(err.Error()).To(ContainSubstring("wrong number of arguments (1 for 2)"))
+		})
+
+		It("raises a TypeError when the path is not a string", func() {
+			_, err := vm.Run("File.write(1, 'x')")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("TypeError")))
+			Expect(err.Error()).To(ContainSubstring("TypeError: no implicit conversion of Fixnum into String"))
+		})
+	})
+
+	Describe(".open", func() {
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(tmpdir, "lines.txt"), []byte("unsifted\nplasmature\n"), 0644)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("yields the file to the block and returns the block's value", func() {
+			value, err := vm.Run("File.open(File.join(tmpdir, 'lines.txt'), 'r') { |f| f.read }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("unsifted\nplasmature\n"))
+		})
+
+		It("closes the file after the block", func() {
+			_, err := vm.Run(`
+handle = nil
+File.open(File.join(tmpdir, 'lines.txt')) { |f| handle = f }
+closed = handle.closed?
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.MustGet("closed")).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("closes the file when the block raises", func() {
+			_, err := vm.Run(`
+handle = nil
+File.open(File.join(tmpdir, 'lines.txt')) { |f| handle = f; f.not_a_method }
+`)
+			Expect(err).To(HaveOccurred())
+
+			closed, err := vm.Run("handle.closed?")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(closed).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("returns the file when no block is given", func() {
+			value, err := vm.Run(`
+f = File.open(File.join(tmpdir, 'lines.txt'))
+contents = f.read
+f.close
+f
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(BeAssignableToTypeOf(&FileValue{}))
+			Expect(vm.MustGet("contents")).To(EqualRubyString("unsifted\nplasmature\n"))
+
+			_, err = vm.Run("f.read")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("IOError: closed stream"))
+		})
+
+		It("yields each line with each_line", func() {
+			_, err := vm.Run(`
+lines = []
+File.open(File.join(tmpdir, 'lines.txt')) { |f| f.each_line { |line| lines.unshift(line) } }
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			lines := vm.MustGet("lines").(*Array).Members()
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(EqualRubyString("plasmature\n"))
+			Expect(lines[1]).To(EqualRubyString("unsifted\n"))
+		})
+
+		It("returns an enumerator from each_line without a block", func() {
+			value, err := vm.Run("File.open(File.join(tmpdir, 'lines.txt')) { |f| f.each_line.to_a }")
+			Expect(err).ToNot(HaveOccurred())
+
+			lines := value.(*Array).Members()
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(EqualRubyString("unsifted\n"))
+			Expect(lines[1]).To(EqualRubyString("plasmature\n"))
+		})
+
+		It("can write to the file", func() {
+			_, err := vm.Run("File.open(File.join(tmpdir, 'written.txt'), 'w') { |f| f.write('photo', 'graph') }")
+			Expect(err).ToNot(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(filepath.Join(tmpdir, "written.txt"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("photograph"))
+		})
+
+		It("raises Errno::ENOENT when the file does not exist", func() {
+			_, err := vm.Run("File.open(File.join(tmpdir, 'nope.txt')) { |f| f.read }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("Errno::ENOENT")))
+		})
+	})
+
+	Describe(".exist?", func() {
+		It("returns whether the file exists", func() {
+			_, err := vm.Run(`
+path = File.join(tmpdir, 'exists.txt')
+before = File.exist?(path)
+File.write(path, '')
+after = File.exist?(path)
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.MustGet("before")).To(Equal(vm.SingletonWithName("false")))
+			Expect(vm.MustGet("after")).To(Equal(vm.SingletonWithName("true")))
+		})
+	})
+})
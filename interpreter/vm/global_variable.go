@@ -0,0 +1,27 @@
+package vm
+
+import (
+	"strconv"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// $1, $2 and so on are not stored, they are the groups of $~, the last match
+func (vm *vm) globalVariable(name string) Value {
+	if index, err := strconv.Atoi(name); err == nil && index > 0 {
+		value, _ := vm.global("~")
+		match, ok := value.(*MatchDataValue)
+		if !ok {
+			return vm.SingletonWithName("nil")
+		}
+
+		return match.Group(index, vm)
+	}
+
+	value, ok := vm.global(name)
+	if !ok {
+		return vm.SingletonWithName("nil")
+	}
+
+	return value
+}
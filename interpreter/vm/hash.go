@@ -25,7 +25,9 @@ func interpretHashInContext(
 			return nil, err
 		}
 
-		hash.Add(key, val)
+		if err := hash.Add(key, val); err != nil {
+			return nil, err
+		}
 	}
 
 	return hash, nil
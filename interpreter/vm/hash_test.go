@@ -85,6 +85,147 @@ hash[:hello]
 		Expect(value).To(Equal(vm.Symbols()["world"]))
 	})
 
+	Describe("keys", func() {
+		It("are compared by value for strings", func() {
+			value, err := vm.Run(`
+hash = {}
+hash['doubler'] = 1
+hash['doubler'] = 2
+hash['doubler']
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+			Expect(vm.MustGet("hash").(*Hash).Len()).To(Equal(1))
+		})
+
+		It("are compared by value for numbers", func() {
+			value, err := vm.Run(`hash = {1 => "a", 1.5 => "b"}; hash[1]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("a"))
+
+			value, err = vm.Run(`hash[1.5]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("b"))
+		})
+
+		It("treats integers and floats with the same value as distinct keys", func() {
+			value, err := vm.Run(`hash = {1 => "int", 1.0 => "float"}; hash[1.0]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("float"))
+			Expect(vm.MustGet("hash").(*Hash).Len()).To(Equal(2))
+		})
+
+		It("are compared by identity for other objects", func() {
+			value, err := vm.Run(`
+a = Object.new
+hash = {a => 1, Object.new => 2}
+hash[a]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+
+			value, err = vm.Run("hash[Object.new]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("are compared with the hash and eql? methods of classes that define them", func() {
+			value, err := vm.Run(`
+class Point
+  def initialize(x, y)
+    @x = x
+    @y = y
+  end
+
+  def coordinates
+    [@x, @y]
+  end
+
+  def hash
+    coordinates.hash
+  end
+
+  def eql?(other)
+    coordinates == other.coordinates
+  end
+end
+
+hash = {Point.new(1, 2) => "here"}
+[hash[Point.new(1, 2)], hash[Point.new(2, 1)]]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["here", nil]`))
+		})
+
+		It("raise what their hash and eql? methods raise", func() {
+			value, err := vm.Run(`
+class Unhashable
+  def hash
+    raise ArgumentError.new("no hash")
+  end
+end
+
+class Incomparable
+  def hash
+    1
+  end
+
+  def eql?(other)
+    raise ArgumentError.new("no eql?")
+  end
+end
+
+messages = []
+begin
+  {Unhashable.new => 1}
+rescue => e
+  messages << e.message
+end
+begin
+  {Incomparable.new => 1, Incomparable.new => 2}
+rescue => e
+  messages << e.message
+end
+begin
+  [[Unhashable.new]].uniq
+rescue => e
+  messages << e.message
+end
+messages
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["no hash", "no eql?", "no hash"]`))
+		})
+
+		It("are kept in insertion order", func() {
+			value, err := vm.Run("{:c => 1, :a => 2, :b => 3}.keys")
+			Expect(err).ToNot(HaveOccurred())
+
+			keys := value.(*Array).Members()
+			Expect(keys).To(Equal([]Value{vm.Symbols()["c"], vm.Symbols()["a"], vm.Symbols()["b"]}))
+		})
+	})
+
+	Describe("Object#hash", func() {
+		It("stays the same for the lifetime of an object", func() {
+			value, err := vm.Run("thing = Object.new; thing.hash == thing.hash")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("differs between objects compared by identity", func() {
+			value, err := vm.Run("Object.new.hash == Object.new.hash")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+		})
+
+		It("is the same for values that are eql?", func() {
+			value, err := vm.Run(`["a".hash == "a".hash, :a.hash == :a.hash, 1.hash == 1.hash, [1, 2].hash == [1, 2].hash]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true, true, true]"))
+		})
+	})
+
 	Describe("iterating over the keys and items", func() {
 		var err error
 
@@ -124,4 +265,149 @@ end
 			Expect(values).To(ContainElement(NewFixnum(2, vm)))
 		})
 	})
+
+	Describe("#merge", func() {
+		It("returns a new hash with the entries of both", func() {
+			value, err := vm.Run("original = {:a => 1, :b => 2}; original.merge({:b => 3, :c => 4})")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("{:a=>1, :b=>3, :c=>4}"))
+			Expect(vm.MustGet("original").PrettyPrint()).To(Equal("{:a=>1, :b=>2}"))
+		})
+
+		It("resolves conflicting keys with the block given", func() {
+			value, err := vm.Run("{:a => 1, :b => 2}.merge({:b => 3}) { |key, old, new| old + new }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("{:a=>1, :b=>5}"))
+		})
+	})
+
+	Describe("#fetch", func() {
+		It("returns the value for the key", func() {
+			value, err := vm.Run("{:a => 1}.fetch(:a)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+		})
+
+		It("returns the default or the result of the block for missing keys", func() {
+			value, err := vm.Run("{:a => 1}.fetch(:b, 2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+
+			value, err = vm.Run("{:a => 1}.fetch(:b) { |key| key }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.Symbols()["b"]))
+		})
+
+		It("raises a KeyError for missing keys without a default", func() {
+			_, err := vm.Run("{:a => 1}.fetch(:b)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("KeyError: key not found: :b"))
+		})
+
+		It("raises an ArgumentError without a key, or with more than a default", func() {
+			_, err := vm.Run("{:a => 1}.fetch")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (given 0, expected 1..2)"))
+
+			_, err = vm.Run("{:a => 1}.fetch(:a, 2, 3)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (given 3, expected 1..2)"))
+		})
+	})
+
+	Describe("default values", func() {
+		It("returns the default given to Hash.new for missing keys", func() {
+			value, err := vm.Run(`
+counts = Hash.new(0)
+%w(a b a).each { |word| counts[word] += 1 }
+[counts, counts['z'], counts.default]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[{"a"=>2, "b"=>1}, 0, 0]`))
+		})
+
+		It("calls the block given to Hash.new for missing keys", func() {
+			value, err := vm.Run(`
+groups = Hash.new { |hash, key| hash[key] = [] }
+groups[:odd] << 1
+groups[:even] << 2
+groups[:odd] << 3
+groups
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("{:odd=>[1, 3], :even=>[2]}"))
+		})
+
+		It("does not store the default unless the block does", func() {
+			value, err := vm.Run("hash = Hash.new { |h, k| k.to_s }; [hash[:a], hash.keys]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["a", []]`))
+		})
+
+		It("is nil without one", func() {
+			value, err := vm.Run("Hash.new[:a]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("is ignored by fetch", func() {
+			_, err := vm.Run("Hash.new(0).fetch(:b)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("KeyError: key not found: :b"))
+		})
+
+		It("can be changed, replacing the block", func() {
+			value, err := vm.Run(`
+hash = Hash.new { |h, k| 1 }
+hash.default = 5
+hash[:a]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(5, vm)))
+		})
+
+		It("cannot be given both as a value and a block", func() {
+			_, err := vm.Run("Hash.new(0) { |h, k| 1 }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (given 1, expected 0)"))
+		})
+
+		It("is kept by dup", func() {
+			value, err := vm.Run("Hash.new(7).dup[:a]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(7, vm)))
+		})
+	})
+
+	Describe("#dig", func() {
+		It("looks up nested keys", func() {
+			value, err := vm.Run("{:a => {:b => 1}}.dig(:a, :b)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+		})
+
+		It("returns nil when an intermediate key is missing", func() {
+			value, err := vm.Run("{:a => {:b => 1}}.dig(:x, :b)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("raises an ArgumentError without a key", func() {
+			_, err := vm.Run("{:a => 1}.dig")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (given 0, expected 1+)"))
+		})
+	})
+
+	Describe("#map and #each_pair", func() {
+		It("yield each key and value", func() {
+			value, err := vm.Run("{:a => 1, :b => 2}.map { |key, value| value }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm)}))
+
+			value, err = vm.Run("pairs = []; {:a => 1}.each_pair { |pair| pairs << pair }; pairs")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[[:a, 1]]"))
+		})
+	})
 })
@@ -11,22 +11,32 @@ func interpretIfStatementInContext(
 	ifBlock ast.IfBlock,
 	context Value,
 ) (Value, error) {
-	truthy := false
+	truthy, err := ifConditionInContext(vm, ifBlock, context)
+	if err != nil {
+		return nil, err
+	}
+
+	if truthy {
+		return vm.executeWithContext(context, ifBlock.Body...)
+	} else {
+		return vm.executeWithContext(context, ifBlock.Else...)
+	}
+}
+
+func ifConditionInContext(
+	vm *vm,
+	ifBlock ast.IfBlock,
+	context Value,
+) (bool, error) {
 	switch ifBlock.Condition.(type) {
 	case ast.Boolean:
-		truthy = ifBlock.Condition.(ast.Boolean).Value
+		return ifBlock.Condition.(ast.Boolean).Value, nil
 	default:
 		value, err := vm.executeWithContext(context, ifBlock.Condition)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 
-		truthy = value.IsTruthy()
-	}
-
-	if truthy {
-		return vm.executeWithContext(context, ifBlock.Body...)
-	} else {
-		return vm.executeWithContext(context, ifBlock.Else...)
+		return value.IsTruthy(), nil
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -69,4 +70,48 @@ foo.foo
 		bar := vm.Symbols()["bar"]
 		Expect(foo).To(Equal(bar))
 	})
+
+	Describe("reflection", func() {
+		BeforeEach(func() {
+			_, err := vm.Run(`
+class Point
+  def initialize
+    @x = 1
+  end
+end
+
+point = Point.new
+`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can read instance variables by name", func() {
+			value, err := vm.Run("point.instance_variable_get(:@x)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+
+			value, err = vm.Run("point.instance_variable_get('@y')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("can set instance variables by name", func() {
+			value, err := vm.Run("point.instance_variable_set(:@y, 2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+			Expect(vm.MustGet("point").GetInstanceVariable("y")).To(Equal(NewFixnum(2, vm)))
+		})
+
+		It("lists the names of its instance variables as symbols", func() {
+			value, err := vm.Run("point.instance_variable_set(:@y, 2); point.instance_variables")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{vm.Symbols()["@x"], vm.Symbols()["@y"]}))
+		})
+
+		It("raises a NameError for names that do not start with @", func() {
+			_, err := vm.Run("point.instance_variable_get(:x)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("NameError: 'x' is not allowed as an instance variable name"))
+		})
+	})
 })
@@ -0,0 +1,86 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// InterruptedError is returned by RunWithContext when its context is done
+// before the program finishes. It is not a ruby exception, so the program
+// cannot rescue it.
+type InterruptedError struct {
+	Cause error
+}
+
+func (err *InterruptedError) Error() string {
+	if err.Cause == context.DeadlineExceeded {
+		return fmt.Sprintf("timeout: %s", err.Cause.Error())
+	}
+
+	return fmt.Sprintf("interrupted: %s", err.Cause.Error())
+}
+
+// Unwrap lets errors.Is match the context's error
+func (err *InterruptedError) Unwrap() error {
+	return err.Cause
+}
+
+// RunWithContext runs the source like Run does, stopping between statements
+// once ctx is done. Whatever the program did until then is kept, and the vm
+// can go on running other programs.
+func (vm *vm) RunWithContext(ctx context.Context, input string) (Value, error) {
+	vm.running.Lock()
+	defer vm.running.Unlock()
+
+	previous := vm.runContext
+	vm.runContext = ctx
+	defer func() { vm.runContext = previous }()
+
+	return vm.run(input)
+}
+
+// checked before each statement is run, which includes every iteration of a
+// loop (its condition is a statement) and every method or block body
+func (vm *vm) interrupted() error {
+	if vm.runContext == nil {
+		return nil
+	}
+
+	if err := vm.runContext.Err(); err != nil {
+		return &InterruptedError{Cause: err}
+	}
+
+	return nil
+}
+
+// sleep waits for Kernel#sleep, waking up as soon as the context given to
+// RunWithContext is done rather than at the end of the duration. A negative
+// duration waits for the context alone, forever when there is none.
+func (vm *vm) sleep(duration time.Duration) error {
+	if duration < 0 {
+		if vm.runContext == nil {
+			select {}
+		}
+
+		<-vm.runContext.Done()
+		return &InterruptedError{Cause: vm.runContext.Err()}
+	}
+
+	if vm.runContext == nil {
+		time.Sleep(duration)
+		return nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-vm.runContext.Done():
+		return &InterruptedError{Cause: vm.runContext.Err()}
+	}
+}
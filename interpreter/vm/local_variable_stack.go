@@ -27,8 +27,9 @@ func (stack *LocalVariableStack) Unshift() {
 	stack.frames = append(newEmptyFrame(), stack.frames...)
 }
 
+// Shift removes the frame most recently added by Unshift, which is at the front
 func (stack *LocalVariableStack) Shift() {
-	stack.frames = stack.frames[0 : len(stack.frames)-1]
+	stack.frames = stack.frames[1:]
 }
 
 func (stack *LocalVariableStack) UnshiftCopyingCurrentFrame() {
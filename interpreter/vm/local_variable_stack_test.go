@@ -37,6 +37,11 @@ var _ = Describe("The local variable stack", func() {
 				_, err := subject.Retrieve("foo")
 				Expect(err).To(HaveOccurred())
 			})
+
+			It("returns it again once that frame is removed", func() {
+				subject.Shift()
+				Expect(subject.Retrieve("foo")).To(Equal(storedValue))
+			})
 		})
 	})
 })
@@ -0,0 +1,34 @@
+package vm
+
+import (
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// while and until loops, until having been parsed with a negated condition
+// like ruby, a loop is always nil, whatever its body evaluated to
+func interpretLoopInContext(vm *vm, loop ast.Loop, context Value) (Value, error) {
+	if loop.BodyFirst {
+		_, err := vm.executeWithContext(context, loop.Body...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		condition, err := vm.executeWithContext(context, loop.Condition)
+		if err != nil {
+			return nil, err
+		}
+
+		if !condition.IsTruthy() {
+			return vm.SingletonWithName("nil"), nil
+		}
+
+		_, err = vm.executeWithContext(context, loop.Body...)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
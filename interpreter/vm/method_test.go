@@ -1,8 +1,10 @@
 package vm_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	. "github.com/grubby/grubby/interpreter/vm"
 	. "github.com/grubby/grubby/interpreter/vm/builtins"
@@ -162,6 +164,90 @@ ace
 				})
 			})
 		})
+
+		Describe("trailing hash arguments", func() {
+			BeforeEach(func() {
+				_, err := vm.Run(`
+def opts(h)
+  h
+end
+`)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("collects trailing 'key: value' pairs into a single hash", func() {
+				result, err := vm.Run(`opts(a: 1, b: 2)`)
+				Expect(err).ToNot(HaveOccurred())
+
+				hash, ok := result.(*Hash)
+				Expect(ok).To(BeTrue())
+				Expect(hash.Len()).To(Equal(2))
+
+				value, found, err := hash.Get(NewSymbol("a", vm))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal(NewFixnum(1, vm)))
+			})
+
+			It("works without parens after positional arguments", func() {
+				result, err := vm.Run(`
+def tag(name, attributes)
+  attributes[:id]
+end
+
+tag "div", id: "header"
+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(EqualRubyString("header"))
+			})
+		})
+
+		Describe("keyword arguments", func() {
+			BeforeEach(func() {
+				_, err := vm.Run(`
+def configure(host:, port: 80)
+  "#{host}:#{port}"
+end
+`)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("binds the keywords provided", func() {
+				result, err := vm.Run(`configure(host: "example.com", port: 9)`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(EqualRubyString("example.com:9"))
+			})
+
+			It("uses the default value for keywords not provided", func() {
+				result, err := vm.Run(`configure host: "example.com"`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(EqualRubyString("example.com:80"))
+			})
+
+			It("can be mixed with positional arguments", func() {
+				result, err := vm.Run(`
+def connect(protocol, host:)
+  "#{protocol}://#{host}"
+end
+
+connect("https", host: "example.com")
+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(EqualRubyString("https://example.com"))
+			})
+
+			It("raises an ArgumentError when a required keyword is missing", func() {
+				_, err := vm.Run(`configure(port: 9)`)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("ArgumentError: missing keyword: :host"))
+			})
+
+			It("raises an ArgumentError when an unknown keyword is provided", func() {
+				_, err := vm.Run(`configure(host: "example.com", user: "root")`)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("ArgumentError: unknown keyword: :user"))
+			})
+		})
 	})
 
 	Describe("return values", func() {
@@ -191,6 +277,32 @@ test()
 			})
 		})
 
+		Context("when a return is nested before the last statement", func() {
+			BeforeEach(func() {
+				result, err = vm.Run(`
+def test(words)
+  while word = words.shift
+    begin
+      return word if word.start_with?('r')
+    ensure
+      $checked = word
+    end
+  end
+
+  'none'
+end
+
+[test(['a', 'ruby', 'gem']), $checked]
+`)
+			})
+
+			It("returns from the method, running the ensure clauses on the way", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.(*Array).Members()[0].(*StringValue).RawString()).To(Equal("ruby"))
+				Expect(result.(*Array).Members()[1].(*StringValue).RawString()).To(Equal("ruby"))
+			})
+		})
+
 		Context("when there is no explicit return expression", func() {
 			BeforeEach(func() {
 				result, err = vm.Run(`
@@ -209,4 +321,240 @@ test()
 			})
 		})
 	})
+
+	Describe("recursion", func() {
+		It("runs self recursive calls in tail position without growing the stack", func() {
+			result, err := vm.Run(`
+def count(n, total)
+  if n == 0
+    total
+  else
+    count(n - 1, total + 1)
+  end
+end
+
+count(20000, 0)
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(NewFixnum(20000, vm)))
+		})
+
+		It("returns from a guard clause before a call in tail position", func() {
+			result, err := vm.Run(`
+def count(n, total)
+  return total if n == 0
+  count(n - 1, total + 1)
+end
+
+count(20000, 0)
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(NewFixnum(20000, vm)))
+		})
+
+		It("binds default args for each tail call", func() {
+			result, err := vm.Run(`
+def countdown(n, total = 100)
+  if n == 0
+    total
+  else
+    if n == 1
+      countdown(n - 1)
+    else
+      countdown(n - 1, total + 1)
+    end
+  end
+end
+
+countdown(5, 0)
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(NewFixnum(100, vm)))
+		})
+
+		It("lets calls nest as deep as the default limit", func() {
+			_, err := vm.Run(`
+def bounded(n)
+  n == 0 ? 0 : 1 + bounded(n - 1)
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := vm.Run(fmt.Sprintf("bounded(%d)", DefaultMaxStackDepth-2))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(NewFixnum(DefaultMaxStackDepth-2, vm)))
+
+			_, err = vm.Run(fmt.Sprintf("bounded(%d)", DefaultMaxStackDepth-1))
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("SystemStackError")))
+		})
+
+		Describe("too deep", func() {
+			BeforeEach(func() {
+				pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+				if err != nil {
+					panic(err)
+				}
+
+				vm = NewVMWithOptions(pathToExecutable, "fake-irb-under-test", VMOptions{MaxStackDepth: 50, MaxTailCalls: 1000})
+				_, err = vm.Run(`
+def climb(n)
+  1 + climb(n + 1)
+end
+
+def bounded(n)
+  n == 0 ? 0 : 1 + bounded(n - 1)
+end
+
+def forever(n)
+  forever(n + 1)
+end
+`)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("raises a SystemStackError once the calls nest past the limit", func() {
+				_, err := vm.Run("climb(0)")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("SystemStackError")))
+				Expect(err.Error()).To(HavePrefix("SystemStackError: stack level too deep"))
+			})
+
+			It("raises a SystemStackError once a method calls itself in tail position past the limit", func() {
+				_, err := vm.Run("forever(0)")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("SystemStackError")))
+			})
+
+			It("lets calls nest up to the limit", func() {
+				// 49 calls to bounded, and the == made by the innermost one
+				result, err := vm.Run("bounded(48)")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(NewFixnum(48, vm)))
+
+				_, err = vm.Run("bounded(49)")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("SystemStackError")))
+			})
+
+			It("leaves the vm usable afterwards", func() {
+				_, err := vm.Run("climb(0)")
+				Expect(err).To(HaveOccurred())
+
+				result, err := vm.Run("bounded(20)")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(NewFixnum(20, vm)))
+			})
+		})
+
+		Describe("backtraces", func() {
+			var framesForOneCall int
+
+			BeforeEach(func() {
+				_, err := vm.Run(`
+def descend(n)
+  if n == 0
+    nil.explode
+  else
+    descend(n - 1)
+  end
+end
+
+def climb(n)
+  if n == 0
+    nil.explode
+  else
+    1 + climb(n - 1)
+  end
+end
+`)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = vm.Run("climb(0)")
+				Expect(err).To(HaveOccurred())
+				framesForOneCall = strings.Count(err.Error(), "in `climb'")
+				Expect(framesForOneCall).ToNot(BeZero())
+			})
+
+			It("reuses the frame of a call in tail position", func() {
+				_, err := vm.Run("descend(3)")
+				Expect(err).To(HaveOccurred())
+				Expect(strings.Count(err.Error(), "in `descend'")).To(Equal(framesForOneCall))
+			})
+
+			It("keeps the frames of calls that are not in tail position", func() {
+				_, err := vm.Run("climb(3)")
+				Expect(err).To(HaveOccurred())
+				Expect(strings.Count(err.Error(), "in `climb'")).To(Equal(4 * framesForOneCall))
+			})
+
+			It("drops the frames of calls that have already returned", func() {
+				_, err := vm.Run(`
+def step
+  1
+end
+
+def walk
+  step
+  step
+  [1, 2].each { |x| step }
+  nil.explode
+end
+`)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = vm.Run("walk()")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).ToNot(ContainSubstring("in `step'"))
+				Expect(err.Error()).ToNot(ContainSubstring("in `each'"))
+				Expect(strings.Count(err.Error(), "in `walk'")).To(Equal(framesForOneCall))
+			})
+		})
+	})
+
+	Describe("__method__", func() {
+		It("is the name of the method being run", func() {
+			value, err := vm.Run(`
+def foo
+  __method__
+end
+foo
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(":foo"))
+		})
+
+		It("is the name of the enclosing method inside a block", func() {
+			value, err := vm.Run(`
+def bar
+  [1].map { |x| __method__ }
+end
+bar
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[:bar]"))
+		})
+
+		It("is the caller's name again once a method it called returns", func() {
+			value, err := vm.Run(`
+def inner
+  __method__
+end
+
+def outer
+  inner
+  __method__
+end
+outer
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(":outer"))
+		})
+
+		It("is nil at the top level", func() {
+			value, err := vm.Run("__method__")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+	})
 })
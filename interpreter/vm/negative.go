@@ -0,0 +1,28 @@
+package vm
+
+import (
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+func interpretNegativeInContext(vm *vm, negative ast.Negative, context Value) (Value, error) {
+	switch target := negative.Target.(type) {
+	case ast.ConstantInt:
+		return NewFixnum(-target.Value, vm), nil
+	case ast.ConstantFloat:
+		return NewFloat(-target.Value, vm), nil
+	}
+
+	target, err := vm.executeWithContext(context, negative.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	method := target.Method("-@")
+	if method == nil {
+		return nil, NewNoMethodError("-@", target, vm)
+	}
+
+	return method.Execute(target, nil)
+}
@@ -6,6 +6,7 @@ import (
 
 	. "github.com/grubby/grubby/interpreter/vm"
 	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -35,6 +36,39 @@ var _ = Describe("numbers", func() {
 			val, err := vm.Run("5 == 5")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(val).To(Equal(vm.SingletonWithName("true")))
+
+			val, err = vm.Run("5.equal?(2 + 3)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("is always frozen", func() {
+			val, err := vm.Run("[5.frozen?, (2 ** 70).frozen?, 1.5.frozen?]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("[true, true, true]"))
+		})
+
+		It("cannot have singleton methods", func() {
+			_, err := vm.Run("five = 5; def five.shout; 'FIVE'; end")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("TypeError: can't define singleton"))
+
+			_, err = vm.Run("class << 1.5; end")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("TypeError: can't define singleton"))
+		})
+
+		It("raises a TypeError that can be rescued when given singleton methods", func() {
+			val, err := vm.Run(`
+begin
+  five = 5
+  def five.shout; 'FIVE'; end
+rescue TypeError => e
+  e.message
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(EqualRubyString("can't define singleton"))
 		})
 
 		It("has a + method", func() {
@@ -44,6 +78,46 @@ var _ = Describe("numbers", func() {
 			Expect(val.String()).To(Equal(NewFixnum(42, vm).String()))
 		})
 
+		It("has - and * methods", func() {
+			val, err := vm.Run("5 - 2 * 3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("-1"))
+		})
+
+		It("can be compared with other numbers", func() {
+			val, err := vm.Run("3 <=> 1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(1, vm)))
+
+			val, err = vm.Run("1 < 1.5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("true")))
+
+			val, err = vm.Run("1 == 1.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("true")))
+
+			val, err = vm.Run("1 <=> 'a'")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("becomes a float when combined with a float", func() {
+			val, err := vm.Run("1 + 2.5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*FloatValue).ValueAsFloat()).To(Equal(3.5))
+		})
+
+		It("can be negative", func() {
+			val, err := vm.Run("-5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("-5")))
+
+			val, err = vm.Run("x = 3; -x")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("-3")))
+		})
+
 		It("has a #nonzero? method", func() {
 			val, err := vm.Run("5.nonzero?")
 			Expect(err).ToNot(HaveOccurred())
@@ -53,6 +127,375 @@ var _ = Describe("numbers", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(val).To(Equal(vm.SingletonWithName("nil")))
 		})
+
+		It("has #gcd and #lcm methods", func() {
+			val, err := vm.Run("12.gcd(18)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("6")))
+
+			val, err = vm.Run("4.lcm(6)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("12")))
+
+			val, err = vm.Run("(-4).lcm(6)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("12")))
+		})
+
+		It("raises when #gcd and #lcm are not given one integer", func() {
+			_, err := vm.Run("12.gcd")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("ArgumentError")))
+			Expect(err.Error()).To(ContainSubstring("wrong number of arguments (0 for 1)"))
+
+			_, err = vm.Run("12.lcm(1.5)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("TypeError")))
+			Expect(err.Error()).To(ContainSubstring("TypeError: not an integer"))
+		})
+
+		Describe("#digits", func() {
+			It("returns the digits, least significant first", func() {
+				val, err := vm.Run("123.digits")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(val.(*Array).Members()).To(Equal([]Value{
+					NewFixnum(3, vm),
+					NewFixnum(2, vm),
+					NewFixnum(1, vm),
+				}))
+			})
+
+			It("takes an optional base", func() {
+				val, err := vm.Run("255.digits(16)")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(val.(*Array).Members()).To(Equal([]Value{NewFixnum(15, vm), NewFixnum(15, vm)}))
+			})
+
+			It("raises Math::DomainError for negative numbers", func() {
+				_, err := vm.Run("(-123).digits")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Math::DomainError: out of domain"))
+			})
+		})
+	})
+
+	Describe("#step", func() {
+		It("calls the block with every step up to the limit, and returns the receiver", func() {
+			val, err := vm.Run("steps = []; 1.step(10, 2) { |i| steps << i }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(1, vm)))
+
+			steps, err := vm.Get("steps")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(steps.(*Array).Members()).To(Equal([]Value{
+				NewFixnum(1, vm),
+				NewFixnum(3, vm),
+				NewFixnum(5, vm),
+				NewFixnum(7, vm),
+				NewFixnum(9, vm),
+			}))
+		})
+
+		It("steps by one by default", func() {
+			val, err := vm.Run("steps = []; 1.step(3) { |i| steps << i }; steps")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm), NewFixnum(3, vm)}))
+		})
+
+		It("counts down with a negative step", func() {
+			val, err := vm.Run("steps = []; 10.step(1, -4) { |i| steps << i }; steps")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*Array).Members()).To(Equal([]Value{NewFixnum(10, vm), NewFixnum(6, vm), NewFixnum(2, vm)}))
+		})
+
+		It("yields floats when any of the numbers is a float", func() {
+			val, err := vm.Run("steps = []; 0.0.step(1.0, 0.25) { |f| steps << f }; steps.inspect")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(EqualRubyString("[0.0, 0.25, 0.5, 0.75, 1.0]"))
+
+			val, err = vm.Run("steps = []; 1.step(0, -0.1) { |f| steps << f }; steps")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*Array).Members()).To(HaveLen(11))
+			Expect(val.(*Array).Members()[10].String()).To(Equal("0.0"))
+		})
+
+		It("returns an enumerator without a block", func() {
+			val, err := vm.Run("1.step(7, 3).to_a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(4, vm), NewFixnum(7, vm)}))
+		})
+
+		It("raises an ArgumentError for a step of zero", func() {
+			_, err := vm.Run("1.step(3, 0) { |i| i }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: step can't be 0"))
+		})
+	})
+
+	Describe("large integers", func() {
+		It("promotes to a Bignum instead of overflowing", func() {
+			val, err := vm.Run("9223372036854775807 + 1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.Class().String()).To(Equal("Bignum"))
+			Expect(val.String()).To(Equal("9223372036854775808"))
+		})
+
+		It("returns a Bignum for a number literal too large for a Fixnum", func() {
+			val, err := vm.Run("99999999999999999999 + 1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("100000000000000000000"))
+		})
+
+		It("computes exact powers", func() {
+			val, err := vm.Run("2 ** 100")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("1267650600228229401496703205376"))
+		})
+
+		It("computes exact products", func() {
+			val, err := vm.Run(`
+def factorial(n)
+  if n == 0
+    1
+  else
+    n * factorial(n - 1)
+  end
+end
+
+factorial(50)
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("30414093201713378043612608166064768844377641568960512000000000000"))
+		})
+
+		It("has #gcd and #lcm methods", func() {
+			val, err := vm.Run("(2 ** 70).gcd((2 ** 65) * 3)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("36893488147419103232"))
+
+			val, err = vm.Run("(2 ** 70).gcd(6)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(2, vm)))
+
+			val, err = vm.Run("(2 ** 70).lcm(3)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("3541774862152233910272"))
+
+			val, err = vm.Run("4611686018427387903.lcm(4611686018427387902)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("21267647932558653952625854909203349506"))
+		})
+
+		It("has a #digits method", func() {
+			val, err := vm.Run("(2 ** 64).digits.join('')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("61615590737044764481"))
+
+			val, err = vm.Run("(2 ** 64).digits(2 ** 32)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("[0, 0, 1]"))
+
+			_, err = vm.Run("(0 - 2 ** 64).digits")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Math::DomainError: out of domain"))
+		})
+
+		It("parses strings too large for a Fixnum with String#to_i", func() {
+			val, err := vm.Run("'18446744073709551616'.to_i")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.Class().String()).To(Equal("Bignum"))
+			Expect(val.String()).To(Equal("18446744073709551616"))
+
+			val, err = vm.Run("'-18446744073709551616'.to_i")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("-18446744073709551616"))
+		})
+
+		It("becomes a Fixnum again once the result is small enough", func() {
+			val, err := vm.Run("((2 ** 100) - (2 ** 100)) + 5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(5, vm)))
+
+			val, err = vm.Run("(2 ** 64) / (2 ** 60)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(16, vm)))
+		})
+
+		It("can be compared with other numbers", func() {
+			val, err := vm.Run("(2 ** 70) > 5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("true")))
+
+			val, err = vm.Run("(2 ** 70) == (2 ** 70)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("becomes a float when combined with a float", func() {
+			val, err := vm.Run("(2 ** 70) * 0.5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*FloatValue).ValueAsFloat()).To(Equal(590295810358705651712.0))
+		})
+
+		It("can be used as the key of a hash", func() {
+			val, err := vm.Run(`
+hash = {}
+hash[2 ** 80] = 'big'
+hash[2 ** 80]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(EqualRubyString("big"))
+		})
+	})
+
+	Describe("division", func() {
+		It("rounds integer division towards negative infinity", func() {
+			val, err := vm.Run("7 / 2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(3, vm)))
+
+			val, err = vm.Run("(-7) / 2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(-4, vm)))
+		})
+
+		It("raises ZeroDivisionError when dividing an integer by 0", func() {
+			_, err := vm.Run("1 / 0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ZeroDivisionError: divided by 0"))
+		})
+	})
+
+	Describe("rationals", func() {
+		It("adds rationals exactly", func() {
+			val, err := vm.Run("Rational(1, 3) + Rational(1, 6)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.Class().String()).To(Equal("Rational"))
+			Expect(val.PrettyPrint()).To(Equal("(1/2)"))
+		})
+
+		It("has a literal form", func() {
+			val, err := vm.Run("1/3r")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(1/3)"))
+
+			val, err = vm.Run("0.75r")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(3/4)"))
+		})
+
+		It("stays exact when combined with integers", func() {
+			val, err := vm.Run("Rational(1, 3) * 3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(1/1)"))
+
+			val, err = vm.Run("1 + Rational(1, 2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(3/2)"))
+
+			val, err = vm.Run("2 ** -2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(1/4)"))
+		})
+
+		It("becomes a float when combined with a float", func() {
+			val, err := vm.Run("Rational(1, 2) + 0.25")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*FloatValue).ValueAsFloat()).To(Equal(0.75))
+		})
+
+		It("can be compared with other numbers", func() {
+			val, err := vm.Run("Rational(1, 2) < Rational(2, 3)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("true")))
+
+			val, err = vm.Run("Rational(1, 2) == 0.5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("has a float approximation", func() {
+			val, err := vm.Run("Rational(1, 3).to_f")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*FloatValue).ValueAsFloat()).To(Equal(1.0 / 3.0))
+		})
+
+		It("is kept in its lowest terms", func() {
+			val, err := vm.Run("Rational(5, 10).denominator")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(Equal(NewFixnum(2, vm)))
+		})
+
+		It("can be created from a string", func() {
+			val, err := vm.Run("Rational('3/4').to_s")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val).To(EqualRubyString("3/4"))
+		})
+
+		It("raises ZeroDivisionError for a zero denominator", func() {
+			_, err := vm.Run("Rational(1, 0)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ZeroDivisionError: divided by 0"))
+		})
+	})
+
+	Describe("complex numbers", func() {
+		It("has a real and an imaginary part", func() {
+			val, err := vm.Run("Complex(1, 2)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.Class().String()).To(Equal("Complex"))
+			Expect(val.PrettyPrint()).To(Equal("(1.0+2.0i)"))
+
+			val, err = vm.Run("Complex(1, 2).real")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*FloatValue).ValueAsFloat()).To(Equal(1.0))
+
+			val, err = vm.Run("Complex(1, 2).imaginary")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*FloatValue).ValueAsFloat()).To(Equal(2.0))
+		})
+
+		It("has a literal form for imaginary numbers", func() {
+			val, err := vm.Run("1 + 2i")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*ComplexValue).Value()).To(Equal(complex(1, 2)))
+		})
+
+		It("does arithmetic with other complex numbers", func() {
+			val, err := vm.Run("Complex(1, 2) + Complex(3, -4)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(4.0-2.0i)"))
+
+			val, err = vm.Run("Complex(1, 2) * Complex(3, 4)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(-5.0+10.0i)"))
+		})
+
+		It("coerces real numbers", func() {
+			val, err := vm.Run("3 - Complex(1, 1)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(2.0-1.0i)"))
+
+			val, err = vm.Run("Complex(1, 2) / 2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(0.5+1.0i)"))
+		})
+
+		It("has an absolute value and a conjugate", func() {
+			val, err := vm.Run("Complex(3, 4).abs")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.(*FloatValue).ValueAsFloat()).To(Equal(5.0))
+
+			val, err = vm.Run("Complex(1, 2).conjugate")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.PrettyPrint()).To(Equal("(1.0-2.0i)"))
+		})
+
+		It("raises ZeroDivisionError when divided by 0", func() {
+			_, err := vm.Run("Complex(1, 2) / 0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ZeroDivisionError: divided by 0"))
+		})
 	})
 
 	Describe("floats", func() {
@@ -68,5 +511,14 @@ var _ = Describe("numbers", func() {
 			Expect(ok).To(BeTrue())
 			Expect(asFloat.ValueAsFloat()).To(Equal(5.123))
 		})
+
+		It("is shown with a decimal point", func() {
+			val, err := vm.Run("2.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(val.String()).To(Equal("2.0"))
+
+			Expect(NewFloat(3.14, vm).String()).To(Equal("3.14"))
+			Expect(NewFloat(1e20, vm).String()).To(Equal("1.0e+20"))
+		})
 	})
 })
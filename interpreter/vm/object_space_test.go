@@ -0,0 +1,78 @@
+package vm_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectSpace", func() {
+	var vm VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+
+		_, err = vm.Run(`
+class Animal
+end
+
+class Dog < Animal
+end
+
+animal = Animal.new
+dogs = [Dog.new, Dog.new]
+`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe(".each_object", func() {
+		It("yields the objects of a class and its subclasses", func() {
+			value, err := vm.Run(`
+found = []
+ObjectSpace.each_object(Animal) { |object| found << object }
+found
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(HaveLen(3))
+		})
+
+		It("returns the number of objects found", func() {
+			value, err := vm.Run("ObjectSpace.each_object(Dog) { |dog| dog }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+		})
+
+		It("returns an Enumerator when not given a block", func() {
+			value, err := vm.Run("ObjectSpace.each_object(Dog).to_a == dogs")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("forgets the objects that were garbage collected", func() {
+			value, err := vm.Run(`
+Dog.new
+Dog.new
+nil
+GC.start
+ObjectSpace.each_object(Dog) { |dog| dog }
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+		})
+	})
+
+	It("counts the objects with .count_objects", func() {
+		value, err := vm.Run("ObjectSpace.count_objects[:TOTAL]")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(3, vm)))
+	})
+})
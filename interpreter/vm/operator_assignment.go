@@ -0,0 +1,131 @@
+package vm
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// the parser leaves `a += 1` as a call to a method named +=
+var assignmentOperators = map[string]bool{
+	"+=":  true,
+	"-=":  true,
+	"*=":  true,
+	"/=":  true,
+	"%=":  true,
+	"**=": true,
+	"<<=": true,
+	">>=": true,
+	"|=":  true,
+	"&=":  true,
+	"^=":  true,
+}
+
+func isOperatorAssignment(callExpr ast.CallExpression) bool {
+	if !assignmentOperators[callExpr.Func.Name] || len(callExpr.Args) != 1 {
+		return false
+	}
+
+	switch target := callExpr.Target.(type) {
+	case ast.BareReference, ast.InstanceVariable, ast.GlobalVariable, ast.ClassVariable:
+		return true
+	case ast.CallExpression:
+		return isIndexTarget(target) || isAttributeTarget(target)
+	default:
+		return false
+	}
+}
+
+// `h[k] += 1` leaves the index call as the target
+func isIndexTarget(target ast.CallExpression) bool {
+	return target.Func.Name == "[]" && target.Target != nil && !target.OptionalBlock.Provided()
+}
+
+// `obj.x += 1` leaves the call to the reader as the target
+func isAttributeTarget(target ast.CallExpression) bool {
+	name := target.Func.Name
+	if target.Target == nil || len(target.Args) != 0 || target.OptionalBlock.Provided() || name == "" {
+		return false
+	}
+
+	first := rune(name[0])
+	last := name[len(name)-1]
+	return (first == '_' || unicode.IsLetter(first)) && last != '?' && last != '!'
+}
+
+// `a += 1` is evaluated as `a = a + 1`
+func interpretOperatorAssignmentInContext(vm *vm, callExpr ast.CallExpression, context Value) (Value, error) {
+	if target, ok := callExpr.Target.(ast.CallExpression); ok {
+		return interpretMethodOperatorAssignmentInContext(vm, callExpr, target, context)
+	}
+
+	return interpretAssignmentInContext(vm, expandOperatorAssignment(callExpr), context)
+}
+
+// expandOperatorAssignment rewrites `a += 1` to `a = a + 1`, for a variable
+func expandOperatorAssignment(callExpr ast.CallExpression) ast.Assignment {
+	return ast.Assignment{
+		Line: callExpr.Line,
+		LHS:  callExpr.Target,
+		RHS: ast.CallExpression{
+			Line:   callExpr.Line,
+			Target: callExpr.Target,
+			Func:   ast.BareReference{Line: callExpr.Func.Line, Name: strings.TrimSuffix(callExpr.Func.Name, "=")},
+			Args:   callExpr.Args,
+		},
+	}
+}
+
+// `h[k] += 1` is evaluated as `h[k] = h[k] + 1` and `obj.x += 1` as
+// `obj.x = obj.x + 1`, with the receiver and index evaluated only once
+func interpretMethodOperatorAssignmentInContext(
+	vm *vm,
+	callExpr ast.CallExpression,
+	target ast.CallExpression,
+	context Value,
+) (Value, error) {
+	receiver, err := callTargetInContext(vm, target, context)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := callArgsInContext(vm, target, context)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := vm.send(receiver, target.Func.Name, indexes...)
+	if err != nil {
+		return nil, err
+	}
+
+	operand, err := callArgsInContext(vm, callExpr, context)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := vm.send(current, strings.TrimSuffix(callExpr.Func.Name, "="), operand...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vm.send(receiver, target.Func.Name+"=", append(indexes, updated)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// send calls the named method on the receiver without a block
+func (vm *vm) send(receiver Value, methodName string, args ...Value) (Value, error) {
+	method := receiver.Method(methodName)
+	if method == nil {
+		return nil, NewNoMethodError(methodName, receiver, vm)
+	}
+
+	return method.Execute(receiver, nil, args...)
+}
@@ -0,0 +1,21 @@
+package vm
+
+import (
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+func interpretRangeInContext(vm *vm, rangeNode ast.Range, context Value) (Value, error) {
+	start, err := vm.executeWithContext(context, rangeNode.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := vm.executeWithContext(context, rangeNode.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRange(start, end, rangeNode.ExcludeLastValue, vm), nil
+}
@@ -33,7 +33,124 @@ var _ = Describe("regular expressions", func() {
 		result, err := vm.Run(`/foo.*bar/.match("foo WOAH bar")`)
 
 		Expect(err).ToNot(HaveOccurred())
-		Expect(result).To(Equal(vm.SingletonWithName("true")))
+		Expect(result).To(BeAssignableToTypeOf(&MatchDataValue{}))
+		Expect(result.String()).To(Equal("foo WOAH bar"))
+
+		result, err = vm.Run(`/foo.*bar/.match("baz")`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(vm.SingletonWithName("nil")))
+	})
+
+	Describe("String#match", func() {
+		It("returns the match", func() {
+			value, err := vm.Run(`"hello".match(/l+/)[0]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("ll"))
+		})
+
+		It("gives access to the capture groups", func() {
+			value, err := vm.Run(`"John Smith".match(/(\w+) (\w+)/)[2]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("Smith"))
+		})
+
+		It("returns nil when the string does not match", func() {
+			value, err := vm.Run(`"hello".match(/z/)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("raises an ArgumentError without a pattern", func() {
+			_, err := vm.Run(`"hello".match`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (0 for 1)"))
+		})
+	})
+
+	Describe("MatchData", func() {
+		BeforeEach(func() {
+			_, err := vm.Run(`match = "say hello world".match(/(\w+) (\w+)$/)`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("has the text before and after the match", func() {
+			value, err := vm.Run("match.pre_match")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("say "))
+
+			value, err = vm.Run("match.post_match")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal(""))
+		})
+
+		It("has the captures", func() {
+			value, err := vm.Run("match.captures")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["hello", "world"]`))
+		})
+
+		It("has the named captures", func() {
+			value, err := vm.Run(`"2024-10".match(/(?<year>\d+)-(?<month>\d+)/).named_captures`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`{"year"=>"2024", "month"=>"10"}`))
+		})
+
+		It("is kept in $~ along with its groups in $1, $2...", func() {
+			value, err := vm.Run("$~")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("hello world"))
+
+			value, err = vm.Run("[$1, $2, $3]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["hello", "world", nil]`))
+		})
+
+		It("is cleared from $~ by a failed match", func() {
+			value, err := vm.Run(`
+"hello" =~ /z/
+[$~, $1]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[nil, nil]"))
+		})
+	})
+
+	Describe("String#scan", func() {
+		It("returns every match", func() {
+			value, err := vm.Run(`"a1b22c333".scan(/\d+/)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`["1", "22", "333"]`))
+		})
+
+		It("returns the groups of every match", func() {
+			value, err := vm.Run(`"k=v, a=b".scan(/(\w)=(\w)/)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[["k", "v"], ["a", "b"]]`))
+		})
+	})
+
+	Describe("=~", func() {
+		It("returns the index of the match", func() {
+			value, err := vm.Run(`"hello" =~ /ll/`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+
+			value, err = vm.Run(`/ll/ =~ "hello"`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+		})
+
+		It("counts characters rather than bytes", func() {
+			value, err := vm.Run(`"héllo" =~ /l/`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+		})
+
+		It("returns nil when the string does not match", func() {
+			value, err := vm.Run(`"hello" =~ /z/`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
 	})
 
 	It("can be used to quote strings for use as regular expressions", func() {
@@ -0,0 +1,27 @@
+package vm
+
+import (
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// like ruby, `statement rescue fallback` only rescues a StandardError,
+// so that eg: a SystemExit or a throw still unwinds past it
+func interpretRescueModifierInContext(
+	vm *vm,
+	modifier ast.RescueModifier,
+	context Value,
+) (Value, error) {
+	value, err := vm.executeWithContext(context, modifier.Statement)
+	if err == nil {
+		return value, nil
+	}
+
+	rubyErr, ok := err.(Value)
+	if !ok || !IsKindOf(rubyErr, vm.CurrentClasses["StandardError"]) {
+		return nil, err
+	}
+
+	return vm.executeWithContext(context, modifier.Rescue)
+}
@@ -0,0 +1,26 @@
+package vm
+
+import (
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// returnSignal unwinds a `return` nested in an if, a loop or a begin up to
+// the method it returns from. it is not a ruby exception, so rescue clauses
+// let it through, but ensure clauses still run
+type returnSignal struct {
+	value Value
+}
+
+func (r *returnSignal) Error() string {
+	return "unexpected return"
+}
+
+// returnedValue is the value of a body that returned early, or the value
+// and error it finished with otherwise
+func returnedValue(value Value, err error) (Value, error) {
+	if signal, ok := err.(*returnSignal); ok {
+		return signal.value, nil
+	}
+
+	return value, err
+}
@@ -0,0 +1,115 @@
+package vm_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Set", func() {
+	var vm VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+
+		_, err = vm.Run("require 'set'")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("ignores duplicate members", func() {
+		value, err := vm.Run(`
+set = Set.new([1, 2])
+set.add(2)
+set << 3
+set << 1
+set.to_a
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm), NewFixnum(3, vm)}))
+	})
+
+	It("compares its members with eql? and hash", func() {
+		value, err := vm.Run("Set.new(['a', [1, 2], 'a', [1, 2], 1.0, 1]).size")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(4, vm)))
+	})
+
+	It("knows whether it includes a value", func() {
+		value, err := vm.Run("Set.new([:a, 'b']).include?('b')")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(vm.SingletonWithName("true")))
+
+		value, err = vm.Run("Set.new([:a, 'b']).include?(:b)")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(vm.SingletonWithName("false")))
+	})
+
+	It("can delete members", func() {
+		value, err := vm.Run(`
+set = Set.new([1, 2, 3])
+set.delete(2)
+set.delete(4)
+set.to_a
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(3, vm)}))
+	})
+
+	It("can be duplicated without sharing its members", func() {
+		value, err := vm.Run(`
+set = Set.new([1])
+copy = set.dup << 2
+set.delete(1)
+[set.to_a, copy.to_a, copy.class]
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.PrettyPrint()).To(Equal("[[], [1, 2], Set]"))
+	})
+
+	It("has union, intersection and difference operators", func() {
+		_, err := vm.Run("a = Set.new([1, 2, 3]); b = Set.new([2, 3, 4])")
+		Expect(err).ToNot(HaveOccurred())
+
+		value, err := vm.Run("(a | b).inspect")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(EqualRubyString("#<Set: {1, 2, 3, 4}>"))
+
+		value, err = vm.Run("a.intersection(b).inspect")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(EqualRubyString("#<Set: {2, 3}>"))
+
+		value, err = vm.Run("(a - [3, 4]).inspect")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(EqualRubyString("#<Set: {1, 2}>"))
+
+		value, err = vm.Run("a.union(b) == (b | a)")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(vm.SingletonWithName("true")))
+	})
+
+	It("yields each of its members", func() {
+		value, err := vm.Run(`
+total = 0
+Set.new([1, 2, 2, 3]).each { |n| total = total + n }
+total
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(6, vm)))
+	})
+
+	It("is enumerable", func() {
+		value, err := vm.Run("Set.new([1, 2, 3]).partition { |n| n > 1 }")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.PrettyPrint()).To(Equal("[[2, 3], [1]]"))
+	})
+})
@@ -66,6 +66,38 @@ adj = 'cruel'
 		})
 	})
 
+	Describe("escape sequences", func() {
+		It("are processed in double quoted strings", func() {
+			value, err := vm.Run(`"a\tb\n"`)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal("a\tb\n"))
+		})
+
+		It("are processed around interpolated values", func() {
+			value, err := vm.Run(`"\"#{:quoted}\"\x21"`)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal(`"quoted"!`))
+		})
+
+		It("can escape the # of an interpolation", func() {
+			value, err := vm.Run(`
+x = 5
+"\#{x} \x23{x} \\#{x} \\x #{"{#{x}}"}"
+`)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal(`#{x} #{x} \5 \x {5}`))
+		})
+
+		It("joins adjacent single and double quoted literals, each decoded by its own rules", func() {
+			value, err := vm.Run(`x = 5; 'a\b#{x}' "\101#{x}"`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal(`a\b#{x}A5`))
+		})
+	})
+
 	Describe("concatenating strings", func() {
 		It("can be done with the shovel operator", func() {
 			value, err := vm.Run("'hello' << ' world'")
@@ -78,7 +110,39 @@ adj = 'cruel'
 		It("can be used to turn a string into an immutable string", func() {
 			_, err := vm.Run("str = 'hello'.freeze; str << 'world'")
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("RuntimeError: can't modify frozen String"))
+			Expect(err.Error()).To(ContainSubstring(`FrozenError: can't modify frozen String: "hello"`))
+		})
+	})
+
+	Describe("the frozen_string_literal magic comment", func() {
+		It("freezes every string literal in the file", func() {
+			value, err := vm.Run(`# frozen_string_literal: true
+[ 'single'.frozen?, "double".frozen? ]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true]"))
+
+			_, err = vm.Run("# frozen_string_literal: true\nstr = 'hello'; str << 'world'")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("FrozenError: can't modify frozen String"))
+		})
+
+		It("does not freeze interpolated strings", func() {
+			value, err := vm.Run("# frozen_string_literal: true\n\"#{1} and 2\".frozen?")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+		})
+
+		It("only applies before the first line of code", func() {
+			value, err := vm.Run("x = 1\n# frozen_string_literal: true\n'hello'.frozen?")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+		})
+
+		It("leaves string literals unfrozen without it", func() {
+			value, err := vm.Run("'hello'.frozen?")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
 		})
 	})
 
@@ -93,6 +157,26 @@ adj = 'cruel'
 		})
 	})
 
+	Describe("#to_sym", func() {
+		It("returns the same symbol as the literal", func() {
+			value, err := vm.Run("'foo'.to_sym.equal?(:foo)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("round trips through Symbol#to_s", func() {
+			value, err := vm.Run("'foo bar'.to_sym.to_s")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal("foo bar"))
+		})
+
+		It("is the quoted symbol literal for names with special characters", func() {
+			value, err := vm.Run(`'foo bar'.to_sym.equal?(:"foo bar")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("true")))
+		})
+	})
+
 	Describe("#split", func() {
 		It("splits on the given separator", func() {
 			result, err := vm.Run("'hello world'.split(' ')")
@@ -106,6 +190,245 @@ adj = 'cruel'
 		})
 	})
 
+	Describe("#chomp", func() {
+		It("removes a trailing newline", func() {
+			result, err := vm.Run(`"hi\n".chomp`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("hi"))
+		})
+
+		It("removes a trailing carriage return and newline as a unit", func() {
+			result, err := vm.Run(`"hi\r\n".chomp`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("hi"))
+
+			result, err = vm.Run(`"hi\n\r".chomp`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("hi\n"))
+		})
+
+		It("removes the given suffix", func() {
+			result, err := vm.Run(`"hellox".chomp("x")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("hello"))
+
+			result, err = vm.Run(`"hello".chomp("x")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("hello"))
+		})
+
+		It("does not modify the receiver", func() {
+			_, err := vm.Run(`str = "hi\n"; str.chomp`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.MustGet("str").(*StringValue).RawString()).To(Equal("hi\n"))
+		})
+	})
+
+	Describe("#strip", func() {
+		It("removes leading and trailing whitespace", func() {
+			result, err := vm.Run(`"\t  padded \n".strip`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("padded"))
+		})
+
+		It("has left and right variants", func() {
+			result, err := vm.Run(`"  padded  ".lstrip`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("padded  "))
+
+			result, err = vm.Run(`"  padded  ".rstrip`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("  padded"))
+		})
+	})
+
+	Describe("#reverse", func() {
+		It("reverses the characters of the string", func() {
+			result, err := vm.Run(`"héllo".reverse`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("olléh"))
+		})
+	})
+
+	Describe("#gsub", func() {
+		It("replaces every match of a string", func() {
+			result, err := vm.Run(`"a.b.c".gsub(".", "-")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("a-b-c"))
+		})
+
+		It("can refer to the groups of a regexp in the replacement", func() {
+			result, err := vm.Run(`"john smith".gsub(/(\w+) (\w+)/, '\2, \1')`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("smith, john"))
+		})
+
+		It("replaces each match with the result of the block", func() {
+			result, err := vm.Run(`"hello".gsub(/l/) { |match| match.upcase }`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("heLLo"))
+		})
+
+		It("returns an enumerator of the matches with neither a replacement nor a block", func() {
+			result, err := vm.Run(`"hello".gsub(/l+|o/).to_a`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PrettyPrint()).To(Equal(`["ll", "o"]`))
+
+			result, err = vm.Run(`str = "hello"; str.gsub!(/l/).each { |match| match.upcase }; str`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("heLLo"))
+		})
+
+		It("raises an ArgumentError without a pattern", func() {
+			_, err := vm.Run(`"hello".gsub`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (0 for 1..2)"))
+		})
+	})
+
+	Describe("#replace", func() {
+		It("replaces the contents of the receiver", func() {
+			result, err := vm.Run(`str = "old"; other = str; str.replace("new"); [str, other]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PrettyPrint()).To(Equal(`["new", "new"]`))
+		})
+
+		It("raises a FrozenError for a frozen string", func() {
+			_, err := vm.Run(`"old".freeze.replace("new")`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`FrozenError: can't modify frozen String: "old"`))
+		})
+
+		It("raises an ArgumentError without a replacement", func() {
+			_, err := vm.Run(`"old".replace`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (0 for 1)"))
+		})
+	})
+
+	Describe("bang methods", func() {
+		It("modify the receiver in place and return it", func() {
+			result, err := vm.Run(`
+str = "  Hello World\n"
+other = str
+results = [str.chomp!, str.strip!, str.upcase!, str.downcase!, str.reverse!, str.gsub!("o", "0")]
+[other, results.map { |r| r.equal?(str) }]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PrettyPrint()).To(Equal(`["dlr0w 0lleh", [true, true, true, true, true, true]]`))
+		})
+
+		It("return nil when nothing changed", func() {
+			result, err := vm.Run(`str = "hi"; [str.chomp!, str.strip!, str.downcase!, str.gsub!("x", "y"), str]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PrettyPrint()).To(Equal(`[nil, nil, nil, nil, "hi"]`))
+		})
+
+		It("raise a FrozenError for a frozen string, even when nothing would change", func() {
+			_, err := vm.Run(`"HI".freeze.upcase!`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`FrozenError: can't modify frozen String: "HI"`))
+		})
+	})
+
+	Describe("#start_with? and #end_with?", func() {
+		It("are true when the string has the prefix or suffix", func() {
+			result, err := vm.Run(`["hello".start_with?("he"), "hello".start_with?("lo"), "hello".end_with?("lo"), "hello".end_with?("he")]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PrettyPrint()).To(Equal("[true, false, true, false]"))
+		})
+
+		It("are true when any of several prefixes or suffixes match", func() {
+			result, err := vm.Run(`["hello".start_with?("xy", "he"), "hello".end_with?("lo", "xy"), "hello".end_with?("xy", "z")]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PrettyPrint()).To(Equal("[true, true, false]"))
+		})
+
+		It("raise a TypeError for anything other than a string", func() {
+			_, err := vm.Run(`"hello".start_with?(1)`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("TypeError: no implicit conversion of Fixnum into String"))
+		})
+	})
+
+	Describe("#ljust, #rjust and #center", func() {
+		It("pad the string to the given width with spaces", func() {
+			result, err := vm.Run(`"hi".ljust(5)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("hi   "))
+		})
+
+		It("pad with the string given", func() {
+			result, err := vm.Run(`["hi".rjust(5, "0"), "hi".center(6, "*"), "hi".center(7, "ab")]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PrettyPrint()).To(Equal(`["000hi", "**hi**", "abhiaba"]`))
+		})
+
+		It("count the width in characters rather than bytes", func() {
+			result, err := vm.Run(`"héllo".rjust(7, "·")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("··héllo"))
+		})
+
+		It("return a copy of the string when it is already wide enough", func() {
+			result, err := vm.Run(`"hello".center(3)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("hello"))
+		})
+
+		It("raise an ArgumentError for an empty pad string", func() {
+			_, err := vm.Run(`"hi".ljust(5, "")`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: zero width padding"))
+		})
+	})
+
+	Describe("#%", func() {
+		It("formats a single value", func() {
+			result, err := vm.Run(`"%05.2f" % 3.14159`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("03.14"))
+		})
+
+		It("formats each value in an array", func() {
+			result, err := vm.Run(`"%s is %d (%x)" % ['pie', 255, 255]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("pie is 255 (ff)"))
+		})
+
+		It("formats bignums", func() {
+			result, err := vm.Run(`"%d %+x %.1f" % [2 ** 70, 2 ** 70, 2 ** 70]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("1180591620717411303424 +400000000000000000 1180591620717411303424.0"))
+		})
+
+		It("raises an ArgumentError when there are too few arguments", func() {
+			_, err := vm.Run(`"%s and %s" % ['lonely']`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: too few arguments"))
+		})
+
+		It("raises an ArgumentError when there are too many arguments", func() {
+			_, err := vm.Run(`"%s" % ['one', 'two']`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: too many arguments for format string"))
+		})
+	})
+
+	Describe("#*", func() {
+		It("repeats the string", func() {
+			result, err := vm.Run(`"ab" * 3`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.(*StringValue).RawString()).To(Equal("ababab"))
+		})
+
+		It("raises an ArgumentError for a negative count", func() {
+			_, err := vm.Run(`"ab" * -1`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: negative argument"))
+		})
+	})
+
 	Describe("#encode", func() {
 		It("should be implmented", func() {
 			result, err := vm.Run(`
@@ -0,0 +1,110 @@
+package vm_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Struct", func() {
+	var vm VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+
+		_, err = vm.Run(`
+Point = Struct.new(:x, :y) do
+  def sum
+    x + y
+  end
+end
+`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("creates a class named after its constant", func() {
+		value, err := vm.Run("Point")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.String()).To(Equal("Point"))
+		Expect(value.(Class).SuperClass().String()).To(Equal("Struct"))
+	})
+
+	It("has a constructor that takes the members in order", func() {
+		_, err := vm.Run("point = Point.new(1, 2)")
+		Expect(err).ToNot(HaveOccurred())
+
+		value, err := vm.Run("point.x")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(1, vm)))
+
+		value, err = vm.Run("point.y")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(2, vm)))
+	})
+
+	It("defaults missing members to nil", func() {
+		value, err := vm.Run("Point.new(1).y")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(vm.SingletonWithName("nil")))
+	})
+
+	It("raises an ArgumentError when given too many arguments", func() {
+		_, err := vm.Run("Point.new(1, 2, 3)")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ArgumentError: struct size differs"))
+	})
+
+	It("has writers for each member", func() {
+		value, err := vm.Run(`
+point = Point.new(1, 2)
+point.x = 10
+point.x
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(10, vm)))
+	})
+
+	It("defines the methods in the block given", func() {
+		value, err := vm.Run("Point.new(3, 4).sum")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(NewFixnum(7, vm)))
+	})
+
+	It("compares structs by their members", func() {
+		value, err := vm.Run("Point.new(1, 2) == Point.new(1, 2)")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(vm.SingletonWithName("true")))
+
+		value, err = vm.Run("Point.new(1, 2) == Point.new(2, 1)")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(vm.SingletonWithName("false")))
+	})
+
+	It("has a #to_s that shows its members", func() {
+		value, err := vm.Run("Point.new(1, 'two').to_s")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(EqualRubyString(`#<struct Point x=1, y="two">`))
+	})
+
+	It("has a #to_a that returns its member values", func() {
+		value, err := vm.Run("Point.new(1, 2).to_a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm), NewFixnum(2, vm)}))
+	})
+
+	It("lists its members", func() {
+		value, err := vm.Run("Point.members")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.(*Array).Members()).To(HaveLen(2))
+	})
+})
@@ -1,18 +1,53 @@
 package vm
 
 import (
+	"errors"
+
 	"github.com/grubby/grubby/ast"
 
 	. "github.com/grubby/grubby/interpreter/vm/builtins"
 )
 
 func interpretSuperCall(vm *vm, superCall ast.SuperclassMethodImplCall, context Value) (Value, error) {
-	methodName := vm.stack.Frames[0].Method
-	superClass := context.Class().SuperClass()
-	superMethod, err := superClass.InstanceMethod(methodName)
-	if err != nil {
-		return nil, NewNoMethodError(methodName, superClass.String(), superClass.Class().String(), vm.stack.String())
+	if len(vm.methods) == 0 {
+		return nil, errors.New("RuntimeError: super called outside of method")
+	}
+
+	method := vm.methods[len(vm.methods)-1]
+	superMethod := SuperMethod(context.Class(), method)
+	if superMethod == nil {
+		return nil, NewNoMethodError(method.Name(), context, vm)
+	}
+
+	// a bare super passes along the arguments the method was called with
+	var args []Value
+	if superCall.Args == nil {
+		args = vm.argsOfCurrentMethod(method)
+	} else {
+		var err error
+		args, err = callArgsInContext(vm, ast.CallExpression{Args: superCall.Args}, context)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vm.invokeMethod(superMethod, context, nil, superCall.LineNumber(), args...)
+}
+
+func (vm *vm) argsOfCurrentMethod(method *RubyMethod) []Value {
+	args := []Value{}
+	for _, param := range method.Params() {
+		value, err := vm.localVariableStack.Retrieve(param.Name)
+		if err != nil || param.IsKeyword || param.IsProc {
+			continue
+		}
+
+		if splat, ok := value.(*Array); ok && param.IsSplat {
+			args = append(args, splat.Members()...)
+		} else {
+			args = append(args, value)
+		}
 	}
 
-	return superMethod.Execute(context, nil)
+	return args
 }
@@ -22,7 +22,7 @@ func interpretSwitchStatement(vm *vm, switchStmt ast.SwitchStatement, context Va
 
 			method := condition.Method("===")
 			if method == nil {
-				return nil, NewNoMethodError("===", condition.String(), condition.Class().String(), vm.stack.String())
+				return nil, NewNoMethodError("===", condition, vm)
 			}
 
 			matches, err := method.Execute(condition, nil, conditionToMatch)
@@ -7,14 +7,5 @@ import (
 )
 
 func interpretSymbol(vm *vm, symbol ast.Symbol) Value {
-	name := symbol.Name
-	maybe, ok := vm.CurrentSymbols[name]
-
-	if !ok {
-		symbol := NewSymbol(name, vm)
-		vm.CurrentSymbols[name] = symbol
-		return symbol
-	} else {
-		return maybe
-	}
+	return NewSymbol(symbol.Name, vm)
 }
@@ -62,4 +62,134 @@ var _ = Describe("symbols", func() {
 		secondPointer := reflect.ValueOf(sameSymbol).Pointer()
 		Expect(secondPointer).To(Equal(firstPointer))
 	})
+
+	It("is always frozen", func() {
+		frozen, err := vm.Run(":foo.frozen?")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(vm.SingletonWithName("true")))
+	})
+
+	It("cannot have singleton methods", func() {
+		_, err := vm.Run("sym = :foo; def sym.shout; 'FOO'; end")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("TypeError: can't define singleton"))
+	})
+
+	Describe("interning", func() {
+		It("is the same symbol however it was made", func() {
+			value, err := vm.Run(`
+class Point
+  def initialize
+    @x = 1
+  end
+end
+[
+  'foo'.intern.equal?(:foo),
+  Struct.new(:bar).members.first.equal?(:bar),
+  Point.new.instance_variables.first.equal?(:@x),
+  Point.instance_methods(false).first.equal?(:initialize)
+]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true, true, true]"))
+		})
+
+		It("returns the symbol the program uses from NewSymbol", func() {
+			Expect(NewSymbol("foo", vm)).To(BeIdenticalTo(val))
+		})
+
+		It("registers symbols made in go", func() {
+			symbol := NewSymbol("made_in_go", vm)
+			Expect(vm.Symbols()["made_in_go"]).To(BeIdenticalTo(symbol))
+
+			value, err := vm.Run(":made_in_go")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(BeIdenticalTo(symbol))
+		})
+
+		It("finds hash keys with a symbol made some other way", func() {
+			value, err := vm.Run("hash = { foo: 1 }; hash['foo'.intern]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(1, vm)))
+		})
+	})
+
+	Describe("comparing", func() {
+		It("is equal to a symbol with the same name", func() {
+			value, err := vm.Run(":foo == :foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("true")))
+
+			value, err = vm.Run(":foo == :bar")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+		})
+
+		It("is not equal to a string with the same name", func() {
+			value, err := vm.Run(":foo == 'foo'")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+		})
+
+		It("is identical to a symbol with the same name", func() {
+			value, err := vm.Run(":foo.equal?(:foo)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("orders symbols by their names", func() {
+			value, err := vm.Run("[:pear, :apple, :fig].sort")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[:apple, :fig, :pear]"))
+		})
+
+		It("cannot be compared with other values", func() {
+			value, err := vm.Run(":foo <=> 'foo'")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+	})
+
+	It("has the length of its name", func() {
+		value, err := vm.Run("[:foo.length, 'héllo'.intern.size]")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.PrettyPrint()).To(Equal("[3, 5]"))
+	})
+
+	It("can be upcased and downcased into other symbols", func() {
+		value, err := vm.Run("[:Foo.upcase, :Foo.downcase, :FOO.downcase.equal?(:foo)]")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value.PrettyPrint()).To(Equal("[:FOO, :foo, true]"))
+	})
+
+	Describe("inspecting", func() {
+		It("is written as the literal", func() {
+			value, err := vm.Run("[:foo, :bar?, :@baz, '<=>'.to_sym, '[]='.to_sym]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[:foo, :bar?, :@baz, :<=>, :[]=]"))
+		})
+
+		It("quotes names with special characters", func() {
+			value, err := vm.Run(`:"foo bar".inspect`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal(`:"foo bar"`))
+
+			value, err = vm.Run("['a-b'.to_sym]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[:"a-b"]`))
+		})
+
+		It("escapes quotes, backslashes and newlines in quoted names", func() {
+			value, err := vm.Run(`[:"a\"b", :"c\\", "d\ne".to_sym]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal(`[:"a\"b", :"c\\", :"d\ne"]`))
+			Expect(vm.Symbols()).To(HaveKey(`a"b`))
+		})
+	})
+
+	It("returns itself from to_sym", func() {
+		value, err := vm.Run(":foo.to_sym.equal?(:foo)")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(vm.SingletonWithName("true")))
+	})
 })
@@ -0,0 +1,132 @@
+package vm
+
+import (
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+// a call of a method to itself whose result is returned directly
+// rather than recursing, the body of the method is run again with new args
+type tailCall struct {
+	target Value
+	args   []Value
+}
+
+// executeMethodBody runs the body of a method declared in ruby
+// self recursive calls in tail position reuse the current frame, so that
+// deep recursion neither grows the go stack nor the backtrace
+func (vm *vm) executeMethodBody(self Value, method *RubyMethod, body *compiledBody) (Value, error) {
+	vm.methods = append(vm.methods, method)
+	defer func() { vm.methods = vm.methods[:len(vm.methods)-1] }()
+
+	for calls := 0; ; calls++ {
+		value, call, err := vm.executeMethodFrame(self, method, body)
+		if err != nil || call == nil {
+			return returnedValue(value, err)
+		}
+
+		if calls >= vm.maxTailCalls() {
+			return nil, NewSystemStackError(vm)
+		}
+
+		err = method.BindArgs(call.target, call.args...)
+		if err != nil {
+			return nil, err
+		}
+		self = call.target
+	}
+}
+
+func (vm *vm) executeMethodFrame(self Value, method *RubyMethod, body *compiledBody) (Value, *tailCall, error) {
+	vm.localVariableStack.Unshift()
+	defer vm.localVariableStack.Shift()
+
+	for _, arg := range method.Args() {
+		vm.localVariableStack.Store(arg.Name, arg.Value)
+	}
+
+	if vm.options.TreeWalk {
+		return vm.executeStatementsInTailPosition(self, method, method.Body())
+	}
+
+	return vm.executeCompiled(self, method, body.compile(method.Body()))
+}
+
+func (vm *vm) executeStatementsInTailPosition(
+	context Value,
+	method *RubyMethod,
+	statements []ast.Node,
+) (Value, *tailCall, error) {
+	if len(statements) == 0 {
+		value, err := vm.executeWithContext(context)
+		return value, nil, err
+	}
+
+	// a return before the last statement unwinds to executeMethodBody
+	last := len(statements) - 1
+	_, err := vm.executeWithContext(context, statements[:last]...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vm.executeInTailPosition(context, method, statements[last])
+}
+
+func (vm *vm) executeInTailPosition(
+	context Value,
+	method *RubyMethod,
+	statement ast.Node,
+) (Value, *tailCall, error) {
+	switch statement := statement.(type) {
+	case ast.Return:
+		return vm.executeInTailPosition(context, method, statement.Value)
+	case ast.IfBlock:
+		truthy, err := ifConditionInContext(vm, statement, context)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if truthy {
+			return vm.executeStatementsInTailPosition(context, method, statement.Body)
+		} else {
+			return vm.executeStatementsInTailPosition(context, method, statement.Else)
+		}
+	case ast.Ternary:
+		value, err := vm.executeWithContext(context, statement.Condition)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if value.IsTruthy() {
+			return vm.executeInTailPosition(context, method, statement.True)
+		} else {
+			return vm.executeInTailPosition(context, method, statement.False)
+		}
+	case ast.CallExpression:
+		if isOperatorAssignment(statement) {
+			value, err := interpretOperatorAssignmentInContext(vm, statement, context)
+			return value, nil, err
+		}
+
+		target, err := callTargetInContext(vm, statement, context)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if statement.OptionalBlock.Provided() || target.Method(statement.Func.Name) != method {
+			value, err := invokeCallExpression(vm, statement, context, target)
+			return value, nil, err
+		}
+
+		args, err := callArgsInContext(vm, statement, context)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return nil, &tailCall{target: target, args: args}, nil
+	default:
+		value, err := vm.executeWithContext(context, statement)
+		return value, nil, err
+	}
+}
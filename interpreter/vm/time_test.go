@@ -0,0 +1,95 @@
+package vm_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/grubby/grubby/interpreter/vm"
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+	. "github.com/grubby/grubby/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Time", func() {
+	var vm VM
+
+	BeforeEach(func() {
+		pathToExecutable, err := filepath.Abs(filepath.Dir(filepath.Dir(filepath.Dir(os.Args[0]))))
+		if err != nil {
+			panic(err)
+		}
+
+		vm = NewVM(pathToExecutable, "fake-irb-under-test")
+	})
+
+	Describe(".now", func() {
+		It("returns the current time", func() {
+			before := time.Now().Unix()
+			value, err := vm.Run("Time.now")
+			after := time.Now().Unix()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(BeAssignableToTypeOf(&TimeValue{}))
+
+			seconds := value.(*TimeValue).Time().Unix()
+			Expect(seconds).To(BeNumerically(">=", before))
+			Expect(seconds).To(BeNumerically("<=", after))
+		})
+	})
+
+	Describe("instances", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2014, time.March, 7, 9, 5, 3, 0, time.UTC)
+			vm.Set("t", NewTime(now, vm))
+		})
+
+		It("can be converted to an integer", func() {
+			value, err := vm.Run("t.to_i")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("1394183103"))
+		})
+
+		It("can be converted to a string", func() {
+			value, err := vm.Run("t.to_s")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("2014-03-07 09:05:03 +0000"))
+		})
+
+		It("exposes each component of the time", func() {
+			for method, expected := range map[string]string{
+				"year":  "2014",
+				"month": "3",
+				"day":   "7",
+				"hour":  "9",
+				"min":   "5",
+				"sec":   "3",
+			} {
+				value, err := vm.Run("t." + method)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value.String()).To(Equal(expected), method)
+			}
+		})
+
+		It("can be formatted with strftime", func() {
+			value, err := vm.Run("t.strftime('%Y-%m-%d %H:%M:%S %% %Q')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("2014-03-07 09:05:03 % %Q"))
+		})
+
+		It("raises unless strftime is given one format string", func() {
+			_, err := vm.Run("t.strftime")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("ArgumentError")))
+			Expect(err.Error()).To(ContainSubstring("wrong number of arguments (0 for 1)"))
+
+			_, err = vm.Run("t.strftime(1)")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("TypeError")))
+			Expect(err.Error()).To(ContainSubstring("TypeError: no implicit conversion of Fixnum into String"))
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package vm
+
+import (
+	"github.com/grubby/grubby/ast"
+
+	. "github.com/grubby/grubby/interpreter/vm/builtins"
+)
+
+func interpretUndefInContext(
+	vm *vm,
+	undef ast.Undef,
+	context Value,
+) (Value, error) {
+	contextModule, ok := context.(Module)
+	if !ok {
+		contextModule = context.Class()
+	}
+
+	for _, name := range undef.Names {
+		err := UndefMethod(contextModule, name.Name, vm)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vm.SingletonWithName("nil"), nil
+}
@@ -1,11 +1,17 @@
 package vm
 
 import (
+	"bufio"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/grubby/grubby/ast"
 	"github.com/grubby/grubby/parser"
@@ -14,6 +20,11 @@ import (
 )
 
 type vm struct {
+	// what the vm was created with, kept so that Reset can start over
+	rubyHome    string
+	programName string
+	options     VMOptions
+
 	currentFilename string
 
 	ObjectSpace    map[string]Value
@@ -23,21 +34,59 @@ type vm struct {
 	CurrentModules map[string]Module
 	singletons     map[string]Value
 
+	// the builtins as registerBuiltinClassesAndModules left them, for Reset
+	builtins *builtinTables
+
+	// guards ObjectSpace, CurrentGlobals, CurrentSymbols, singletons and the
+	// classes and modules, so that goroutines sharing the vm can look up and
+	// intern values safely. Go code should use Get, Set, GetClass, Symbols,
+	// Globals and the like rather than the maps.
+	tables sync.RWMutex
+
+	// the methods found by looking them up on the classes of this vm
+	methodCache *MethodCache
+
 	stack              *CallStack
 	localVariableStack *LocalVariableStack
 
+	// how many method calls are nested now, which MaxStackDepth bounds. a
+	// call pushes more than one frame onto stack, so that can't be counted
+	calls int
+
+	// the ruby methods being run, innermost last, for __method__ and super
+	methods []*RubyMethod
+
+	liveObjects LiveObjects
+
 	inEigenclassBlock bool
 
 	currentModuleName string // FIXME: I bet this could be determined from context
 
 	exitCallbacks []Block
 
+	// set while RunWithContext runs, see interrupted
+	runContext context.Context
+
+	// held by Run, RunWithContext, Exit and Reset, as a vm has a single call
+	// stack (and one of everything else a running program keeps track of)
+	running sync.Mutex
+
 	required_files map[string]bool
+
+	stdin *bufio.Reader
 }
 
+// A VM can be shared by goroutines: Run, RunWithContext, Eval, Exit and
+// Reset take turns, each waiting for the others to finish, and Get, Set,
+// GetClass, GetModule, Classes, Modules, Symbols and Globals can be called at
+// any time. A GoFunction is called while its program is running, so it must
+// not call back into Run or Eval.
 type VM interface {
 	Run(string) (Value, error)
+	RunWithContext(context.Context, string) (Value, error)
+	Eval(string) (interface{}, error)
 	Exit()
+	Reset()
 
 	Get(string) (Value, error)
 	MustGet(string) Value
@@ -49,6 +98,10 @@ type VM interface {
 	MustGetModule(string) Module
 
 	Set(string, Value)
+	SetGo(string, interface{}) error
+
+	DefineMethod(name string, fn GoFunction) error
+	DefineMethodOn(moduleName, name string, fn GoFunction) error
 
 	Symbols() map[string]Value
 	Globals() map[string]Value
@@ -62,45 +115,165 @@ type VM interface {
 	StackProvider
 }
 
+type VMOptions struct {
+	// Stdout and Stderr back $stdout and $stderr (defaulting to os.Stdout and os.Stderr)
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// MaxStackDepth is how deep method calls may nest before a
+	// SystemStackError is raised (defaulting to DefaultMaxStackDepth)
+	MaxStackDepth int
+
+	// MaxTailCalls is how many times a method may call itself in tail
+	// position, which never nests, before a SystemStackError is raised
+	// (defaulting to DefaultMaxTailCalls)
+	MaxTailCalls int
+
+	// TreeWalk runs the bodies of methods by walking their syntax tree on
+	// every call, rather than compiling them on their first call
+	TreeWalk bool
+
+	// Sandbox freezes the builtin classes and modules, so that a program
+	// defining, aliasing or removing their methods raises a FrozenError, as
+	// does replacing a builtin Kernel method or constant at the top level.
+	// The classes and modules named in Reopenable are left as they are.
+	Sandbox    bool
+	Reopenable []string
+}
+
+const (
+	DefaultMaxStackDepth = 10000
+	DefaultMaxTailCalls  = 1000000
+)
+
 func NewVM(rubyHome, name string) VM {
+	return NewVMWithOptions(rubyHome, name, VMOptions{})
+}
+
+func NewVMWithOptions(rubyHome, name string, options VMOptions) VM {
 	vm := &vm{
-		currentFilename:    name,
-		stack:              NewCallStack(),
-		CurrentGlobals:     make(map[string]Value),
-		ObjectSpace:        make(map[string]Value),
-		CurrentSymbols:     make(map[string]Value),
-		CurrentModules:     make(map[string]Module),
-		localVariableStack: NewLocalVariableStack(),
-		singletons:         make(map[string]Value),
-		required_files:     make(map[string]bool),
-	}
-	vm.registerBuiltinClassesAndModules()
-
-	loadPath, _ := vm.CurrentClasses["Array"].New(vm)
+		rubyHome:    rubyHome,
+		programName: name,
+		options:     options,
+		methodCache: NewMethodCache(),
+	}
+	vm.Reset()
+
+	return vm
+}
+
+// Reset puts the vm back in the state it was created in, forgetting every
+// variable, global, symbol, class, module and method the programs it ran
+// defined. The builtin classes are put back as they were when the vm was
+// created, as a program may have reopened them. The output given in
+// VMOptions, and whatever was read from stdin so far, are kept.
+func (vm *vm) Reset() {
+	vm.running.Lock()
+	defer vm.running.Unlock()
+
+	rubyHome, name, options := vm.rubyHome, vm.programName, vm.options
+
+	vm.currentFilename = name
+	vm.stack = NewCallStack()
+	vm.localVariableStack = NewLocalVariableStack()
+	vm.required_files = make(map[string]bool)
+	vm.methods = nil
+	vm.liveObjects = LiveObjects{}
+	vm.inEigenclassBlock = false
+	vm.currentModuleName = ""
+	vm.exitCallbacks = nil
+
+	if vm.builtins == nil {
+		// the vm is still being created, so nothing else can be using it
+		vm.CurrentGlobals = make(map[string]Value)
+		vm.ObjectSpace = make(map[string]Value)
+		vm.CurrentSymbols = make(map[string]Value)
+		vm.singletons = make(map[string]Value)
+		vm.registerBuiltinClassesAndModules()
+		vm.builtins = vm.snapshotBuiltins()
+	} else {
+		vm.tables.Lock()
+		vm.builtins.restoreTables(vm)
+		vm.builtins.restoreValues()
+		vm.tables.Unlock()
+	}
+
+	// the new globals and objects are built aside and swapped in below, as
+	// creating them looks up classes (and interns symbols) in the tables
+	globals := make(map[string]Value)
+	objectSpace := make(map[string]Value)
+
+	loadPath, _ := vm.ClassWithName("Array").New(vm)
 	loadPath.(*Array).Append(NewString(filepath.Join(rubyHome, "lib"), vm))
+	if cwd, err := os.Getwd(); err == nil {
+		loadPath.(*Array).Append(NewString(cwd, vm))
+	}
+
+	globals["LOAD_PATH"] = loadPath
+	globals[":"] = loadPath
 
-	vm.CurrentGlobals["LOAD_PATH"] = loadPath
-	vm.CurrentGlobals[":"] = loadPath
+	stdout := NewIO(writerOrDefault(options.Stdout, func() *os.File { return os.Stdout }), vm)
+	stderr := NewIO(writerOrDefault(options.Stderr, func() *os.File { return os.Stderr }), vm)
+	globals["stdout"] = stdout
+	globals["stderr"] = stderr
 
-	argvArray, err := vm.CurrentClasses["Array"].New(vm)
+	programName := NewString(name, vm)
+	globals["PROGRAM_NAME"] = programName
+	globals["0"] = programName
+
+	argvArray, err := vm.ClassWithName("Array").New(vm)
 	if err != nil {
 		panic(err)
 	}
-	vm.CurrentClasses["Object"].SetConstant("ARGV", argvArray)
-	vm.CurrentClasses["Object"].SetConstant("RUBY_NAME", NewString("grubby", vm))
-	vm.CurrentClasses["Object"].SetConstant("ENV", NewENVConstant(vm))
 
-	// this is a temporary hack to gain progress on running rubyspec
-	// as an alternative, we could implement String#=~
-	vm.CurrentClasses["Object"].SetConstant("RUBY_EXE", NewString("bin/ruby", vm))
+	constants := map[string]Value{
+		"STDOUT":    stdout,
+		"STDERR":    stderr,
+		"ARGV":      argvArray,
+		"RUBY_NAME": NewString("grubby", vm),
+		"ENV":       NewENVConstant(vm),
+
+		// this is a temporary hack to gain progress on running rubyspec
+		// as an alternative, we could implement String#=~
+		"RUBY_EXE": NewString("bin/ruby", vm),
+	}
 
-	main, _ := vm.CurrentClasses["Object"].New(vm)
+	main, _ := vm.ClassWithName("Object").New(vm)
 	main.AddMethod(NewNativeMethod("to_s", vm, func(self Value, block Block, args ...Value) (Value, error) {
 		return NewString("main", vm), nil
 	}))
-	vm.ObjectSpace["main"] = main
+	objectSpace["main"] = main
 
-	return vm
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
+	vm.CurrentGlobals = globals
+	vm.ObjectSpace = objectSpace
+	for name, value := range constants {
+		vm.CurrentClasses["Object"].SetConstant(name, value)
+	}
+
+	if options.Sandbox {
+		vm.freezeBuiltins(options.Reopenable)
+	}
+}
+
+func (vm *vm) freezeBuiltins(reopenable []string) {
+	skipped := map[string]bool{}
+	for _, name := range reopenable {
+		skipped[name] = true
+	}
+
+	for name, class := range vm.CurrentClasses {
+		if !skipped[name] {
+			class.Freeze()
+		}
+	}
+	for name, module := range vm.CurrentModules {
+		if !skipped[name] {
+			module.Freeze()
+		}
+	}
 }
 
 func (vm *vm) registerBuiltinClassesAndModules() {
@@ -119,18 +292,23 @@ func (vm *vm) registerBuiltinClassesAndModules() {
 	moduleClass := NewModuleClass(vm, vm)
 	vm.CurrentClasses["Module"] = moduleClass
 	vm.CurrentModules["Comparable"] = NewComparableModule(vm)
+	vm.CurrentModules["Enumerable"] = NewEnumerableModule(vm)
 	vm.CurrentModules["Kernel"] = NewGlobalKernelModule(vm)
 	vm.CurrentModules["Process"] = NewProcessModule(vm)
 
 	// FIXME: this should be private, but method resolution fails
 	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("require", vm, func(self Value, block Block, args ...Value) (Value, error) {
 		fileName := args[0].(*StringValue).RawString()
-		if fileName == "rubygems" {
+		switch fileName {
+		case "rubygems":
 			// don't "require 'rubygems'"
-			return vm.singletons["false"], nil
+			return vm.SingletonWithName("false"), nil
+		case "set":
+			// Set is built in, so there is no need to load lib/set.rb
+			return vm.SingletonWithName("false"), nil
 		}
 
-		loadPath := vm.CurrentGlobals["LOAD_PATH"]
+		loadPath, _ := vm.global("LOAD_PATH")
 		for _, pathStr := range loadPath.(*Array).Members() {
 			path := pathStr.(*StringValue)
 			fullPath := filepath.Join(path.RawString(), fileName+".rb")
@@ -146,7 +324,7 @@ func (vm *vm) registerBuiltinClassesAndModules() {
 
 			_, ok := vm.required_files[absolutePath]
 			if ok {
-				return vm.singletons["false"], nil
+				return vm.SingletonWithName("false"), nil
 			}
 			vm.required_files[absolutePath] = true
 
@@ -158,24 +336,76 @@ func (vm *vm) registerBuiltinClassesAndModules() {
 				}()
 
 				vm.currentFilename = file.Name()
-				_, rubyErr := vm.Run(string(contents))
+				_, rubyErr := vm.run(string(contents))
 				if rubyErr != nil {
 					return nil, rubyErr
 				}
 
-				return vm.singletons["true"], nil
+				return vm.SingletonWithName("true"), nil
 			}
 		}
 
-		errorMessage := fmt.Sprintf("LoadError: cannot load such file -- %s", fileName)
-		return nil, NewLoadError(errorMessage, vm.stack.String())
+		return nil, NewLoadError(fileName, vm)
+	}))
+	vm.CurrentModules["Kernel"].AddMethod(NewSleepMethod(vm, vm.sleep))
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("puts", vm, func(self Value, block Block, args ...Value) (Value, error) {
+		return vm.sendToStdout("puts", args...)
+	}))
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("print", vm, func(self Value, block Block, args ...Value) (Value, error) {
+		return vm.sendToStdout("print", args...)
+	}))
+	// like puts, but to $stderr, and nothing at all is written for no args
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("warn", vm, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 {
+			return vm.SingletonWithName("nil"), nil
+		}
+
+		return vm.sendToStream("stderr", "puts", args...)
+	}))
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("p", vm, vm.inspectToStdout(Inspect)))
+	// like p, but wide arrays and hashes are spread over several lines
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("pp", vm, vm.inspectToStdout(PrettyInspect)))
+	// printf is sprintf, but written to $stdout rather than returned
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("printf", vm, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(args) == 0 {
+			return vm.SingletonWithName("nil"), nil
+		}
+
+		formatted, err := vm.CurrentModules["Kernel"].Method("sprintf").Execute(self, nil, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		return vm.sendToStdout("write", formatted)
 	}))
 	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("at_exit", vm, func(self Value, block Block, args ...Value) (Value, error) {
 		if block != nil {
 			vm.exitCallbacks = append(vm.exitCallbacks, block)
 		}
 
-		return nil, nil
+		return vm.SingletonWithName("nil"), nil
+	}))
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("gets", vm, func(self Value, block Block, args ...Value) (Value, error) {
+		if vm.stdin == nil {
+			vm.stdin = bufio.NewReader(os.Stdin)
+		}
+
+		line, err := vm.stdin.ReadString('\n')
+		if err != nil && line == "" {
+			vm.SetGlobal("_", vm.SingletonWithName("nil"))
+			return vm.SingletonWithName("nil"), nil
+		}
+
+		str := NewString(line, vm)
+		vm.SetGlobal("_", str)
+		return str, nil
+	}))
+	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("__method__", vm, func(self Value, block Block, args ...Value) (Value, error) {
+		if len(vm.methods) == 0 {
+			return vm.SingletonWithName("nil"), nil
+		}
+
+		return NewSymbol(vm.methods[len(vm.methods)-1].Name(), vm), nil
 	}))
 	vm.CurrentModules["Kernel"].AddMethod(NewNativeMethod("object_id", vm, func(self Value, block Block, args ...Value) (Value, error) {
 		return NewFixnum(1, vm), nil
@@ -204,19 +434,53 @@ func (vm *vm) registerBuiltinClassesAndModules() {
 	vm.CurrentClasses["IO"] = NewIOClass(vm)
 	vm.CurrentClasses["Array"] = NewArrayClass(vm)
 	vm.CurrentClasses["Hash"] = NewHashClass(vm)
+	vm.CurrentClasses["Range"] = NewRangeClass(vm)
+	vm.CurrentClasses["Set"] = NewSetClass(vm)
+	vm.CurrentClasses["Enumerator"] = NewEnumeratorClass(vm)
+	for _, name := range []string{"Array", "Hash", "Range", "Set", "Enumerator"} {
+		vm.CurrentClasses[name].Include(vm.CurrentModules["Enumerable"])
+	}
 	vm.CurrentClasses["String"] = NewStringClass(vm)
 	vm.CurrentClasses["Numeric"] = NewNumericClass(vm)
 	vm.CurrentClasses["Integer"] = NewIntegerClass(vm)
 	vm.CurrentClasses["Fixnum"] = NewFixnumClass(vm)
+	vm.CurrentClasses["Bignum"] = NewBignumClass(vm)
 	vm.CurrentClasses["Float"] = NewFloatClass(vm)
+	vm.CurrentClasses["Rational"] = NewRationalClass(vm)
+	vm.CurrentClasses["Complex"] = NewComplexClass(vm)
 	vm.CurrentClasses["Symbol"] = NewSymbolClass(vm)
 	vm.CurrentClasses["Proc"] = NewProcClass(vm)
+	vm.CurrentClasses["Method"] = NewMethodClass(vm)
 	vm.CurrentClasses["Regexp"] = NewRegexpClass(vm)
+	vm.CurrentClasses["MatchData"] = NewMatchDataClass(vm)
 	vm.CurrentClasses["File"] = NewFileClass(vm)
 	vm.CurrentClasses["Dir"] = NewDirClass(vm)
+	vm.CurrentClasses["Time"] = NewTimeClass(vm)
+	vm.CurrentClasses["Struct"] = NewStructClass(vm)
 	vm.CurrentClasses["Exception"] = NewExceptionClass(vm)
+	vm.CurrentClasses["SystemExit"] = NewSystemExitClass(vm)
+	vm.CurrentClasses["SystemStackError"] = NewSystemStackErrorClass(vm)
 	vm.CurrentClasses["StandardError"] = NewStandardErrorClass(vm)
 	vm.CurrentClasses["ArgumentError"] = NewArgumentErrorClass(vm)
+	vm.CurrentClasses["UncaughtThrowError"] = NewUncaughtThrowErrorClass(vm)
+	vm.CurrentClasses["RuntimeError"] = NewRuntimeErrorClass(vm)
+	vm.CurrentClasses["FrozenError"] = NewFrozenErrorClass(vm)
+	vm.CurrentClasses["IOError"] = NewIOErrorClass(vm)
+	vm.CurrentClasses["LocalJumpError"] = NewLocalJumpErrorClass(vm)
+	vm.CurrentClasses["NameError"] = NewNameErrorClass(vm)
+	vm.CurrentClasses["NoMethodError"] = NewNoMethodErrorClass(vm)
+	vm.CurrentClasses["ScriptError"] = NewScriptErrorClass(vm)
+	vm.CurrentClasses["LoadError"] = NewLoadErrorClass(vm)
+	vm.CurrentClasses["IndexError"] = NewIndexErrorClass(vm)
+	vm.CurrentClasses["TypeError"] = NewTypeErrorClass(vm)
+	vm.CurrentClasses["StopIteration"] = NewStopIterationClass(vm)
+	vm.CurrentClasses["KeyError"] = NewKeyErrorClass(vm)
+	vm.CurrentClasses["ZeroDivisionError"] = NewZeroDivisionErrorClass(vm)
+	vm.CurrentClasses["SystemCallError"] = NewSystemCallErrorClass(vm)
+	vm.CurrentModules["Errno"] = NewErrnoModule(vm)
+	vm.CurrentModules["Math"] = NewMathModule(vm)
+	vm.CurrentModules["ObjectSpace"] = NewObjectSpaceModule(vm)
+	vm.CurrentModules["GC"] = NewGCModule(vm)
 	vm.CurrentClasses["Encoding"] = NewEncodingClass(vm)
 }
 
@@ -230,22 +494,22 @@ func (vm *vm) MustGet(key string) Value {
 }
 
 func (vm *vm) Get(key string) (Value, error) {
-	val, ok := vm.ObjectSpace[key]
+	val, ok := vm.objectSpaceValue(key)
 	if ok {
 		return val, nil
 	}
 
-	val, ok = vm.CurrentGlobals[key]
+	val, ok = vm.global(key)
 	if ok {
 		return val, nil
 	}
 
-	class, ok := vm.CurrentClasses[key]
+	class, ok := vm.class(key)
 	if ok {
 		return class, nil
 	}
 
-	module, ok := vm.CurrentModules[key]
+	module, ok := vm.module(key)
 	if ok {
 		return module, nil
 	}
@@ -253,31 +517,55 @@ func (vm *vm) Get(key string) (Value, error) {
 	return nil, errors.New(fmt.Sprintf("'%s' is undefined", key))
 }
 
+func (vm *vm) class(name string) (Class, bool) {
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	class, ok := vm.CurrentClasses[name]
+	return class, ok
+}
+
+func (vm *vm) module(name string) (Module, bool) {
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	module, ok := vm.CurrentModules[name]
+	return module, ok
+}
+
+func (vm *vm) addClass(name string, class Class) {
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
+	vm.CurrentClasses[name] = class
+}
+
+func (vm *vm) addModule(name string, module Module) {
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
+	vm.CurrentModules[name] = module
+}
+
 func (vm *vm) GetClass(name string) (Class, error) {
-	for keyName, class := range vm.CurrentClasses {
-		if keyName == name {
-			return class, nil
-		}
+	if class, ok := vm.class(name); ok {
+		return class, nil
 	}
 
 	return nil, errors.New(fmt.Sprintf("Class '%s' not found", name))
 }
 
 func (vm *vm) MustGetClass(name string) Class {
-	for keyName, class := range vm.CurrentClasses {
-		if keyName == name {
-			return class
-		}
+	if class, ok := vm.class(name); ok {
+		return class
 	}
 
 	panic(fmt.Sprintf("class '%s' requested, but does not exist", name))
 }
 
 func (vm *vm) GetModule(name string) (Module, error) {
-	for keyName, module := range vm.CurrentModules {
-		if keyName == name {
-			return module, nil
-		}
+	if module, ok := vm.module(name); ok {
+		return module, nil
 	}
 
 	return nil, errors.New(fmt.Sprintf("Module '%s' not found", name))
@@ -293,58 +581,283 @@ func (vm *vm) MustGetModule(name string) Module {
 }
 
 func (vm *vm) Set(key string, value Value) {
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
 	vm.ObjectSpace[key] = value
 }
 
+func (vm *vm) objectSpaceValue(key string) (Value, bool) {
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	value, ok := vm.ObjectSpace[key]
+	return value, ok
+}
+
+func (vm *vm) global(name string) (Value, bool) {
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	value, ok := vm.CurrentGlobals[name]
+	return value, ok
+}
+
+// Symbols is a copy of the symbol table, which is safe to read while other
+// goroutines keep running code (and interning symbols) on the vm
 func (vm *vm) Symbols() map[string]Value {
-	return vm.CurrentSymbols
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	return copyTable(vm.CurrentSymbols)
 }
 
+// Globals is a copy of the global variables, like Symbols
 func (vm *vm) Globals() map[string]Value {
-	return vm.CurrentGlobals
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	return copyTable(vm.CurrentGlobals)
 }
 
+func copyTable(table map[string]Value) map[string]Value {
+	copied := make(map[string]Value, len(table))
+	for name, value := range table {
+		copied[name] = value
+	}
+
+	return copied
+}
+
+// Classes returns a copy of the table of classes, which the program may add to
 func (vm *vm) Classes() map[string]Class {
-	return vm.CurrentClasses
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	classes := make(map[string]Class, len(vm.CurrentClasses))
+	for name, class := range vm.CurrentClasses {
+		classes[name] = class
+	}
+
+	return classes
 }
 
+// Modules returns a copy of the table of modules, which the program may add to
 func (vm *vm) Modules() map[string]Module {
-	return vm.CurrentModules
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
+	modules := make(map[string]Module, len(vm.CurrentModules))
+	for name, module := range vm.CurrentModules {
+		modules[name] = module
+	}
+
+	return modules
+}
+
+// inspectToStdout builds p and pp, which write each of their arguments to
+// $stdout as inspected by inspect, and return them
+func (vm *vm) inspectToStdout(inspect func(Value) (string, error)) func(Value, Block, ...Value) (Value, error) {
+	return func(self Value, block Block, args ...Value) (Value, error) {
+		for _, arg := range args {
+			inspected, err := inspect(arg)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = vm.sendToStdout("write", NewString(inspected+"\n", vm))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch len(args) {
+		case 0:
+			return vm.SingletonWithName("nil"), nil
+		case 1:
+			return args[0], nil
+		default:
+			array, _ := vm.CurrentClasses["Array"].New(vm)
+			for _, arg := range args {
+				array.(*Array).Append(arg)
+			}
+			return array, nil
+		}
+	}
+}
+
+// sendToStdout calls the named method on whichever object $stdout refers to
+func (vm *vm) sendToStdout(methodName string, args ...Value) (Value, error) {
+	return vm.sendToStream("stdout", methodName, args...)
+}
+
+// sends the message to whichever IO the global ($stdout or $stderr) refers to
+func (vm *vm) sendToStream(global, methodName string, args ...Value) (Value, error) {
+	stream, _ := vm.global(global)
+	method := stream.Method(methodName)
+	if method == nil {
+		return nil, NewNoMethodError(methodName, stream, vm)
+	}
+
+	_, err := method.Execute(stream, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return vm.SingletonWithName("nil"), nil
+}
+
+// standardStream writes to whichever file os.Stdout (or os.Stderr) refers to
+// at the time of the write, so that they can be swapped out after the VM starts
+type standardStream func() *os.File
+
+func (stream standardStream) Write(bytes []byte) (int, error) {
+	return stream().Write(bytes)
+}
+
+func writerOrDefault(writer io.Writer, defaultStream standardStream) io.Writer {
+	if writer != nil {
+		return writer
+	}
+
+	return defaultStream
+}
+
+func (vm *vm) maxStackDepth() int {
+	if vm.options.MaxStackDepth > 0 {
+		return vm.options.MaxStackDepth
+	}
+
+	return DefaultMaxStackDepth
+}
+
+func (vm *vm) maxTailCalls() int {
+	if vm.options.MaxTailCalls > 0 {
+		return vm.options.MaxTailCalls
+	}
+
+	return DefaultMaxTailCalls
 }
 
 type ParseError struct {
 	Filename string
+
+	// 1-based position of the token the parser gave up on
+	Line       int
+	Column     int
+	Message    string
+	SourceLine string
+
+	Unexpected string
+	Expected   []string
 }
 
-func NewParseError(filename string) *ParseError {
-	return &ParseError{Filename: filename}
+func NewParseError(filename string, syntaxError *parser.SyntaxError) *ParseError {
+	err := &ParseError{Filename: filename, Message: "syntax error"}
+	if syntaxError != nil {
+		err.Line = syntaxError.Line
+		err.Column = syntaxError.Column
+		err.Message = syntaxError.Message
+		err.SourceLine = syntaxError.SourceLine
+		err.Unexpected = syntaxError.Unexpected
+		err.Expected = syntaxError.Expected
+	}
+
+	return err
 }
 
 func (err *ParseError) Error() string {
-	return "parse error"
+	if err.Line == 0 {
+		return fmt.Sprintf("%s: parse error", err.Filename)
+	}
+
+	padding := strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		return ' '
+	}, err.SourceLine[:err.Column-1])
+
+	return fmt.Sprintf("%s:%d:%d: %s\n%s\n%s^", err.Filename, err.Line, err.Column, err.Message, err.SourceLine, padding)
 }
 
-func (vm *vm) Run(input string) (Value, error) {
+// the parser keeps what it parsed in package variables, so every vm takes
+// turns to use it
+var parsing sync.Mutex
+
+func (vm *vm) parse(input string) ([]ast.Node, error) {
+	parsing.Lock()
+	defer parsing.Unlock()
+
 	parser.Reset()
 
 	lexer := parser.NewLexer(input)
 	result := parser.RubyParse(lexer)
 	if result != 0 {
-		return nil, NewParseError(vm.currentFilename)
+		return nil, NewParseError(vm.currentFilename, lexer.(*parser.ConcreteStatefulRubyLexer).SyntaxError())
 	}
 
-	main := vm.ObjectSpace["main"]
+	return parser.Statements, nil
+}
+
+func (vm *vm) Run(input string) (Value, error) {
+	vm.running.Lock()
+	defer vm.running.Unlock()
+
+	return vm.run(input)
+}
+
+// run is Run for a program that is already running, like a required file
+func (vm *vm) run(input string) (Value, error) {
+	statements, err := vm.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	main := vm.MustGet("main")
 	vm.stack.Unshift("main", vm.currentFilename, 0)
 	defer vm.stack.Shift()
 
 	vm.localVariableStack.Unshift()
 	defer vm.localVariableStack.Shift()
-	return vm.executeWithContext(main, parser.Statements...)
+
+	value, err := returnedValue(vm.executeWithContext(main, statements...))
+	if _, exiting := err.(*ImmediateExit); exiting {
+		vm.exitCallbacks = nil
+	}
+
+	return value, err
 }
 
+// Exit runs the blocks registered with at_exit, most recent first
+// an error in one block is reported on $stderr, and the rest still run
 func (vm *vm) Exit() {
-	for _, block := range vm.exitCallbacks {
-		block.Call()
+	vm.running.Lock()
+	defer vm.running.Unlock()
+
+	for len(vm.exitCallbacks) > 0 {
+		last := len(vm.exitCallbacks) - 1
+		block := vm.exitCallbacks[last]
+		vm.exitCallbacks = vm.exitCallbacks[:last]
+
+		_, err := block.Call()
+		if err != nil {
+			vm.reportError(err)
+		}
+	}
+}
+
+// warn writes a warning on $stderr, in the form the ruby interpreter uses
+func (vm *vm) warn(lineNumber int, message string) error {
+	warning := fmt.Sprintf("%s:%d: warning: %s", vm.currentFilename, lineNumber+1, message)
+	_, err := vm.sendToStream("stderr", "puts", NewString(warning, vm))
+	return err
+}
+
+func (vm *vm) reportError(err error) {
+	stderr, _ := vm.global("stderr")
+	if puts := stderr.Method("puts"); puts != nil {
+		puts.Execute(stderr, nil, NewString(err.Error(), vm))
 	}
 }
 
@@ -353,21 +866,38 @@ func (vm *vm) executeWithContext(context Value, statements ...ast.Node) (Value,
 		returnValue Value
 		returnErr   error
 	)
+	// an empty block called over and over is interruptible too
+	if len(statements) == 0 {
+		returnErr = vm.interrupted()
+	}
+
 	for _, statement := range statements {
 		if returnErr != nil {
 			break
 		}
+		if returnErr = vm.interrupted(); returnErr != nil {
+			break
+		}
 
 		switch statement.(type) {
 		case ast.Self:
 			returnValue = context
 		case ast.Return:
-			returnNode := statement.(ast.Return)
-			return vm.executeWithContext(context, returnNode.Value)
+			value := vm.SingletonWithName("nil")
+			if returnNode := statement.(ast.Return); returnNode.Value != nil {
+				var err error
+				value, err = vm.executeWithContext(context, returnNode.Value)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return value, &returnSignal{value: value}
 		case ast.IfBlock:
 			returnValue, returnErr = interpretIfStatementInContext(vm, statement.(ast.IfBlock), context)
 		case ast.Alias:
 			returnValue, returnErr = interpretAliasInContext(vm, statement.(ast.Alias), context)
+		case ast.Undef:
+			returnValue, returnErr = interpretUndefInContext(vm, statement.(ast.Undef), context)
 		case ast.ModuleDecl:
 			returnValue, returnErr = interpretModuleDeclarationInContext(vm, statement.(ast.ModuleDecl), context)
 		case ast.ClassDecl:
@@ -375,27 +905,34 @@ func (vm *vm) executeWithContext(context Value, statements ...ast.Node) (Value,
 		case ast.FuncDecl:
 			returnValue, returnErr = interpretMethodDeclarationInContext(vm, statement.(ast.FuncDecl), context)
 		case ast.Nil:
-			returnValue = vm.singletons["nil"]
+			returnValue = vm.SingletonWithName("nil")
 		case ast.SimpleString:
 			returnValue = NewString(statement.(ast.SimpleString).Value, vm)
+			if statement.(ast.SimpleString).Frozen {
+				returnValue.Freeze()
+			}
 		case ast.InterpolatedString:
 			returnValue, returnErr = interpretDoubleQuotedStringInContext(vm, statement.(ast.InterpolatedString), context)
 		case ast.Boolean:
 			if statement.(ast.Boolean).Value {
-				returnValue = vm.singletons["true"]
+				returnValue = vm.SingletonWithName("true")
 			} else {
-				returnValue = vm.singletons["false"]
+				returnValue = vm.SingletonWithName("false")
 			}
 		case ast.GlobalVariable:
-			var ok bool
-			returnValue, ok = vm.CurrentGlobals[statement.(ast.GlobalVariable).Name]
-			if !ok {
-				returnValue = vm.singletons["nil"]
-			}
+			returnValue = vm.globalVariable(statement.(ast.GlobalVariable).Name)
 		case ast.ConstantInt:
 			returnValue = NewFixnum(statement.(ast.ConstantInt).Value, vm)
+		case ast.ConstantUint:
+			returnValue = NewInteger(new(big.Int).SetUint64(statement.(ast.ConstantUint).Value), vm)
+		case ast.ConstantBigInt:
+			returnValue = NewInteger(statement.(ast.ConstantBigInt).Value, vm)
 		case ast.ConstantFloat:
 			returnValue = NewFloat(statement.(ast.ConstantFloat).Value, vm)
+		case ast.ConstantRational:
+			returnValue = NewRational(statement.(ast.ConstantRational).Value, vm)
+		case ast.ConstantComplex:
+			returnValue = NewComplex(statement.(ast.ConstantComplex).Value, vm)
 		case ast.Symbol:
 			returnValue = interpretSymbol(vm, statement.(ast.Symbol))
 		case ast.BareReference:
@@ -404,7 +941,7 @@ func (vm *vm) executeWithContext(context Value, statements ...ast.Node) (Value,
 			returnValue, returnErr = interpretCallExpressionInContext(vm, statement.(ast.CallExpression), context)
 		case ast.Block:
 			astBlock := statement.(ast.Block)
-			block := NewBlock(context, astBlock.Args, astBlock.Body, vm)
+			block := NewBlock(context, astBlock.Args, astBlock.Body, vm, vm)
 			returnValue = block.(Value)
 
 		case ast.Assignment:
@@ -418,6 +955,8 @@ func (vm *vm) executeWithContext(context Value, statements ...ast.Node) (Value,
 			returnValue = NewFixnum(lineNumber, vm)
 		case ast.Begin:
 			returnValue, returnErr = interpretBeginInContext(vm, statement.(ast.Begin), context)
+		case ast.RescueModifier:
+			returnValue, returnErr = interpretRescueModifierInContext(vm, statement.(ast.RescueModifier), context)
 		case ast.Array:
 			returnValue, returnErr = interpretArrayInContext(vm, statement.(ast.Array), context)
 		case ast.Hash:
@@ -432,6 +971,15 @@ func (vm *vm) executeWithContext(context Value, statements ...ast.Node) (Value,
 			returnValue, returnErr = interpretConstantInContext(vm, statement.(ast.Constant), context)
 		case ast.Negation:
 			returnValue, returnErr = interpretNegationInContext(vm, statement.(ast.Negation), context)
+		case ast.Negative:
+			returnValue, returnErr = interpretNegativeInContext(vm, statement.(ast.Negative), context)
+		case ast.Group:
+			returnValue, returnErr = vm.executeWithContext(context, statement.(ast.Group).Body...)
+			if returnValue == nil && returnErr == nil {
+				returnValue = vm.SingletonWithName("nil")
+			}
+		case ast.Range:
+			returnValue, returnErr = interpretRangeInContext(vm, statement.(ast.Range), context)
 		case ast.Regex:
 			returnValue, returnErr = interpretRegexpInContext(vm, statement.(ast.Regex), context)
 		case ast.WeakLogicalAnd:
@@ -442,6 +990,10 @@ func (vm *vm) executeWithContext(context Value, statements ...ast.Node) (Value,
 			returnValue, returnErr = interpretDefinedKeyword(vm, statement.(ast.Defined), context)
 		case ast.InstanceVariable:
 			returnValue, returnErr = interpretInstanceVariableInContext(vm, statement.(ast.InstanceVariable), context)
+		case ast.ClassVariable:
+			returnValue, returnErr = interpretClassVariableInContext(vm, statement.(ast.ClassVariable), context)
+		case ast.Loop:
+			returnValue, returnErr = interpretLoopInContext(vm, statement.(ast.Loop), context)
 		case ast.SwitchStatement:
 			returnValue, returnErr = interpretSwitchStatement(vm, statement.(ast.SwitchStatement), context)
 		case ast.SuperclassMethodImplCall:
@@ -456,7 +1008,44 @@ func (vm *vm) executeWithContext(context Value, statements ...ast.Node) (Value,
 
 // ClassProvider
 func (vm *vm) ClassWithName(name string) Class {
-	return vm.CurrentClasses[name]
+	class, ok := vm.class(name)
+	if ok {
+		return class
+	}
+
+	// namespaced classes (eg: Errno::ENOENT) are constants of their module
+	parts := strings.Split(name, "::")
+	if len(parts) < 2 {
+		return nil
+	}
+
+	var namespace Module
+	if module, ok := vm.module(parts[0]); ok {
+		namespace = module
+	} else if class, ok := vm.class(parts[0]); ok {
+		namespace = class
+	} else {
+		return nil
+	}
+
+	for _, part := range parts[1:] {
+		constant, err := namespace.Constant(part)
+		if err != nil {
+			return nil
+		}
+
+		namespace, ok = constant.(Module)
+		if !ok {
+			return nil
+		}
+	}
+
+	class, _ = namespace.(Class)
+	return class
+}
+
+func (vm *vm) MethodCache() *MethodCache {
+	return vm.methodCache
 }
 
 // ArgEvaluator
@@ -476,52 +1065,72 @@ func (vm *vm) EvaluateBlockWithArgsInContext(
 		vm.localVariableStack.Store(arg.Name, arg.Value)
 	}
 
-	return vm.executeWithContext(context, statements...)
+	return returnedValue(vm.executeWithContext(context, statements...))
 }
 
 // SingletonProvider
 func (vm *vm) SingletonWithName(name string) Value {
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
 	return vm.singletons[name]
 }
 
-func (vm *vm) NewSingletonWithName(name string, value Value) {
+// NewSingletonWithName registers value under name, unless another goroutine
+// registered a singleton with that name first, and returns the one that won
+func (vm *vm) NewSingletonWithName(name string, value Value) Value {
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
+	if existing, ok := vm.singletons[name]; ok {
+		return existing
+	}
+
 	vm.singletons[name] = value
+	return value
 }
 
 func (vm *vm) SymbolWithName(name string) Value {
+	vm.tables.RLock()
+	defer vm.tables.RUnlock()
+
 	return vm.CurrentSymbols[name]
 }
 
-func (vm *vm) AddSymbol(val Value) {
+// AddSymbol interns the symbol, and returns the symbol with its name that
+// the program uses, which is another one if a goroutine interned it first
+func (vm *vm) AddSymbol(val Value) Value {
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
+
 	symbol := val.(*SymbolValue)
+	if existing, ok := vm.CurrentSymbols[symbol.Name()]; ok {
+		return existing
+	}
+
 	vm.CurrentSymbols[symbol.Name()] = symbol
+	return symbol
 }
 
 // Evaluator
 func (vm *vm) EvaluateStringInContext(input string, context Value) (Value, error) {
-	parser.Reset()
-
-	lexer := parser.NewLexer(input)
-	result := parser.RubyParse(lexer)
-	if result != 0 {
-		return nil, NewParseError(vm.currentFilename)
+	statements, err := vm.parse(input)
+	if err != nil {
+		return nil, err
 	}
 
-	return vm.executeWithContext(context, parser.Statements...)
+	return returnedValue(vm.executeWithContext(context, statements...))
 }
 
 func (vm *vm) EvaluateStringInContextAndNewStack(input string, context Value) (Value, error) {
-	parser.Reset()
-
-	lexer := parser.NewLexer(input)
-	result := parser.RubyParse(lexer)
-	if result != 0 {
-		return nil, NewParseError(vm.currentFilename)
+	statements, err := vm.parse(input)
+	if err != nil {
+		return nil, err
 	}
 
 	vm.localVariableStack.Unshift()
 	defer vm.localVariableStack.Shift()
-	return vm.executeWithContext(context, parser.Statements...)
+	return returnedValue(vm.executeWithContext(context, statements...))
 }
 
 // StackProvider
@@ -529,12 +1138,12 @@ func (vm *vm) CurrentStack() string {
 	return vm.stack.String()
 }
 
-func (vm *vm) ShiftStackFrame() {
-	vm.stack.Shift()
-}
+// GlobalProvider
+func (vm *vm) SetGlobal(name string, value Value) {
+	vm.tables.Lock()
+	defer vm.tables.Unlock()
 
-func (vm *vm) UnshiftStackFrame(methodName string, filename string, lineNumber int) {
-	vm.stack.Unshift(methodName, filename, lineNumber)
+	vm.CurrentGlobals[name] = value
 }
 
 // MethodProvider
@@ -559,6 +1168,12 @@ func (vm *vm) SingletonProvider() SingletonProvider {
 func (vm *vm) StackProvider() StackProvider {
 	return vm
 }
+func (vm *vm) GlobalProvider() GlobalProvider {
+	return vm
+}
+func (vm *vm) ObjectSpaceProvider() ObjectSpaceProvider {
+	return &vm.liveObjects
+}
 func (vm *vm) MethodProvider() MethodProvider {
 	return vm
 }
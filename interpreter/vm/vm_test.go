@@ -1,10 +1,13 @@
 package vm_test
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
+	"time"
 
 	. "github.com/grubby/grubby/interpreter/vm"
 	. "github.com/grubby/grubby/interpreter/vm/builtins"
@@ -47,6 +50,222 @@ end
 			})
 		})
 
+		Describe("#dup", func() {
+			It("copies the instance variables into a new instance", func() {
+				_, err := vm.Run(`
+class Dupper
+  def initialize(name)
+    @name = name
+  end
+
+  def rename(name)
+    @name = name
+  end
+
+  def name
+    @name
+  end
+end
+
+original = Dupper.new('pseudoporphyritic')
+copy = original.dup
+copy.rename('unrefracting')
+`)
+				Expect(err).ToNot(HaveOccurred())
+
+				original := vm.MustGet("original")
+				copy := vm.MustGet("copy")
+				Expect(copy).ToNot(BeIdenticalTo(original))
+				Expect(copy.Class()).To(Equal(original.Class()))
+				Expect(original.GetInstanceVariable("name")).To(EqualRubyString("pseudoporphyritic"))
+				Expect(copy.GetInstanceVariable("name")).To(EqualRubyString("unrefracting"))
+			})
+
+			It("copies the members of an array", func() {
+				_, err := vm.Run("original = ['a']; copy = original.dup; copy.unshift('b')")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(vm.MustGet("original").(*Array).Members()).To(HaveLen(1))
+				Expect(vm.MustGet("copy").(*Array).Members()).To(HaveLen(2))
+			})
+
+			It("copies strings", func() {
+				_, err := vm.Run("original = 'pre'; copy = original.dup; copy << 'fix'")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(vm.MustGet("original")).To(EqualRubyString("pre"))
+				Expect(vm.MustGet("copy")).To(EqualRubyString("prefix"))
+			})
+
+			It("returns the receiver for immediate values", func() {
+				value, err := vm.Run("5.dup")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal(vm.SingletonWithName("5")))
+			})
+
+			It("does not copy singleton methods or frozen state", func() {
+				original, err := vm.Run("original = 'cold'.freeze")
+				Expect(err).ToNot(HaveOccurred())
+				original.AddMethod(NewNativeMethod("only_on_original", vm, func(self Value, block Block, args ...Value) (Value, error) {
+					return self, nil
+				}))
+
+				copy, err := vm.Run("original.dup")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(copy.Method("only_on_original")).To(BeNil())
+
+				_, err = vm.Run("original.dup << 'er'")
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Describe("#clone", func() {
+			It("copies singleton methods and frozen state", func() {
+				original, err := vm.Run("original = 'cold'.freeze")
+				Expect(err).ToNot(HaveOccurred())
+				original.AddMethod(NewNativeMethod("only_on_original", vm, func(self Value, block Block, args ...Value) (Value, error) {
+					return self, nil
+				}))
+
+				copy, err := vm.Run("original.clone")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(copy).ToNot(BeIdenticalTo(original))
+				Expect(copy).To(EqualRubyString("cold"))
+				Expect(copy.Method("only_on_original")).ToNot(BeNil())
+
+				_, err = vm.Run("original.clone << 'er'")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("#freeze", func() {
+			It("returns the receiver, marking it as frozen", func() {
+				_, err := vm.Run("obj = Object.new; frozen = obj.freeze; before = Object.new.frozen?; after = obj.frozen?")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(vm.MustGet("frozen")).To(BeIdenticalTo(vm.MustGet("obj")))
+				Expect(vm.MustGet("before")).To(Equal(vm.SingletonWithName("false")))
+				Expect(vm.MustGet("after")).To(Equal(vm.SingletonWithName("true")))
+			})
+
+			It("prevents arrays from being modified", func() {
+				_, err := vm.Run("[1].freeze.push(2)")
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&ExceptionValue{}))
+				Expect(err.(*ExceptionValue).Class()).To(Equal(vm.MustGetClass("FrozenError")))
+			})
+
+			It("prevents hashes from being modified", func() {
+				_, err := vm.Run("h = {}; h.freeze; h[:a] = 1")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("FrozenError: can't modify frozen Hash"))
+			})
+
+			It("prevents instance variables from being assigned", func() {
+				_, err := vm.Run(`
+class Thermometer
+  def set(degrees)
+    @degrees = degrees
+  end
+end
+
+t = Thermometer.new
+t.set(10)
+t.freeze
+t.set(-40)
+`)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("FrozenError: can't modify frozen Thermometer"))
+			})
+
+			It("is always true for immediate values", func() {
+				for _, immediate := range []string{"5", ":sym", "nil", "true", "false", "1.5"} {
+					value, err := vm.Run(immediate + ".frozen?")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(value).To(Equal(vm.SingletonWithName("true")), immediate)
+				}
+			})
+		})
+
+		Describe("#tap", func() {
+			It("yields the receiver and returns it", func() {
+				value, err := vm.Run("tapped = nil; 'semiovate'.tap { |s| tapped = s + '!' }")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(value).To(EqualRubyString("semiovate"))
+				Expect(vm.MustGet("tapped")).To(EqualRubyString("semiovate!"))
+			})
+
+			It("raises a LocalJumpError without a block", func() {
+				_, err := vm.Run("5.tap")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("LocalJumpError: no block given (yield)"))
+			})
+		})
+
+		Describe("#then and #yield_self", func() {
+			It("return the result of the block", func() {
+				value, err := vm.Run("5.then { |n| n + 1 }")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal(vm.SingletonWithName("6")))
+
+				value, err = vm.Run("'a'.yield_self { |s| s + 'b' }")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(EqualRubyString("ab"))
+			})
+		})
+
+		Describe("#inspect", func() {
+			It("shows the class and instance variables of an object", func() {
+				value, err := vm.Run(`
+class Hydrant
+  def initialize
+    @pressure = 12
+    @color = 'red'
+  end
+end
+
+Hydrant.new.inspect
+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value.(*StringValue).RawString()).To(MatchRegexp(`^#<Hydrant:0x[0-9a-f]+ @pressure=12, @color="red">$`))
+			})
+
+			It("does not recurse into an object that references itself", func() {
+				value, err := vm.Run(`
+class Ouroboros
+  def initialize
+    @tail = self
+  end
+end
+
+Ouroboros.new.inspect
+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value.(*StringValue).RawString()).To(MatchRegexp(`^#<Ouroboros:(0x[0-9a-f]+) @tail=#<Ouroboros:(0x[0-9a-f]+) \.\.\.>>$`))
+			})
+
+			It("shows literals the way they are written", func() {
+				value, err := vm.Run(`[1, 'two', :three, nil, {:four => [5]}].inspect`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(EqualRubyString(`[1, "two", :three, nil, {:four=>[5]}]`))
+			})
+
+			It("uses the inspect methods defined by user classes", func() {
+				value, err := vm.Run(`
+class Hydrant
+  def inspect
+    'a hydrant'
+  end
+end
+
+[Hydrant.new].inspect
+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(EqualRubyString("[a hydrant]"))
+			})
+		})
+
 		Describe("#=~", func() {
 			It("returns nil", func() {
 				value, err := vm.Run("5 =~ 12")
@@ -56,6 +275,113 @@ end
 		})
 	})
 
+	Describe("resetting the vm", func() {
+		var stringClass Class
+
+		BeforeEach(func() {
+			stringClass = vm.MustGetClass("String")
+
+			_, err := vm.Run(`
+favorite = 'kumquat'
+$counter = 5
+:a_new_symbol
+class Widget; end
+module Gadgets; end
+class String
+  def shout
+    self + '!'
+  end
+
+  def strip
+    'broken'
+  end
+end
+Math::TAU = 6
+$LOAD_PATH << '/some/other/lib'
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm.Reset()
+		})
+
+		It("puts back the builtin classes it created rather than create them again", func() {
+			Expect(vm.MustGetClass("String")).To(BeIdenticalTo(stringClass))
+
+			_, err := vm.MustGet("Math").(Module).Constant("TAU")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("forgets the variables, globals, classes and modules defined", func() {
+			_, err := vm.Get("favorite")
+			Expect(err).To(HaveOccurred())
+
+			Expect(vm.Globals()).ToNot(HaveKey("counter"))
+			Expect(vm.Symbols()).ToNot(HaveKey("a_new_symbol"))
+			Expect(vm.Classes()).ToNot(HaveKey("Widget"))
+			Expect(vm.Modules()).ToNot(HaveKey("Gadgets"))
+		})
+
+		It("does not keep methods added to the builtin classes", func() {
+			_, err := vm.Run("'quiet'.shout")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("undefined method 'shout'"))
+		})
+
+		It("seeds the load path and the builtin classes again", func() {
+			value, err := vm.Run("$LOAD_PATH.include?('/some/other/lib')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("false")))
+
+			value, err = vm.Run("'  still works '.strip")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("still works"))
+		})
+	})
+
+	Describe("statements separated by semicolons", func() {
+		It("are each evaluated", func() {
+			value, err := vm.Run("a = 1; b = 2; a + b")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(3, vm)))
+		})
+
+		It("work inside of method bodies", func() {
+			value, err := vm.Run("def sum; a = 1; b = 2; a + b; end; sum")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(3, vm)))
+		})
+	})
+
+	Describe("parse errors", func() {
+		It("include the position of the offending token", func() {
+			_, err := vm.Run("x = 1\nputs(x ]\n")
+			Expect(err).To(HaveOccurred())
+
+			parseError, ok := err.(*ParseError)
+			Expect(ok).To(BeTrue())
+			Expect(parseError.Line).To(Equal(2))
+			Expect(parseError.Column).To(Equal(8))
+			Expect(parseError.SourceLine).To(Equal("puts(x ]"))
+		})
+
+		It("describe the unexpected token and what was expected instead", func() {
+			_, err := vm.Run("puts(x ]")
+			Expect(err).To(HaveOccurred())
+
+			parseError := err.(*ParseError)
+			Expect(parseError.Unexpected).To(Equal("']'"))
+			Expect(parseError.Expected).To(ContainElement("')'"))
+			Expect(parseError.Message).To(HavePrefix("syntax error, unexpected ']', expecting ')'"))
+		})
+
+		It("point at the offending token with a caret", func() {
+			_, err := vm.Run("puts(x ]")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("fake-irb-under-test:1:8: syntax error"))
+			Expect(err.Error()).To(HaveSuffix("\nputs(x ]\n       ^"))
+		})
+	})
+
 	Describe("creating a simple function", func() {
 		BeforeEach(func() {
 			_, err := vm.Run(`
@@ -175,12 +501,374 @@ test(5)
 		})
 	})
 
+	Describe("$stdout and $stderr", func() {
+		It("are IO objects", func() {
+			stdout, err := vm.Run("$stdout")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(BeAssignableToTypeOf(&IOValue{}))
+			Expect(stdout.Class()).To(Equal(vm.MustGetClass("IO")))
+
+			stderr, err := vm.Run("$stderr")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stderr).To(BeAssignableToTypeOf(&IOValue{}))
+		})
+
+		It("can be written to with puts", func() {
+			output := SwapStdout(func() {
+				_, err := vm.Run("$stdout.puts 'dyschroia-ashine'")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Expect(output).To(Equal("dyschroia-ashine\n"))
+		})
+
+		It("can be written to with print and write", func() {
+			output := SwapStdout(func() {
+				written, err := vm.Run("$stdout.print 'foo', 'bar'; $stdout.write(\"baz\\n\")")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(written.String()).To(Equal("4"))
+			})
+
+			Expect(output).To(Equal("foobarbaz\n"))
+		})
+	})
+
+	Describe("writing output", func() {
+		var output, errorOutput *bytes.Buffer
+
+		BeforeEach(func() {
+			output = &bytes.Buffer{}
+			errorOutput = &bytes.Buffer{}
+			vm = NewVMWithOptions(filepath.Join(os.Getenv("HOME"), ".grubby"), "fake-irb-under-test", VMOptions{
+				Stdout: output,
+				Stderr: errorOutput,
+			})
+		})
+
+		It("goes to the error writer provided for $stderr", func() {
+			_, err := vm.Run("$stderr.puts 'oops'; STDERR.print 'again'")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(errorOutput.String()).To(Equal("oops\nagain"))
+			Expect(output.String()).To(BeEmpty())
+		})
+
+		It("goes to the writer provided when constructing the VM", func() {
+			_, err := vm.Run("puts 'unbeloved'; print 'sleety'; p 'unvalued'")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(output.String()).To(Equal("unbeloved\nsleety\"unvalued\"\n"))
+		})
+
+		It("writes the formatted string given to printf", func() {
+			_, err := vm.Run(`printf("%-5s|%3d\n", 'ab', 7)`)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(output.String()).To(Equal("ab   |  7\n"))
+		})
+
+		It("raises an ArgumentError when printf is given too few arguments", func() {
+			_, err := vm.Run(`printf("%s %s", 'one')`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: too few arguments"))
+			Expect(output.String()).To(BeEmpty())
+		})
+
+		It("writes each of the messages given to warn on its own line of $stderr", func() {
+			value, err := vm.Run("warn('careful', 'very careful')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+
+			Expect(errorOutput.String()).To(Equal("careful\nvery careful\n"))
+			Expect(output.String()).To(BeEmpty())
+		})
+
+		It("writes nothing when warn is given no messages", func() {
+			_, err := vm.Run("warn")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errorOutput.String()).To(BeEmpty())
+		})
+
+		It("warns on whichever IO $stderr was set to", func() {
+			_, err := vm.Run("$stderr = $stdout; warn 'redirected'")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(Equal("redirected\n"))
+			Expect(errorOutput.String()).To(BeEmpty())
+		})
+
+		It("writes the inspected values given to p", func() {
+			_, err := vm.Run("p([1, nil, 'two'])")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(output.String()).To(Equal("[1, nil, \"two\"]\n"))
+		})
+
+		It("writes small values given to pp on one line, like p", func() {
+			value, err := vm.Run("pp({:a => [1, 2]})")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("{:a=>[1, 2]}"))
+
+			Expect(output.String()).To(Equal("{:a=>[1, 2]}\n"))
+		})
+
+		It("spreads wide values given to pp over several lines", func() {
+			_, err := vm.Run(`pp({:name => "a" * 30, :tags => ["x" * 30, "y" * 30], :sizes => [1, 2]})`)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(output.String()).To(Equal(`{:name=>"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+ :tags=>["xxxxxxxxxxxxxxxxxxxxxxxxxxxxxx", "yyyyyyyyyyyyyyyyyyyyyyyyyyyyyy"],
+ :sizes=>[1, 2]}
+`))
+		})
+
+		It("writes [...] for an array that pp finds inside itself", func() {
+			_, err := vm.Run(`list = ["q" * 50, "r" * 50]; list << list; pp(list)`)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(output.String()).To(Equal(`["qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+ "rrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrr",
+ [...]]
+`))
+		})
+
+		It("reports errors raised in at_exit blocks without skipping the others", func() {
+			_, err := vm.Run(`
+ran = false
+at_exit do
+  ran = true
+end
+at_exit { [1] + 2 }
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm.Exit()
+			Expect(vm.MustGet("ran")).To(Equal(vm.SingletonWithName("true")))
+			Expect(errorOutput.String()).To(ContainSubstring("TypeError: no implicit conversion of Fixnum into Array"))
+		})
+
+		It("puts each member of an array on its own line, flattening nested arrays", func() {
+			_, err := vm.Run("puts([1, [2, [nil, 'three']]], 4)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(Equal("1\n2\n\nthree\n4\n"))
+		})
+
+		It("puts a blank line for an empty array", func() {
+			_, err := vm.Run("puts([])")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(Equal("\n"))
+		})
+
+		It("puts a recursive array as [...]", func() {
+			_, err := vm.Run("a = [1]; a << a; puts(a)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(Equal("1\n[...]\n"))
+		})
+
+		It("goes through whatever $stdout refers to", func() {
+			_, err := vm.Run(`
+class Recorder
+  def puts(*args)
+    @recorded = args
+  end
+
+  def recorded
+    @recorded
+  end
+end
+
+$stdout = Recorder.new
+puts 'dehonestate'
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.String()).To(BeEmpty())
+
+			recorded, err := vm.Run("$stdout.recorded")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorded.(*Array).Members()).To(HaveLen(1))
+			Expect(recorded.(*Array).Members()[0]).To(EqualRubyString("dehonestate"))
+		})
+	})
+
+	Describe("$PROGRAM_NAME", func() {
+		It("is the name of the running script", func() {
+			name, err := vm.Run("$PROGRAM_NAME")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(EqualRubyString("fake-irb-under-test"))
+
+			name, err = vm.Run("$0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(EqualRubyString("fake-irb-under-test"))
+		})
+	})
+
+	Describe("Kernel#gets", func() {
+		It("reads a line from stdin, including the newline", func() {
+			SwapStdin("first line\nsecond line\n", func() {
+				first, err := vm.Run("gets")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(first).To(EqualRubyString("first line\n"))
+
+				second, err := vm.Run("gets")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(second).To(EqualRubyString("second line\n"))
+			})
+		})
+
+		It("sets $_ to the last line read", func() {
+			SwapStdin("gerontic-unbusied\n", func() {
+				_, err := vm.Run("gets")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(vm.MustGet("_")).To(EqualRubyString("gerontic-unbusied\n"))
+			})
+		})
+
+		It("can be chomped", func() {
+			SwapStdin("read me\r\n", func() {
+				line, err := vm.Run("gets.chomp")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(line).To(EqualRubyString("read me"))
+			})
+		})
+
+		It("returns nil at EOF", func() {
+			SwapStdin("no trailing newline", func() {
+				last, err := vm.Run("gets")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(last).To(EqualRubyString("no trailing newline"))
+
+				eof, err := vm.Run("gets")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(eof).To(Equal(vm.SingletonWithName("nil")))
+				Expect(vm.MustGet("_")).To(Equal(vm.SingletonWithName("nil")))
+			})
+		})
+	})
+
+	Describe("Kernel#sprintf and Kernel#format", func() {
+		It("format their arguments", func() {
+			value, err := vm.Run(`sprintf("%d-%s", 1, "x")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("1-x"))
+
+			value, err = vm.Run(`format("%-5s|%+d|%.1f|%%", "ab", 3, 2)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("ab   |+3|2.0|%"))
+		})
+	})
+
+	Describe("the Kernel conversion functions", func() {
+		It("converts strings to integers strictly with Integer()", func() {
+			value, err := vm.Run(`Integer("42")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(42, vm)))
+
+			value, err = vm.Run(`Integer("0x1A")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(26, vm)))
+
+			value, err = vm.Run(`Integer("ff", 16)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(255, vm)))
+
+			_, err = vm.Run(`Integer("abc")`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`ArgumentError: invalid value for Integer(): "abc"`))
+		})
+
+		It("converts strings too large for a Fixnum to a Bignum with Integer()", func() {
+			value, err := vm.Run(`Integer("18446744073709551616")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(BeAssignableToTypeOf(&BignumValue{}))
+			Expect(value.String()).To(Equal("18446744073709551616"))
+
+			value, err = vm.Run(`Integer("-1_0000_0000_0000_0000_0000")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("-100000000000000000000"))
+
+			value, err = vm.Run(`Integer("ffffffffffffffffff", 16)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("4722366482869645213695"))
+		})
+
+		It("converts strings to floats strictly with Float()", func() {
+			value, err := vm.Run(`Float("3.14")`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*FloatValue).ValueAsFloat()).To(Equal(3.14))
+
+			_, err = vm.Run(`Float("1.2.3")`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`ArgumentError: invalid value for Float(): "1.2.3"`))
+		})
+
+		It("converts any object to a string with String()", func() {
+			value, err := vm.Run(`String(12)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("12"))
+		})
+
+		It("wraps objects in an array with Array()", func() {
+			value, err := vm.Run(`Array(nil)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(BeEmpty())
+
+			value, err = vm.Run(`Array([1])`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm)}))
+
+			value, err = vm.Run(`Array(1)`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(1, vm)}))
+		})
+
+		It("raise an ArgumentError unless they are given exactly one value", func() {
+			for _, code := range []string{"Float()", "String()", "Array()", "Float(1, 2)"} {
+				_, err := vm.Run(code)
+				Expect(err).To(HaveOccurred(), code)
+				Expect(err.(*ExceptionValue).Class()).To(Equal(vm.ClassWithName("ArgumentError")), code)
+				Expect(err.Error()).To(ContainSubstring("wrong number of arguments"), code)
+			}
+		})
+	})
+
+	Describe("Kernel#sleep", func() {
+		It("pauses for the given number of seconds", func() {
+			start := time.Now()
+			value, err := vm.Run("sleep(0.05)")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+			Expect(value.String()).To(Equal("0"))
+		})
+
+		It("accepts integers", func() {
+			value, err := vm.Run("sleep(0)")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal("0"))
+		})
+
+		It("raises an ArgumentError for negative values", func() {
+			_, err := vm.Run("sleep(-1)")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: time interval must not be negative"))
+		})
+
+		It("raises an ArgumentError when given more than one argument", func() {
+			_, err := vm.Run("sleep(1, 2)")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ArgumentError: wrong number of arguments (2 for 0..1)"))
+		})
+	})
+
 	Describe("Kernel#require", func() {
 		It("searches for a file with the given name", func() {
 			_, err := vm.Run("require 'something'")
 
 			Expect(err).To(HaveOccurred())
-			Expect(err).To(BeAssignableToTypeOf(NewLoadError("", "")))
+			Expect(err).To(BeAssignableToTypeOf(NewLoadError("", vm)))
 		})
 
 		Context("with a load path and a file to require", func() {
@@ -228,6 +916,39 @@ require 'foo'
 
 			Expect(vm.MustGet(":").(*Array).Members()).To(ContainElement(str))
 		})
+
+		It("starts with the lib directory inside the grubby home", func() {
+			grubbyLib := filepath.Join(os.Getenv("HOME"), ".grubby", "lib")
+			members := vm.MustGet("LOAD_PATH").(*Array).Members()
+
+			Expect(members).ToNot(BeEmpty())
+			Expect(members[0]).To(EqualRubyString(grubbyLib))
+		})
+
+		It("includes the current working directory", func() {
+			cwd, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.MustGet("LOAD_PATH").(*Array).Members()).To(ContainElement(EqualRubyString(cwd)))
+		})
+
+		It("allows files in the current working directory to be required", func() {
+			tmpdir, err := ioutil.TempDir("", "grubby-cwd")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpdir)
+
+			err = ioutil.WriteFile(filepath.Join(tmpdir, "cwd_lib.rb"), []byte("CWD_LIB_LOADED = true"), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			originalDir, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Chdir(originalDir)
+			Expect(os.Chdir(tmpdir)).To(Succeed())
+
+			vm = NewVM(filepath.Join(os.Getenv("HOME"), ".grubby"), "fake-irb-under-test")
+			_, err = vm.Run("require 'cwd_lib'")
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 
 	Describe("global variables", func() {
@@ -238,6 +959,54 @@ require 'foo'
 			nilInstance := vm.SingletonWithName("nil")
 			Expect(value).To(Equal(nilInstance))
 		})
+
+		It("describes local variables, methods, constants and globals", func() {
+			definitions := map[string]string{
+				"foo = 1; defined?(foo)": "local-variable",
+				"defined?(puts)":         "method",
+				"defined?(String)":       "constant",
+				"$g = 1; defined?($g)":   "global-variable",
+				"defined?(String.new)":   "method",
+				"defined?(a = 1)":        "assignment",
+				"defined?(self)":         "self",
+				"defined?(nil)":          "expression",
+			}
+
+			for source, description := range definitions {
+				value, err := vm.Run(source)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(EqualRubyString(description), source)
+			}
+		})
+
+		It("describes instance variables that have been assigned", func() {
+			value, err := vm.Run(`
+class WithIvar
+  def initialize
+    @x = 1
+  end
+
+  def check
+    [defined?(@x), defined?(@y)]
+  end
+end
+
+WithIvar.new.check
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			members := value.(*Array).Members()
+			Expect(members[0]).To(EqualRubyString("instance-variable"))
+			Expect(members[1]).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("returns nil without raising for undefined things", func() {
+			for _, source := range []string{"defined?(Nope)", "defined?($nope)", "defined?(nope.bar)", "defined?(String.nope)"} {
+				value, err := vm.Run(source)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal(vm.SingletonWithName("nil")), source)
+			}
+		})
 	})
 
 	Describe("the File class", func() {
@@ -310,9 +1079,76 @@ require 'foo'
 			_, err := vm.Run("foo = 'albitite-compotor'")
 			Expect(err).ToNot(HaveOccurred())
 
-			value, err := vm.Get("foo")
+			value, err := vm.Get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*StringValue).RawString()).To(Equal("albitite-compotor"))
+		})
+
+		It("assigns the result of the operator with an operator assignment", func() {
+			value, err := vm.Run("foo = 5; foo += 2; foo *= 3; foo -= 1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(20, vm)))
+
+			value, err = vm.Get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(20, vm)))
+		})
+
+		It("assigns with an operator assignment as the last expression of a method", func() {
+			value, err := vm.Run(`
+def increment
+  x = 1
+  x += 1
+end
+
+class Counter
+  @@count = 0
+
+  def self.increment
+    @@count += 1
+  end
+end
+
+Counter.increment
+[increment, Counter.increment]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.(*Array).Members()).To(Equal([]Value{NewFixnum(2, vm), NewFixnum(2, vm)}))
+		})
+
+		It("assigns through an index with an operator assignment", func() {
+			value, err := vm.Run(`
+counts = {'a' => 1}
+counts['a'] += 2
+counts['a'] *= 2
+counts['a']
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(6, vm)))
+		})
+
+		It("assigns through an attribute with an operator assignment", func() {
+			value, err := vm.Run(`
+class Box
+  def initialize
+    @size = 1
+  end
+
+  def size
+    @size
+  end
+
+  def size=(size)
+    @size = size
+  end
+end
+
+box = Box.new
+box.size += 4
+box.size
+`)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(value.(*StringValue).RawString()).To(Equal("albitite-compotor"))
+			Expect(value).To(Equal(NewFixnum(5, vm)))
 		})
 	})
 
@@ -325,6 +1161,42 @@ require 'foo'
 			kernel := vm.MustGet("Kernel").(Module)
 			Expect(kernel.Constant("Foobar")).To(Equal(NewFixnum(1, vm)))
 		})
+
+		Describe("being reassigned", func() {
+			var errorOutput *bytes.Buffer
+
+			BeforeEach(func() {
+				errorOutput = &bytes.Buffer{}
+				vm = NewVMWithOptions(filepath.Join(os.Getenv("HOME"), ".grubby"), "fake-irb-under-test", VMOptions{
+					Stdout: &bytes.Buffer{},
+					Stderr: errorOutput,
+				})
+			})
+
+			It("takes the new value, with a warning on $stderr", func() {
+				value, err := vm.Run("FOO = 1\nFOO = 2\nFOO")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal(NewFixnum(2, vm)))
+				Expect(errorOutput.String()).To(Equal("fake-irb-under-test:2: warning: already initialized constant FOO\n"))
+			})
+
+			It("names the module of the constant in the warning", func() {
+				_, err := vm.Run(`
+module Settings
+  LIMIT = 1
+  LIMIT = 2
+end
+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(errorOutput.String()).To(ContainSubstring("warning: already initialized constant Settings::LIMIT"))
+			})
+
+			It("does not warn when a constant is first assigned", func() {
+				_, err := vm.Run("FOO = 1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(errorOutput.String()).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("special global variables", func() {
@@ -395,11 +1267,246 @@ bar = true
 		})
 	})
 
+	Describe("begin; rescue; else; ensure; end", func() {
+		run := func(body, clauses string) string {
+			value, err := vm.Run(`
+log = []
+begin
+  log.push('body')
+  ` + body + `
+` + clauses + `
+end
+log.join(' ')
+`)
+			Expect(err).ToNot(HaveOccurred())
+			return value.String()
+		}
+
+		It("runs else when the body raises nothing", func() {
+			Expect(run("", "rescue NoMethodError\n log.push('rescue')\nelse\n log.push('else')")).To(Equal("body else"))
+		})
+
+		It("skips else when the body raises", func() {
+			Expect(run("nil.explode", "rescue NoMethodError\n log.push('rescue')\nelse\n log.push('else')")).To(Equal("body rescue"))
+		})
+
+		It("runs ensure after else", func() {
+			Expect(run("", "rescue NoMethodError\n log.push('rescue')\nelse\n log.push('else')\nensure\n log.push('ensure')")).To(Equal("body else ensure"))
+		})
+
+		It("runs ensure after rescue, skipping else", func() {
+			Expect(run("nil.explode", "rescue NoMethodError\n log.push('rescue')\nelse\n log.push('else')\nensure\n log.push('ensure')")).To(Equal("body rescue ensure"))
+		})
+
+		It("runs ensure without a rescue clause", func() {
+			Expect(run("", "ensure\n log.push('ensure')")).To(Equal("body ensure"))
+		})
+
+		It("does not rescue errors raised by else", func() {
+			_, err := vm.Run(`
+log = []
+begin
+  log.push('body')
+rescue NoMethodError
+  log.push('rescue')
+else
+  nil.explode
+ensure
+  log.push('ensure')
+end
+`)
+			Expect(err).To(BeAssignableToTypeOf(NewNoMethodError("", vm.MustGet("Object"), vm)))
+
+			log, err := vm.Run("log.join(' ')")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(log).To(EqualRubyString("body ensure"))
+		})
+	})
+
+	Describe("the value of begin", func() {
+		It("is the value of the body when nothing is raised", func() {
+			value, err := vm.Run(`
+result = begin
+  1 + 1
+rescue
+  'fallback'
+ensure
+  'ignored'
+end
+result
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(2, vm)))
+		})
+
+		It("is the value of the rescue clause that handled the error", func() {
+			value, err := vm.Run(`
+result = begin
+  nil.explode
+rescue ArgumentError
+  'wrong clause'
+rescue NoMethodError
+  'fallback'
+ensure
+  'ignored'
+end
+result
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("fallback"))
+		})
+
+		It("is the value of the else clause when it runs", func() {
+			value, err := vm.Run(`
+result = begin
+  'body'
+rescue
+  'rescue'
+else
+  'else'
+end
+result
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("else"))
+		})
+
+		It("is returned from a method that ends with it", func() {
+			value, err := vm.Run(`
+def safely
+  begin
+    raise 'boom'
+  rescue => e
+    e.message
+  end
+end
+
+safely()
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("boom"))
+		})
+	})
+
+	Describe("the rescue modifier", func() {
+		It("evaluates to the statement when nothing is raised", func() {
+			value, err := vm.Run("value = 'fine' rescue 'fallback'\nvalue")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("fine"))
+		})
+
+		It("evaluates to the fallback when a StandardError is raised", func() {
+			value, err := vm.Run("value = nil.explode rescue 'fallback'\nvalue")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("fallback"))
+
+			value, err = vm.Run("undefined_thing rescue 'fallback'")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("fallback"))
+		})
+
+		It("does not rescue exceptions outside of StandardError", func() {
+			_, err := vm.Run("exit(3) rescue 'fallback'")
+			status, ok := SystemExitStatus(err)
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(3))
+		})
+	})
+
 	Describe("calling a method that does not exist", func() {
 		It("raises a NoMethodError", func() {
 			_, err := vm.Run("'hello'.world()")
-			Expect(err).To(BeAssignableToTypeOf(NewNoMethodError("", "", "", "")))
-			Expect(err.Error()).To(ContainSubstring("undefined method 'world' for \"hello\":String"))
+			Expect(err).To(BeAssignableToTypeOf(NewNoMethodError("", vm.MustGet("Object"), vm)))
+			Expect(err.Error()).To(ContainSubstring("NoMethodError: undefined method 'world' for an instance of String"))
+		})
+
+		It("describes nil, booleans, classes and modules the way ruby does", func() {
+			for source, message := range map[string]string{
+				"nil.world":    "undefined method 'world' for nil",
+				"true.world":   "undefined method 'world' for true",
+				"String.world": "undefined method 'world' for class String",
+				"Kernel.world": "undefined method 'world' for module Kernel",
+			} {
+				_, err := vm.Run(source)
+				Expect(err).To(HaveOccurred(), source)
+				Expect(err.Error()).To(ContainSubstring(message))
+			}
+		})
+
+		It("can be rescued as a NoMethodError", func() {
+			value, err := vm.Run(`
+rescued = nil
+begin
+  'hello'.world
+rescue NoMethodError
+  rescued = 'rescued'
+end
+rescued
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("rescued"))
+		})
+
+		It("does not write anything to stdout", func() {
+			output := SwapStdout(func() {
+				_, err := vm.Run("Object.new.world(1)")
+				Expect(err).To(BeAssignableToTypeOf(NewNoMethodError("", vm.MustGet("Object"), vm)))
+				Expect(err.Error()).To(ContainSubstring("undefined method 'world' for an instance of Object"))
+				fmt.Println("nothing else")
+			})
+
+			Expect(output).To(Equal("nothing else\n"))
+		})
+	})
+
+	Describe("referring to a variable or method that does not exist", func() {
+		It("raises a NameError", func() {
+			_, err := vm.Run("world")
+			Expect(err).To(BeAssignableToTypeOf(NewNameError("", vm.MustGet("Object"), vm)))
+			Expect(err.Error()).To(ContainSubstring("NameError: undefined local variable or method 'world' for an instance of Object"))
+		})
+
+		It("is not rescued as a NoMethodError", func() {
+			_, err := vm.Run(`
+begin
+  world
+rescue NoMethodError
+end
+`)
+			Expect(err).To(BeAssignableToTypeOf(NewNameError("", vm.MustGet("Object"), vm)))
+		})
+	})
+
+	Describe("formatting errors about builtin values", func() {
+		It("can describe a value of every builtin type", func() {
+			values := []Value{NewBlock(nil, nil, nil, nil, vm).(Value)}
+			for _, source := range []string{
+				"nil", "true", "false", "1", "2 ** 80", "1.5", "Rational(1, 2)", "Complex(1, 2)",
+				"'string'", ":symbol", "[1]", "{1 => 2}", "(1..2)", "/regexp/", "'abc'.match(/b/)",
+				"Object.new", "class Foo\nend\nFoo.new", "Foo", "module Bar\nend\nBar", "Struct.new(:a).new(1)",
+				"Set.new", "Time.now", "$stdout", "[1].each", "[1].lazy",
+				":upcase.to_proc", "NoMethodError", "LoadError",
+			} {
+				value, err := vm.Run(source)
+				Expect(err).ToNot(HaveOccurred(), source)
+				values = append(values, value)
+			}
+			values = append(values,
+				NewNoMethodError("foo", vm.MustGet("Object"), vm),
+				NewNameError("foo", vm.MustGet("Object"), vm),
+				NewLoadError("foo", vm),
+				NewNativeMethod("foo", vm, nil),
+			)
+
+			for _, value := range values {
+				Expect(value.Class()).ToNot(BeNil(), value.String())
+
+				Expect(func() {
+					_ = NewNoMethodError("foo", value, vm).Error()
+					_ = NewFrozenError(value, vm).Error()
+					_ = value.PrettyPrint()
+				}).ToNot(Panic(), value.String())
+			}
 		})
 	})
 
@@ -480,6 +1587,77 @@ counter = 4
 		})
 	})
 
+	Describe("while and until loops", func() {
+		It("runs the body for as long as the condition of a while loop is truthy", func() {
+			value, err := vm.Run(`
+x = 0
+while x < 10
+  x += 3
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+
+			x, err := vm.Get("x")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(x).To(Equal(NewFixnum(12, vm)))
+		})
+
+		It("runs the body until the condition of an until loop is truthy", func() {
+			_, err := vm.Run(`
+x = 10
+until x == 4
+  x -= 2
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			x, err := vm.Get("x")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(x).To(Equal(NewFixnum(4, vm)))
+		})
+
+		It("checks the condition of a while or until modifier before running the statement", func() {
+			_, err := vm.Run(`
+x = 0
+x += 1 while x < 10
+y = 0
+y += 1 until y >= 3
+z = 0
+z += 1 while false
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			for name, expected := range map[string]int64{"x": 10, "y": 3, "z": 0} {
+				value, err := vm.Get(name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal(NewFixnum(expected, vm)))
+			}
+		})
+
+		It("runs a begin block given a while or until modifier at least once", func() {
+			_, err := vm.Run(`
+x = 0
+begin
+  x += 1
+end while false
+y = 0
+begin
+  y += 5
+end until y > 12
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			x, err := vm.Get("x")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(x).To(Equal(NewFixnum(1, vm)))
+
+			y, err := vm.Get("y")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(y).To(Equal(NewFixnum(15, vm)))
+		})
+	})
+
 	Describe("equality", func() {
 		Context("with the == operator", func() {
 			It("treats objects as equal when they have the same value", func() {
@@ -567,6 +1745,158 @@ value
 		})
 	})
 
+	Describe("Kernel#exit", func() {
+		It("raises a SystemExit with a status of zero", func() {
+			_, err := vm.Run("exit")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("SystemExit: exit"))
+
+			status, ok := SystemExitStatus(err)
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(0))
+		})
+
+		It("takes the status to exit with", func() {
+			_, err := vm.Run("def quit\n  exit(3)\nend\nquit")
+			status, ok := SystemExitStatus(err)
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(3))
+
+			_, err = vm.Run("exit(false)")
+			status, ok = SystemExitStatus(err)
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(1))
+		})
+
+		It("runs ensure blocks on the way out", func() {
+			_, err := vm.Run(`
+ensured = false
+begin
+  exit
+ensure
+  ensured = true
+end
+`)
+			_, ok := SystemExitStatus(err)
+			Expect(ok).To(BeTrue())
+			Expect(vm.MustGet("ensured")).To(Equal(vm.SingletonWithName("true")))
+		})
+
+		It("is not a SystemExit for other errors", func() {
+			_, err := vm.Run("[1] + 2")
+			_, ok := SystemExitStatus(err)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Kernel#exit!", func() {
+		It("ends the program with a status of one", func() {
+			_, err := vm.Run("exit!")
+			Expect(err).To(Equal(&ImmediateExit{Status: 1}))
+
+			_, err = vm.Run("def quit\n  exit!(true)\nend\nquit")
+			Expect(err).To(Equal(&ImmediateExit{Status: 0}))
+		})
+
+		It("is not rescued, and skips ensure blocks and exit hooks", func() {
+			_, err := vm.Run(`
+$stops = []
+at_exit { $stops << :at_exit }
+begin
+  exit!(2)
+rescue Exception
+  $stops << :rescue
+ensure
+  $stops << :ensure
+end
+`)
+			Expect(err).To(Equal(&ImmediateExit{Status: 2}))
+
+			vm.Exit()
+			Expect(vm.MustGet("stops").(*Array).Members()).To(BeEmpty())
+		})
+	})
+
+	Describe("Kernel#catch and Kernel#throw", func() {
+		It("returns the value thrown to the matching catch", func() {
+			value, err := vm.Run(`
+catch(:done) do
+  [1, 2, 3, 4].each do |i|
+    throw :done, i if i == 3
+  end
+  :never
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(3, vm)))
+		})
+
+		It("returns nil when no value is thrown", func() {
+			value, err := vm.Run("catch(:done) { throw :done }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(vm.SingletonWithName("nil")))
+		})
+
+		It("matches a symbol tag however the symbol was written", func() {
+			value, err := vm.Run(`catch(:"done") { throw "done".to_sym, 7 }`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(7, vm)))
+		})
+
+		It("returns the value of the block when nothing is thrown", func() {
+			value, err := vm.Run("catch(:done) { 42 }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(42, vm)))
+		})
+
+		It("unwinds through methods and inner catches to the matching tag", func() {
+			value, err := vm.Run(`
+def deep
+  throw :outer, 'thrown'
+end
+
+catch(:outer) do
+  catch(:inner) do
+    deep
+  end
+  'not thrown'
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("thrown"))
+		})
+
+		It("is not rescued, but runs ensure blocks", func() {
+			value, err := vm.Run(`
+result = nil
+catch(:done) do
+  begin
+    throw :done
+  rescue StandardError
+    result = 'rescued'
+  ensure
+    result = 'ensured'
+  end
+end
+result
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(EqualRubyString("ensured"))
+		})
+
+		It("yields a new tag when none is given", func() {
+			value, err := vm.Run("catch do |tag| throw tag, 7 end")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(NewFixnum(7, vm)))
+		})
+
+		It("raises an UncaughtThrowError for a tag that is not being caught", func() {
+			_, err := vm.Run("catch(:other) { throw :nope }")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("UncaughtThrowError: uncaught throw :nope"))
+		})
+	})
+
 	Describe("Kernel#at_exit", func() {
 		It("can be triggered by calling vm.exit", func() {
 			_, err := vm.Run(`
@@ -581,6 +1911,33 @@ end
 			vm.Exit()
 			Expect(vm.MustGet("value").String()).To(ContainSubstring("just right"))
 		})
+
+		It("runs every block, most recently registered first", func() {
+			_, err := vm.Run(`
+order = []
+at_exit { order << 1 }
+at_exit { order << 2 }
+at_exit { order << 3 }
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm.Exit()
+			Expect(vm.MustGet("order").PrettyPrint()).To(Equal("[3, 2, 1]"))
+		})
+
+		It("only runs each block once", func() {
+			_, err := vm.Run(`
+count = 0
+at_exit do
+  count = count + 1
+end
+`)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm.Exit()
+			vm.Exit()
+			Expect(vm.MustGet("count")).To(Equal(NewFixnum(1, vm)))
+		})
 	})
 
 	Describe("opening up a class again", func() {
@@ -664,6 +2021,48 @@ d = nil or false
 		})
 	})
 
+	Describe("equality", func() {
+		It("compares identity with equal?", func() {
+			value, err := vm.Run("a = 'x'; [a.equal?(a), a.equal?('x')]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, false]"))
+		})
+
+		It("compares values with ==", func() {
+			value, err := vm.Run("['x' == 'x', 1 == 1.0, [1, [2]] == [1, [2]], {:a => 1} == {:a => 1}, Object.new == Object.new]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true, true, true, false]"))
+		})
+
+		It("compares values and types with eql?", func() {
+			value, err := vm.Run("['x'.eql?('x'), 1.eql?(1), 1.eql?(1.0)]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true, false]"))
+		})
+
+		It("uses the == method of user defined classes, including for !=", func() {
+			value, err := vm.Run(`
+class Money
+  def initialize(cents)
+    @cents = cents
+  end
+
+  def cents
+    @cents
+  end
+
+  def ==(other)
+    cents == other.cents
+  end
+end
+
+[Money.new(1) == Money.new(1), Money.new(1) != Money.new(2), Money.new(1).equal?(Money.new(1))]
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.PrettyPrint()).To(Equal("[true, true, false]"))
+		})
+	})
+
 	Describe("the defined? keyword", func() {
 		It("can be used to check if a value is defined", func() {
 			value, err := vm.Run("defined? a")
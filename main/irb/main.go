@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 
 	"github.com/grubby/grubby/interpreter/vm"
+	"github.com/grubby/grubby/interpreter/vm/builtins"
 )
 
 func main() {
@@ -16,30 +17,41 @@ func main() {
 	vm := vm.NewVM(grubbyHome, "(grubby irb")
 	defer vm.Exit()
 
+	input := bufio.NewReader(os.Stdin)
 	for {
-		txt := readInput()
+		txt := readInput(input)
 		if txt == "quit\n" {
 			break
 		}
+		if txt == "reset!\n" {
+			vm.Reset()
+			fmt.Println("=> nil")
+			continue
+		}
 		result, err := vm.Run(txt)
 		if err != nil {
 			fmt.Printf(" => %s", err.Error())
 			continue
 		}
 
-		if result != nil {
-			fmt.Printf("=> %s", result.String())
-		} else {
-			fmt.Printf("=> %#v", result)
+		if result == nil {
+			fmt.Println("=> nil")
+			continue
+		}
+
+		inspected, err := builtins.Inspect(result)
+		if err != nil {
+			fmt.Printf(" => %s\n", err.Error())
+			continue
 		}
-		println("")
+
+		fmt.Printf("=> %s\n", inspected)
 	}
 }
 
-func readInput() string {
+func readInput(input *bufio.Reader) string {
 	print("> ")
-	bio := bufio.NewReader(os.Stdin)
-	userInput, err := bio.ReadString('\n')
+	userInput, err := input.ReadString('\n')
 	if err != nil {
 		panic(err)
 	}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 
 	"github.com/grubby/grubby/interpreter/vm"
+	"github.com/grubby/grubby/interpreter/vm/builtins"
 	"github.com/grubby/grubby/parser"
 )
 
@@ -39,10 +40,23 @@ func main() {
 
 	_, err = rubyVM.Run(string(bytes))
 
+	if exit, ok := err.(*builtins.ImmediateExit); ok {
+		os.Exit(exit.Status)
+	}
+	if status, ok := builtins.SystemExitStatus(err); ok {
+		// os.Exit skips deferred calls, so the exit hooks must be run first
+		rubyVM.Exit()
+		os.Exit(status)
+	}
+
 	switch err.(type) {
 	case *vm.ParseError:
-		offendingFilename := err.(*vm.ParseError).Filename
-		println(fmt.Sprintf("Error parsing ruby script %s", offendingFilename))
+		fmt.Fprintln(os.Stderr, err.Error())
+		if !*verboseFlag {
+			os.Exit(1)
+		}
+
+		println("")
 		println("last 20 statements from the parser:")
 		println("")
 
@@ -65,9 +79,14 @@ func main() {
 
 		os.Exit(1)
 	case nil:
-	case error:
-		panic(err.Error())
 	default:
-		panic(fmt.Sprintf("%#v", err))
+		// the go stack of the interpreter is only of interest when debugging it
+		if *verboseFlag {
+			panic(err.Error())
+		}
+
+		fmt.Fprintln(os.Stderr, err.Error())
+		rubyVM.Exit()
+		os.Exit(1)
 	}
 }
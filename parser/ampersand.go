@@ -85,6 +85,8 @@ func lexAmpersand(l StatefulRubyLexer) stateFn {
 		parseAsProcArg(l)
 	case tokenTypeStar:
 		parseAsProcArg(l)
+	case tokenTypeBinaryStar:
+		parseAsProcArg(l)
 	case tokenTypeLBracket:
 		parseAsProcArg(l)
 	case tokenTypeRBracket:
@@ -1,10 +1,84 @@
 package parser
 
+import (
+	"regexp"
+	"strings"
+)
+
+var frozenStringLiteralComment = regexp.MustCompile(`(?i)frozen[-_]string[-_]literal\s*:\s*(true|false)\b`)
+
 func lexComment(l StatefulRubyLexer) stateFn {
 	for r := l.next(); r != '\n' && r != eof; r = l.next() {
 	}
 
 	l.backup()
+	if l.inLeadingComments() {
+		if match := frozenStringLiteralComment.FindStringSubmatch(l.currentSlice()); match != nil {
+			l.setFrozenStringLiterals(strings.ToLower(match[1]) == "true")
+		}
+	}
+
 	l.ignore()
 	return lexSomething
 }
+
+// magic comments (eg: # frozen_string_literal: true) only count when they
+// come before any code, in the comments at the very top of the file.
+// Only the input since the last leading comment is checked each time.
+func (l *ConcreteStatefulRubyLexer) inLeadingComments() bool {
+	if l.leadingCommentsEnd < 0 {
+		return false
+	}
+
+	for _, line := range strings.Split(l.input[l.leadingCommentsEnd:l.start], "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			l.leadingCommentsEnd = -1
+			return false
+		}
+	}
+
+	l.leadingCommentsEnd = l.start
+	return true
+}
+
+// =begin ... =end comments, each of which must start a line
+// called with the lexer positioned just after the "="
+func startsBlockComment(l StatefulRubyLexer) bool {
+	start := l.currentIndex() - 1
+	if start > 0 && l.slice(start-1, start) != "\n" {
+		return false
+	}
+
+	return lineHasKeywordAt(l, start, "=begin")
+}
+
+func lexBlockComment(l StatefulRubyLexer) stateFn {
+	for r := l.next(); r != eof; r = l.next() {
+		if r != '\n' {
+			continue
+		}
+
+		l.parsedNewLine()
+		if lineHasKeywordAt(l, l.currentIndex(), "=end") {
+			return lexComment
+		}
+	}
+
+	l.ignore()
+	return lexSomething
+}
+
+func lineHasKeywordAt(l StatefulRubyLexer, index int, keyword string) bool {
+	end := index + len(keyword)
+	if end > l.lengthOfInput() || l.slice(index, end) != keyword {
+		return false
+	}
+
+	if end == l.lengthOfInput() {
+		return true
+	}
+
+	following := l.slice(end, end+1)
+	return following == " " || following == "\t" || following == "\n"
+}
@@ -76,6 +76,8 @@ func lexSlash(l StatefulRubyLexer) stateFn {
 		parseAsRegex(l)
 	case tokenTypeStar:
 		parseAsRegex(l)
+	case tokenTypeBinaryStar:
+		parseAsRegex(l)
 	case tokenTypeLBracket:
 		parseAsRegex(l)
 	case tokenTypeRBracket:
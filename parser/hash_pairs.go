@@ -0,0 +1,13 @@
+package parser
+
+import "github.com/grubby/grubby/ast"
+
+// builds the hash for `{key: value}` literals and `key: value` call arguments
+func hashFromSymbolKeyValuePairs(nodes ast.Nodes) ast.Hash {
+	pairs := []ast.HashKeyValuePair{}
+	for _, node := range nodes {
+		pairs = append(pairs, node.(ast.HashKeyValuePair))
+	}
+
+	return ast.Hash{Line: pairs[0].Key.LineNumber(), Pairs: pairs}
+}
@@ -0,0 +1,27 @@
+package parser
+
+import "strings"
+
+// `do` after the condition of a while or until loop only separates the
+// condition from the body, like `then` does after the condition of an if,
+// unless, elsif or when, so both are lexed as if they were a semicolon.
+// Any other `do` begins a block.
+func isLoopDo(l StatefulRubyLexer) bool {
+	line := l.slice(0, l.startIndex())
+	if index := strings.LastIndexAny(line, "\n;"); index >= 0 {
+		line = line[index+1:]
+	}
+
+	words := strings.Fields(line)
+	if len(words) == 0 || (words[0] != "while" && words[0] != "until") {
+		return false
+	}
+
+	for _, word := range words[1:] {
+		if word == "do" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -1,8 +1,8 @@
 package parser
 
 import (
-	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -21,6 +21,9 @@ type token struct {
 	typ   tokenType
 	value string
 	line  int
+
+	// index into the input where the token begins
+	offset int
 }
 
 type tokenType int
@@ -73,6 +76,7 @@ const (
 	tokenTypeBinaryMinus
 	tokenTypeUnaryMinus
 	tokenTypeStar
+	tokenTypeBinaryStar
 	tokenTypeLBracket
 	tokenTypeRBracket
 	tokenTypeLBrace
@@ -110,6 +114,7 @@ const (
 	tokenTypeCASE
 	tokenTypeWHEN
 	tokenTypeALIAS
+	tokenTypeUNDEF
 	tokenTypeSUPER
 	tokenType__FILE__
 	tokenType__LINE__
@@ -145,6 +150,9 @@ type StatefulRubyLexer interface {
 	parsedNewLine()
 	CurrentLineNumber() int
 
+	setFrozenStringLiterals(bool)
+	inLeadingComments() bool
+
 	RubyLexer
 }
 
@@ -157,9 +165,16 @@ type ConcreteStatefulRubyLexer struct {
 	tokens chan token
 
 	lastTokenEmitted token
+	lastTokenLexed   token
 	LastError        error
 
 	currentLineNumber int
+
+	// set by the frozen_string_literal magic comment
+	frozenStringLiterals bool
+
+	// the index of the last comment before any code, or -1 once there is code
+	leadingCommentsEnd int
 }
 
 type stateFn func(StatefulRubyLexer) stateFn
@@ -237,6 +252,10 @@ func lexSomething(l StatefulRubyLexer) stateFn {
 			l.emit(tokenTypeGreaterThan)
 		}
 	case r == '=':
+		if startsBlockComment(l) {
+			return lexBlockComment
+		}
+
 		if l.accept("=") {
 			l.accept("=")
 			l.emit(tokenTypeOperator)
@@ -266,6 +285,8 @@ func lexSomething(l StatefulRubyLexer) stateFn {
 			l.emit(tokenTypeOperator)
 		} else if l.accept("*") {
 			l.emit(tokenTypeOperator)
+		} else if isBinaryStar(l) {
+			l.emit(tokenTypeBinaryStar)
 		} else {
 			l.emit(tokenTypeStar)
 		}
@@ -279,7 +300,9 @@ func lexSomething(l StatefulRubyLexer) stateFn {
 		l.emit(tokenTypeRBrace)
 	case r == '$':
 		l.ignore()
-		l.acceptRun(alphaNumericUnderscore + ":\\$><")
+		if !l.accept("~") {
+			l.acceptRun(alphaNumericUnderscore + ":\\$><")
+		}
 		l.emit(tokenTypeGlobal)
 	case r == '@':
 		tokenToEmit := tokenTypeInstanceVariable
@@ -419,11 +442,16 @@ func (l *ConcreteStatefulRubyLexer) CurrentLineNumber() int {
 	return l.currentLineNumber
 }
 
+func (l *ConcreteStatefulRubyLexer) setFrozenStringLiterals(frozen bool) {
+	l.frozenStringLiterals = frozen
+}
+
 func (l *ConcreteStatefulRubyLexer) emit(t tokenType) {
 	l.emitToken(token{
-		typ:   t,
-		value: l.input[l.start:l.pos],
-		line:  l.currentLineNumber,
+		typ:    t,
+		value:  l.input[l.start:l.pos],
+		line:   l.currentLineNumber,
+		offset: l.start,
 	})
 }
 
@@ -446,19 +474,46 @@ func (lexer *ConcreteStatefulRubyLexer) Lex(lval *RubySymType) int {
 	defer func() { debug("") }()
 
 	for token := range lexer.tokens {
+		lexer.lastTokenLexed = token
+		if isRationalLiteral(token) {
+			debug("rational: %s", token.value)
+			ratVal, ok := new(big.Rat).SetString(strings.TrimSuffix(token.value, "r"))
+			if !ok || isHexLiteral(token) {
+				return invalidToken
+			}
+
+			lval.genericValue = ast.ConstantRational{Line: token.line, Value: ratVal}
+			return NODE
+		}
+		if isImaginaryLiteral(token) {
+			debug("imaginary: %s", token.value)
+			floatVal, err := strconv.ParseFloat(strings.TrimSuffix(token.value, "i"), 64)
+			if err != nil || isHexLiteral(token) {
+				return invalidToken
+			}
+
+			lval.genericValue = ast.ConstantComplex{Line: token.line, Value: complex(0, floatVal)}
+			return NODE
+		}
+
 		switch token.typ {
 		case tokenTypeInteger:
 			debug("integer: %s", token.value)
 			intVal, err := strconv.ParseInt(token.value, 0, 64)
 			if err != nil {
 				uintVal, err := strconv.ParseUint(token.value, 0, 64)
-				if err != nil {
-					panic(err)
+				if err == nil {
+					intValue := ast.ConstantUint{Value: uintVal}
+					intValue.Line = token.line
+					lval.genericValue = intValue
+				} else {
+					bigVal, ok := new(big.Int).SetString(token.value, 0)
+					if !ok {
+						return invalidToken
+					}
+
+					lval.genericValue = ast.ConstantBigInt{Line: token.line, Value: bigVal}
 				}
-
-				intValue := ast.ConstantUint{Value: uintVal}
-				intValue.Line = token.line
-				lval.genericValue = intValue
 			} else {
 				intValue := ast.ConstantInt{Value: intVal}
 				intValue.Line = token.line
@@ -478,13 +533,14 @@ func (lexer *ConcreteStatefulRubyLexer) Lex(lval *RubySymType) int {
 			return NODE
 		case tokenTypeString:
 			debug("string: '%s'", token.value)
-			someValue := ast.SimpleString{Value: token.value}
+			someValue := ast.SimpleString{Value: token.value, Frozen: lexer.frozenStringLiterals}
 			someValue.Line = token.line
 			lval.astString = someValue
 			return STRING
 		case tokenTypeDoubleQuoteString:
 			debug("string: '%s'", token.value)
-			someValue := ast.InterpolatedString{Value: token.value}
+			someValue := ast.InterpolatedString{Value: unescapeDoubleQuotedString(token.value)}
+			someValue.Frozen = lexer.frozenStringLiterals && !strings.Contains(token.value, "#{")
 			someValue.Line = token.line
 			lval.astString = someValue
 			return STRING
@@ -496,7 +552,11 @@ func (lexer *ConcreteStatefulRubyLexer) Lex(lval *RubySymType) int {
 			return NODE
 		case tokenTypeSymbol:
 			debug("symbol: %s", token.value)
-			someValue := ast.Symbol{Name: token.value}
+			name := token.value
+			if lexer.isQuotedSymbol(token) {
+				name = unescapeSymbolName(name)
+			}
+			someValue := ast.Symbol{Name: name}
 			someValue.Line = token.line
 			lval.genericValue = someValue
 			return SYMBOL
@@ -635,6 +695,10 @@ func (lexer *ConcreteStatefulRubyLexer) Lex(lval *RubySymType) int {
 			debug("*")
 			lval.genericValue = ast.Nil{Line: token.line}
 			return STAR
+		case tokenTypeBinaryStar:
+			debug("(binary) *")
+			lval.genericValue = ast.Nil{Line: token.line}
+			return BINARY_STAR
 		case tokenTypeLBracket:
 			debug("[")
 			lval.genericValue = ast.Nil{Line: token.line}
@@ -788,6 +852,12 @@ func (lexer *ConcreteStatefulRubyLexer) Lex(lval *RubySymType) int {
 			someValue.Line = token.line
 			lval.genericValue = someValue
 			return ALIAS
+		case tokenTypeUNDEF:
+			debug("UNDEF")
+			someValue := ast.BareReference{}
+			someValue.Line = token.line
+			lval.genericValue = someValue
+			return UNDEF
 		case tokenTypeOrEquals:
 			debug("||=")
 			return OR_EQUALS
@@ -827,11 +897,18 @@ func (lexer *ConcreteStatefulRubyLexer) Lex(lval *RubySymType) int {
 		}
 	}
 
+	lexer.lastTokenLexed = token{typ: tokenTypeEOF, line: lexer.currentLineNumber, offset: len(lexer.input)}
 	return 0
 }
 
 func (lexer *ConcreteStatefulRubyLexer) Error(error string) {
-	lexer.LastError = errors.New(fmt.Sprintf("syntax error: %s\n", error))
+	lexer.LastError = newSyntaxError(error, lexer.input, lexer.lastTokenLexed)
+}
+
+// SyntaxError returns the error reported by the parser, if there was one
+func (lexer *ConcreteStatefulRubyLexer) SyntaxError() *SyntaxError {
+	syntaxError, _ := lexer.LastError.(*SyntaxError)
+	return syntaxError
 }
 
 func debug(formatString string, args ...interface{}) {
@@ -81,6 +81,8 @@ func lexMinus(l StatefulRubyLexer) stateFn {
 		l.emit(tokenTypeUnaryMinus)
 	case tokenTypeStar:
 		l.emit(tokenTypeUnaryMinus)
+	case tokenTypeBinaryStar:
+		l.emit(tokenTypeUnaryMinus)
 	case tokenTypeLBracket:
 		l.emit(tokenTypeUnaryMinus)
 	case tokenTypeRBracket:
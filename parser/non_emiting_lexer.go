@@ -55,11 +55,20 @@ func (l *nonEmitingLexer) parsedNewLine() {
 	l.lexer.parsedNewLine()
 }
 
+func (l *nonEmitingLexer) setFrozenStringLiterals(frozen bool) {
+	l.lexer.setFrozenStringLiterals(frozen)
+}
+
+func (l *nonEmitingLexer) inLeadingComments() bool {
+	return l.lexer.inLeadingComments()
+}
+
 func (l *nonEmitingLexer) emit(t tokenType) {
 	l.Tokens = append(l.Tokens, token{
-		typ:   t,
-		value: l.lexer.currentSlice(),
-		line:  l.lexer.CurrentLineNumber(),
+		typ:    t,
+		value:  l.lexer.currentSlice(),
+		line:   l.lexer.CurrentLineNumber(),
+		offset: l.lexer.startIndex(),
 	})
 	l.lexer.ignore()
 }
@@ -1,10 +1,15 @@
 package parser
 
+import (
+	"strings"
+	"unicode/utf8"
+)
+
 const digits = "0123456789"
 
 func lexNumber(l StatefulRubyLexer) stateFn {
-	if l.accept("0") && l.peek() == 'x' {
-		l.accept("x")
+	if l.accept("0") && strings.ContainsRune("xX", l.peek()) {
+		l.accept("xX")
 		l.acceptRun(alphaNumeric)
 		l.emit(tokenTypeInteger)
 		return lexSomething
@@ -13,6 +18,7 @@ func lexNumber(l StatefulRubyLexer) stateFn {
 		if l.accept(".") {
 			if l.accept(digits) {
 				l.acceptRun(digits)
+				acceptNumberSuffix(l)
 				l.emit(tokenTypeFloat)
 				return lexSomething
 			} else {
@@ -23,6 +29,33 @@ func lexNumber(l StatefulRubyLexer) stateFn {
 		}
 	}
 
+	acceptNumberSuffix(l)
 	l.emit(tokenTypeInteger)
 	return lexSomething
 }
+
+// the r of rational literals (eg: 3r, 0.75r) and the i of imaginary ones
+// (eg: 2i, 1.5i) stay part of the number's token
+func acceptNumberSuffix(l StatefulRubyLexer) {
+	if l.accept("ri") && strings.ContainsRune(alphaNumericUnderscore, l.peek()) {
+		l.backup()
+	}
+}
+
+func isRationalLiteral(t token) bool {
+	return (t.typ == tokenTypeInteger || t.typ == tokenTypeFloat) && strings.HasSuffix(t.value, "r")
+}
+
+func isImaginaryLiteral(t token) bool {
+	return (t.typ == tokenTypeInteger || t.typ == tokenTypeFloat) && strings.HasSuffix(t.value, "i")
+}
+
+// hex literals take every letter after the 0x into the token, so 0x10r is
+// not a rational and 0xabi is not imaginary, but malformed integers
+func isHexLiteral(t token) bool {
+	return strings.HasPrefix(t.value, "0x") || strings.HasPrefix(t.value, "0X")
+}
+
+// a malformed number is passed to the parser as a character it does not
+// know, which it reports as a syntax error at that token
+const invalidToken = utf8.MaxRune + 1
@@ -1,9 +1,13 @@
+// Code generated by goyacc -o parser.go -p Ruby parser.y. DO NOT EDIT.
+
 //line parser.y:2
+
 package parser
 
 import __yyfmt__ "fmt"
 
 //line parser.y:3
+
 import (
 	"github.com/grubby/grubby/ast"
 	"strings"
@@ -71,41 +75,43 @@ const LAMBDA = 57385
 const CASE = 57386
 const WHEN = 57387
 const ALIAS = 57388
-const SUPER = 57389
-const SELF = 57390
-const NIL = 57391
-const DEFINED = 57392
-const LESSTHAN = 57393
-const GREATERTHAN = 57394
-const EQUALTO = 57395
-const BANG = 57396
-const COMPLEMENT = 57397
-const BINARY_PLUS = 57398
-const UNARY_PLUS = 57399
-const BINARY_MINUS = 57400
-const UNARY_MINUS = 57401
-const STAR = 57402
-const RANGE = 57403
-const EXCLUSIVE_RANGE = 57404
-const OR_EQUALS = 57405
-const AND_EQUALS = 57406
-const WHITESPACE = 57407
-const NEWLINE = 57408
-const SEMICOLON = 57409
-const COLON = 57410
-const DOT = 57411
-const PIPE = 57412
-const SLASH = 57413
-const AMPERSAND = 57414
-const QUESTIONMARK = 57415
-const CARET = 57416
-const LBRACKET = 57417
-const RBRACKET = 57418
-const LBRACE = 57419
-const RBRACE = 57420
-const FILE_CONST_REF = 57421
-const LINE_CONST_REF = 57422
-const EOF = 57423
+const UNDEF = 57389
+const SUPER = 57390
+const SELF = 57391
+const NIL = 57392
+const DEFINED = 57393
+const LESSTHAN = 57394
+const GREATERTHAN = 57395
+const EQUALTO = 57396
+const BANG = 57397
+const COMPLEMENT = 57398
+const BINARY_PLUS = 57399
+const BINARY_STAR = 57400
+const UNARY_PLUS = 57401
+const BINARY_MINUS = 57402
+const UNARY_MINUS = 57403
+const STAR = 57404
+const RANGE = 57405
+const EXCLUSIVE_RANGE = 57406
+const OR_EQUALS = 57407
+const AND_EQUALS = 57408
+const WHITESPACE = 57409
+const NEWLINE = 57410
+const SEMICOLON = 57411
+const COLON = 57412
+const DOT = 57413
+const PIPE = 57414
+const SLASH = 57415
+const AMPERSAND = 57416
+const QUESTIONMARK = 57417
+const CARET = 57418
+const LBRACKET = 57419
+const RBRACKET = 57420
+const LBRACE = 57421
+const RBRACE = 57422
+const FILE_CONST_REF = 57423
+const LINE_CONST_REF = 57424
+const EOF = 57425
 
 var RubyToknames = [...]string{
 	"$end",
@@ -154,6 +160,7 @@ var RubyToknames = [...]string{
 	"CASE",
 	"WHEN",
 	"ALIAS",
+	"UNDEF",
 	"SUPER",
 	"SELF",
 	"NIL",
@@ -164,6 +171,7 @@ var RubyToknames = [...]string{
 	"BANG",
 	"COMPLEMENT",
 	"BINARY_PLUS",
+	"BINARY_STAR",
 	"UNARY_PLUS",
 	"BINARY_MINUS",
 	"UNARY_MINUS",
@@ -190,939 +198,1008 @@ var RubyToknames = [...]string{
 	"LINE_CONST_REF",
 	"EOF",
 }
+
 var RubyStatenames = [...]string{}
 
 const RubyEofCode = 1
 const RubyErrCode = 2
-const RubyMaxDepth = 200
+const RubyInitialStackSize = 16
 
-//line parser.y:1927
+//line parser.y:1986
 
 //line yacctab:1
-var RubyExca = [...]int{
+var RubyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 132,
-	69, 20,
-	-2, 158,
-	-1, 143,
-	21, 262,
-	23, 262,
-	26, 262,
-	27, 262,
-	28, 262,
-	30, 262,
-	31, 262,
-	32, 262,
-	35, 262,
-	36, 262,
-	38, 262,
-	39, 262,
-	40, 262,
-	44, 262,
-	46, 262,
-	67, 262,
+	-1, 136,
+	71, 23,
+	-2, 166,
+	-1, 147,
+	21, 274,
+	23, 274,
+	26, 274,
+	27, 274,
+	28, 274,
+	30, 274,
+	31, 274,
+	32, 274,
+	35, 274,
+	36, 274,
+	38, 274,
+	39, 274,
+	40, 274,
+	44, 274,
+	46, 274,
+	47, 274,
+	69, 274,
 	-2, 11,
-	-1, 154,
-	21, 13,
-	23, 13,
-	26, 13,
-	27, 13,
-	28, 13,
-	30, 13,
-	31, 13,
-	32, 13,
-	35, 13,
-	36, 13,
-	38, 13,
-	39, 13,
-	40, 13,
-	44, 13,
-	46, 13,
-	67, 13,
+	-1, 159,
+	21, 16,
+	23, 16,
+	26, 16,
+	27, 16,
+	28, 16,
+	30, 16,
+	31, 16,
+	32, 16,
+	35, 16,
+	36, 16,
+	38, 16,
+	39, 16,
+	40, 16,
+	44, 16,
+	46, 16,
+	47, 16,
+	69, 16,
 	-2, 11,
-	-1, 212,
-	21, 262,
-	23, 262,
-	26, 262,
-	27, 262,
-	28, 262,
-	30, 262,
-	31, 262,
-	32, 262,
-	35, 262,
-	36, 262,
-	38, 262,
-	39, 262,
-	40, 262,
-	44, 262,
-	46, 262,
-	67, 262,
-	-2, 11,
-	-1, 216,
-	21, 13,
-	23, 13,
-	26, 13,
-	27, 13,
-	28, 13,
-	30, 13,
-	31, 13,
-	32, 13,
-	35, 13,
-	36, 13,
-	38, 13,
-	39, 13,
-	40, 13,
-	44, 13,
-	46, 13,
-	67, 13,
-	78, 13,
+	-1, 219,
+	21, 274,
+	23, 274,
+	26, 274,
+	27, 274,
+	28, 274,
+	30, 274,
+	31, 274,
+	32, 274,
+	35, 274,
+	36, 274,
+	38, 274,
+	39, 274,
+	40, 274,
+	44, 274,
+	46, 274,
+	47, 274,
+	69, 274,
 	-2, 11,
 	-1, 224,
-	21, 262,
-	23, 262,
-	26, 262,
-	27, 262,
-	28, 262,
-	30, 262,
-	31, 262,
-	32, 262,
-	35, 262,
-	36, 262,
-	38, 262,
-	39, 262,
-	40, 262,
-	44, 262,
-	46, 262,
-	67, 262,
+	21, 16,
+	23, 16,
+	26, 16,
+	27, 16,
+	28, 16,
+	30, 16,
+	31, 16,
+	32, 16,
+	35, 16,
+	36, 16,
+	38, 16,
+	39, 16,
+	40, 16,
+	44, 16,
+	46, 16,
+	47, 16,
+	69, 16,
+	80, 16,
+	-2, 11,
+	-1, 232,
+	21, 274,
+	23, 274,
+	26, 274,
+	27, 274,
+	28, 274,
+	30, 274,
+	31, 274,
+	32, 274,
+	35, 274,
+	36, 274,
+	38, 274,
+	39, 274,
+	40, 274,
+	44, 274,
+	46, 274,
+	47, 274,
+	69, 274,
 	-2, 11,
-	-1, 359,
-	16, 127,
-	-2, 20,
-	-1, 410,
-	66, 11,
-	78, 11,
-	-2, 13,
-	-1, 453,
-	66, 11,
-	78, 11,
-	-2, 13,
-	-1, 570,
-	66, 11,
-	78, 11,
-	-2, 14,
-	-1, 612,
-	16, 136,
+	-1, 428,
+	68, 11,
+	80, 11,
+	-2, 16,
+	-1, 474,
+	68, 11,
+	80, 11,
+	-2, 16,
+	-1, 596,
+	68, 11,
+	80, 11,
+	-2, 17,
+	-1, 637,
+	16, 142,
 	-2, 11,
-	-1, 616,
-	66, 11,
-	78, 11,
-	-2, 14,
+	-1, 642,
+	68, 11,
+	80, 11,
+	-2, 17,
 }
 
-const RubyNprod = 330
 const RubyPrivate = 57344
 
-var RubyTokenNames []string
-var RubyStates []string
-
-const RubyLast = 4877
-
-var RubyAct = [...]int{
-
-	338, 28, 5, 469, 466, 158, 656, 167, 145, 189,
-	265, 157, 383, 427, 146, 345, 300, 55, 144, 467,
-	263, 344, 25, 546, 438, 56, 21, 2, 3, 377,
-	421, 262, 135, 654, 70, 132, 69, 344, 136, 26,
-	137, 138, 4, 101, 344, 95, 102, 297, 419, 640,
-	104, 103, 344, 159, 153, 344, 614, 170, 186, 187,
-	168, 344, 195, 196, 568, 613, 200, 540, 344, 209,
-	404, 279, 381, 538, 199, 153, 96, 97, 344, 178,
-	498, 159, 170, 217, 218, 168, 99, 98, 536, 545,
-	215, 160, 379, 175, 211, 178, 377, 425, 377, 171,
-	377, 100, 226, 227, 228, 229, 152, 73, 72, 172,
-	173, 216, 236, 223, 424, 174, 175, 241, 125, 160,
-	174, 169, 124, 248, 171, 252, 176, 177, 257, 258,
-	259, 260, 216, 198, 123, 270, 377, 170, 174, 377,
-	168, 344, 126, 14, 123, 297, 169, 210, 273, 386,
-	285, 288, 290, 277, 174, 278, 420, 243, 418, 269,
-	402, 301, 131, 251, 159, 297, 255, 271, 630, 373,
-	296, 284, 309, 310, 311, 286, 314, 315, 316, 234,
-	320, 321, 322, 101, 344, 652, 102, 282, 244, 245,
-	104, 103, 601, 599, 159, 156, 380, 109, 602, 376,
-	323, 169, 160, 495, 347, 348, 349, 350, 201, 308,
-	330, 491, 490, 276, 313, 362, 357, 600, 346, 129,
-	185, 355, 130, 156, 298, 506, 626, 627, 572, 505,
-	249, 179, 160, 254, 118, 119, 344, 563, 306, 344,
-	367, 180, 181, 344, 107, 108, 183, 125, 490, 110,
-	368, 111, 344, 112, 280, 122, 489, 184, 464, 127,
-	128, 463, 106, 115, 113, 114, 30, 343, 344, 653,
-	384, 126, 109, 302, 461, 651, 307, 389, 159, 344,
-	382, 312, 361, 182, 179, 387, 365, 650, 344, 324,
-	101, 400, 101, 102, 366, 102, 649, 104, 103, 104,
-	103, 636, 74, 634, 357, 266, 156, 632, 159, 118,
-	119, 205, 264, 266, 206, 133, 160, 268, 409, 107,
-	108, 417, 436, 468, 110, 268, 111, 159, 112, 120,
-	121, 610, 603, 334, 335, 625, 156, 106, 115, 113,
-	114, 117, 560, 435, 282, 266, 160, 101, 553, 401,
-	102, 134, 208, 354, 104, 103, 444, 268, 267, 440,
-	491, 159, 340, 159, 406, 160, 267, 439, 159, 411,
-	456, 413, 669, 415, 666, 665, 452, 455, 327, 317,
-	291, 454, 585, 303, 328, 318, 292, 170, 390, 203,
-	342, 384, 204, 586, 482, 465, 472, 470, 388, 160,
-	477, 160, 664, 486, 666, 665, 160, 488, 279, 101,
-	458, 280, 102, 485, 450, 478, 104, 103, 492, 448,
-	156, 446, 445, 502, 474, 475, 476, 447, 449, 512,
-	501, 329, 319, 293, 531, 441, 532, 442, 185, 526,
-	526, 487, 459, 460, 515, 471, 386, 462, 377, 432,
-	156, 433, 341, 534, 183, 386, 294, 443, 443, 567,
-	436, 434, 550, 352, 551, 552, 141, 78, 351, 156,
-	481, 555, 554, 580, 34, 511, 510, 457, 101, 207,
-	496, 102, 555, 561, 423, 104, 103, 504, 422, 405,
-	565, 566, 509, 620, 511, 510, 587, 392, 394, 621,
-	548, 393, 588, 156, 391, 156, 390, 574, 521, 332,
-	156, 577, 331, 141, 78, 261, 537, 231, 539, 520,
-	541, 140, 543, 496, 544, 358, 161, 141, 78, 589,
-	590, 339, 1, 214, 92, 161, 91, 90, 161, 161,
-	89, 88, 87, 41, 40, 484, 39, 502, 38, 597,
-	527, 20, 564, 43, 161, 44, 16, 12, 13, 161,
-	161, 161, 604, 606, 608, 11, 486, 569, 161, 161,
-	488, 45, 611, 24, 617, 573, 612, 605, 607, 609,
-	161, 23, 161, 161, 22, 161, 27, 161, 161, 161,
-	161, 19, 161, 10, 35, 161, 161, 18, 161, 15,
-	161, 161, 71, 631, 487, 594, 42, 17, 46, 555,
-	633, 555, 635, 555, 637, 161, 37, 36, 31, 47,
-	29, 32, 161, 161, 161, 161, 75, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 0,
-	615, 161, 0, 0, 161, 0, 526, 526, 526, 161,
-	645, 646, 647, 660, 0, 0, 0, 161, 667, 0,
-	0, 0, 0, 629, 0, 0, 671, 161, 0, 526,
-	0, 0, 0, 526, 526, 526, 109, 0, 0, 0,
-	0, 596, 638, 668, 161, 161, 641, 0, 0, 0,
-	0, 672, 673, 0, 0, 0, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 484, 161,
-	0, 0, 0, 118, 119, 0, 0, 0, 662, 161,
-	161, 0, 0, 107, 108, 0, 0, 0, 110, 0,
-	111, 0, 112, 670, 0, 0, 161, 0, 0, 0,
-	0, 106, 115, 113, 114, 0, 0, 0, 576, 0,
-	0, 161, 0, 0, 70, 162, 69, 79, 163, 78,
-	165, 164, 143, 0, 151, 95, 0, 166, 153, 0,
-	0, 0, 0, 161, 0, 0, 0, 161, 0, 161,
-	0, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 81, 0, 0, 0, 94, 96, 97, 93, 0,
-	161, 148, 82, 83, 0, 84, 109, 85, 86, 0,
-	0, 149, 150, 0, 0, 161, 0, 0, 0, 0,
-	0, 161, 0, 147, 0, 154, 0, 73, 72, 0,
-	9, 0, 0, 0, 161, 0, 161, 0, 0, 0,
-	161, 161, 0, 118, 119, 161, 0, 0, 0, 0,
-	0, 0, 0, 107, 108, 109, 0, 0, 110, 0,
-	111, 0, 112, 0, 161, 0, 0, 0, 0, 0,
-	0, 106, 115, 113, 114, 0, 161, 0, 575, 0,
-	0, 161, 155, 0, 0, 0, 0, 0, 0, 0,
-	161, 190, 118, 119, 197, 202, 0, 0, 0, 161,
-	161, 0, 107, 108, 0, 0, 0, 110, 0, 111,
-	213, 112, 120, 121, 0, 219, 220, 221, 161, 0,
-	106, 115, 113, 114, 222, 225, 161, 503, 0, 0,
-	0, 0, 0, 0, 161, 161, 230, 161, 232, 233,
-	0, 235, 0, 237, 238, 239, 240, 0, 242, 0,
-	0, 246, 247, 0, 250, 0, 253, 256, 0, 0,
+const RubyLast = 5483
+
+var RubyAct = [...]int16{
+	353, 172, 5, 683, 149, 446, 487, 314, 162, 163,
+	274, 490, 194, 277, 399, 457, 362, 275, 156, 148,
+	56, 488, 25, 21, 361, 57, 571, 18, 2, 3,
+	361, 139, 158, 72, 136, 71, 681, 140, 103, 141,
+	142, 104, 667, 4, 97, 106, 105, 361, 440, 438,
+	361, 421, 291, 361, 361, 361, 158, 563, 175, 191,
+	192, 173, 640, 200, 201, 594, 567, 565, 133, 150,
+	361, 134, 397, 395, 638, 393, 98, 99, 204, 393,
+	180, 183, 523, 101, 100, 225, 226, 183, 223, 311,
+	28, 224, 14, 175, 128, 393, 173, 129, 102, 393,
+	393, 176, 393, 179, 236, 237, 238, 239, 75, 74,
+	131, 132, 177, 178, 246, 224, 231, 127, 175, 180,
+	252, 173, 130, 444, 174, 443, 259, 179, 127, 264,
+	181, 182, 269, 270, 271, 272, 176, 570, 402, 282,
+	129, 439, 179, 164, 405, 161, 261, 281, 179, 266,
+	283, 218, 35, 289, 678, 290, 205, 437, 206, 174,
+	406, 419, 396, 361, 392, 130, 310, 311, 135, 315,
+	292, 518, 164, 311, 161, 296, 298, 324, 325, 326,
+	299, 329, 330, 331, 174, 335, 336, 337, 593, 389,
+	627, 361, 103, 322, 520, 104, 363, 626, 327, 106,
+	105, 625, 598, 588, 513, 167, 339, 361, 338, 514,
+	364, 365, 366, 367, 167, 345, 531, 167, 167, 461,
+	462, 103, 530, 378, 104, 372, 190, 374, 106, 105,
+	363, 26, 361, 512, 167, 485, 285, 652, 653, 167,
+	167, 167, 31, 297, 301, 303, 383, 513, 167, 167,
+	361, 484, 125, 361, 361, 361, 361, 164, 482, 161,
+	167, 384, 167, 167, 189, 167, 651, 167, 167, 167,
+	167, 167, 188, 167, 184, 278, 167, 167, 400, 278,
+	167, 184, 167, 167, 165, 185, 186, 280, 164, 342,
+	161, 280, 137, 398, 403, 343, 332, 167, 76, 164,
+	211, 161, 333, 212, 167, 167, 167, 167, 103, 371,
+	187, 104, 558, 165, 559, 106, 105, 677, 464, 167,
+	465, 676, 374, 675, 167, 304, 164, 167, 161, 427,
+	279, 305, 167, 209, 404, 466, 210, 292, 368, 103,
+	167, 466, 104, 661, 344, 659, 106, 105, 138, 657,
+	167, 334, 254, 358, 359, 455, 489, 635, 417, 263,
+	278, 167, 267, 628, 585, 578, 454, 276, 215, 167,
+	167, 611, 280, 458, 459, 460, 467, 463, 514, 164,
+	306, 161, 612, 294, 356, 357, 477, 355, 167, 476,
+	308, 436, 103, 317, 167, 104, 504, 167, 165, 106,
+	105, 492, 402, 400, 291, 478, 323, 471, 167, 167,
+	407, 328, 164, 486, 161, 279, 491, 469, 493, 499,
+	496, 497, 498, 190, 393, 188, 167, 507, 510, 165,
+	402, 164, 515, 161, 500, 527, 175, 349, 350, 526,
+	165, 167, 451, 694, 452, 691, 690, 473, 537, 307,
+	126, 646, 475, 455, 453, 540, 613, 647, 551, 551,
+	551, 551, 614, 145, 80, 167, 592, 165, 164, 167,
+	161, 167, 167, 164, 167, 161, 260, 411, 214, 561,
+	410, 103, 213, 575, 104, 576, 577, 442, 106, 105,
+	441, 422, 409, 167, 689, 579, 691, 690, 408, 606,
+	580, 536, 535, 534, 407, 536, 535, 586, 167, 508,
+	347, 506, 580, 590, 591, 346, 167, 546, 144, 360,
+	165, 369, 145, 80, 145, 80, 273, 241, 545, 354,
+	167, 1, 600, 222, 167, 167, 603, 94, 93, 167,
+	92, 91, 90, 89, 42, 41, 40, 39, 157, 552,
+	294, 20, 44, 165, 615, 616, 45, 16, 167, 167,
+	12, 13, 11, 46, 24, 23, 22, 27, 527, 19,
+	10, 167, 165, 623, 36, 15, 167, 73, 43, 17,
+	47, 38, 37, 32, 48, 30, 167, 29, 33, 629,
+	631, 633, 77, 636, 0, 0, 167, 167, 637, 510,
+	643, 0, 0, 0, 630, 632, 634, 0, 0, 165,
+	0, 0, 0, 0, 165, 0, 0, 0, 479, 167,
+	0, 0, 0, 0, 0, 0, 0, 0, 656, 0,
+	221, 0, 0, 167, 167, 0, 167, 658, 0, 660,
+	9, 662, 580, 0, 580, 233, 580, 0, 0, 0,
+	509, 0, 0, 0, 0, 0, 0, 622, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 672, 673, 674,
+	0, 0, 0, 551, 551, 551, 0, 687, 0, 0,
+	508, 0, 506, 0, 0, 692, 0, 0, 0, 0,
+	0, 695, 0, 160, 551, 0, 0, 551, 551, 551,
+	0, 693, 195, 0, 0, 202, 207, 696, 697, 0,
+	0, 698, 0, 573, 0, 0, 0, 167, 0, 167,
+	0, 216, 220, 0, 0, 0, 0, 227, 228, 229,
+	0, 0, 0, 0, 0, 0, 230, 234, 0, 0,
+	0, 0, 167, 0, 0, 0, 167, 0, 240, 0,
+	242, 243, 0, 245, 0, 247, 248, 249, 250, 251,
+	0, 253, 0, 0, 257, 258, 375, 0, 262, 0,
+	265, 268, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 203, 0, 0, 0,
+	233, 167, 293, 295, 300, 302, 0, 0, 111, 0,
+	0, 217, 0, 0, 0, 0, 0, 160, 0, 0,
+	0, 0, 320, 0, 0, 268, 0, 0, 0, 0,
+	268, 509, 233, 0, 0, 0, 0, 0, 234, 0,
+	0, 0, 0, 244, 0, 121, 122, 221, 160, 0,
+	0, 0, 0, 255, 256, 0, 109, 110, 0, 160,
+	111, 112, 115, 0, 113, 0, 114, 370, 376, 0,
+	0, 375, 0, 0, 0, 108, 118, 116, 117, 288,
+	221, 0, 679, 0, 0, 0, 160, 0, 0, 0,
+	0, 312, 234, 0, 0, 387, 0, 121, 122, 0,
+	0, 0, 0, 0, 0, 321, 390, 391, 109, 110,
+	0, 0, 0, 112, 115, 0, 113, 0, 114, 123,
+	124, 0, 0, 0, 234, 0, 0, 108, 118, 116,
+	117, 0, 0, 0, 528, 0, 221, 0, 0, 220,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 111,
+	0, 377, 0, 0, 0, 0, 381, 0, 0, 0,
+	0, 0, 0, 428, 382, 0, 0, 432, 0, 434,
+	435, 119, 220, 0, 0, 0, 0, 511, 107, 0,
+	0, 0, 516, 0, 0, 0, 121, 122, 0, 0,
+	0, 160, 0, 0, 0, 0, 0, 109, 110, 0,
+	0, 0, 112, 115, 0, 113, 456, 114, 123, 124,
+	0, 0, 0, 0, 195, 0, 108, 118, 116, 117,
+	120, 0, 111, 418, 0, 0, 0, 0, 220, 0,
+	0, 0, 474, 220, 0, 0, 0, 268, 313, 423,
+	424, 0, 0, 0, 0, 429, 0, 431, 0, 433,
+	0, 0, 0, 0, 0, 0, 494, 495, 0, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 0, 505,
+	109, 110, 0, 0, 517, 112, 115, 0, 113, 0,
+	114, 123, 124, 0, 376, 0, 0, 0, 0, 108,
+	118, 116, 117, 0, 532, 533, 420, 0, 0, 193,
+	0, 468, 0, 0, 0, 0, 470, 472, 72, 235,
+	71, 81, 198, 80, 141, 199, 232, 195, 0, 97,
+	480, 481, 158, 0, 0, 483, 0, 0, 0, 0,
+	0, 572, 574, 0, 517, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 83, 0, 0, 511, 503,
+	96, 98, 99, 95, 0, 0, 385, 84, 85, 0,
+	521, 86, 34, 87, 88, 0, 0, 0, 529, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 386,
+	0, 159, 284, 75, 74, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 309, 0, 0,
+	0, 564, 0, 566, 0, 568, 521, 569, 0, 0,
+	0, 0, 0, 143, 146, 621, 0, 376, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 196, 0, 0,
+	348, 0, 0, 0, 0, 0, 589, 0, 0, 0,
+	505, 0, 0, 0, 639, 0, 0, 0, 0, 196,
+	196, 196, 0, 0, 595, 0, 0, 0, 196, 196,
+	0, 0, 599, 0, 0, 0, 0, 0, 0, 0,
+	196, 0, 196, 196, 0, 196, 0, 196, 196, 196,
+	196, 196, 0, 196, 0, 0, 196, 196, 0, 664,
+	196, 0, 196, 196, 620, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 111, 0,
+	0, 0, 0, 401, 196, 196, 196, 196, 0, 0,
+	0, 0, 412, 0, 0, 415, 0, 0, 641, 0,
+	0, 0, 0, 0, 196, 0, 0, 196, 0, 0,
+	0, 0, 196, 0, 0, 121, 122, 111, 0, 426,
+	196, 655, 0, 430, 0, 0, 109, 110, 0, 0,
+	0, 112, 115, 0, 113, 0, 114, 123, 124, 663,
+	0, 665, 0, 0, 668, 108, 118, 116, 117, 0,
+	196, 0, 394, 0, 121, 122, 0, 0, 449, 450,
+	0, 111, 0, 0, 0, 109, 110, 0, 680, 0,
+	112, 115, 0, 113, 196, 114, 0, 196, 0, 0,
+	0, 0, 0, 0, 108, 118, 116, 117, 196, 196,
+	0, 602, 0, 0, 0, 0, 0, 0, 121, 122,
+	0, 0, 0, 0, 0, 0, 196, 0, 0, 109,
+	110, 0, 0, 0, 112, 115, 0, 113, 414, 114,
+	0, 0, 0, 501, 0, 0, 0, 0, 108, 118,
+	116, 117, 0, 111, 316, 601, 0, 522, 0, 0,
+	525, 0, 0, 0, 0, 196, 0, 0, 0, 196,
+	0, 196, 196, 0, 0, 0, 0, 538, 0, 0,
+	0, 542, 543, 0, 544, 0, 0, 0, 0, 0,
+	121, 122, 0, 0, 560, 0, 562, 0, 0, 0,
+	0, 109, 110, 522, 0, 0, 112, 115, 196, 113,
+	0, 114, 123, 124, 111, 0, 196, 0, 581, 0,
+	108, 118, 116, 117, 120, 582, 583, 584, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 196,
+	0, 0, 0, 107, 0, 0, 0, 0, 0, 0,
+	0, 121, 122, 0, 0, 597, 111, 0, 196, 196,
+	0, 0, 109, 110, 0, 604, 605, 112, 115, 0,
+	113, 0, 114, 0, 610, 0, 196, 0, 0, 0,
+	0, 108, 118, 116, 117, 120, 196, 0, 617, 0,
+	619, 0, 111, 121, 122, 0, 196, 196, 0, 0,
+	0, 0, 0, 0, 109, 110, 0, 0, 547, 112,
+	115, 0, 113, 0, 114, 123, 124, 0, 0, 196,
+	0, 0, 388, 108, 118, 116, 117, 0, 0, 121,
+	122, 0, 644, 196, 196, 0, 196, 645, 0, 0,
+	109, 110, 649, 650, 348, 112, 115, 0, 113, 0,
+	114, 123, 124, 0, 0, 0, 0, 0, 0, 108,
+	118, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 670, 671, 0, 0, 0,
+	0, 449, 450, 0, 0, 0, 0, 0, 72, 52,
+	71, 81, 53, 80, 55, 54, 82, 0, 0, 97,
+	0, 0, 0, 49, 686, 553, 685, 684, 554, 50,
+	51, 0, 62, 63, 60, 0, 0, 66, 67, 196,
+	68, 65, 61, 0, 0, 83, 64, 0, 69, 70,
+	96, 98, 99, 95, 0, 0, 0, 84, 85, 0,
+	0, 86, 0, 87, 88, 0, 196, 0, 0, 0,
+	549, 550, 0, 0, 0, 0, 0, 0, 0, 78,
+	0, 79, 0, 75, 74, 648, 0, 0, 72, 52,
+	71, 81, 53, 80, 55, 54, 82, 0, 0, 97,
+	0, 0, 0, 49, 682, 553, 685, 684, 554, 50,
+	51, 196, 62, 63, 60, 0, 0, 66, 67, 0,
+	68, 65, 61, 0, 0, 83, 64, 0, 69, 70,
+	96, 98, 99, 95, 0, 0, 0, 84, 85, 0,
+	0, 86, 0, 87, 88, 0, 0, 0, 0, 0,
+	549, 550, 0, 0, 0, 0, 0, 0, 0, 78,
+	0, 79, 0, 75, 74, 72, 52, 71, 81, 53,
+	80, 55, 54, 82, 0, 0, 97, 0, 0, 0,
+	49, 539, 58, 448, 447, 59, 50, 51, 0, 62,
+	63, 60, 0, 0, 66, 67, 0, 68, 65, 61,
+	0, 0, 83, 64, 0, 69, 70, 96, 98, 99,
+	95, 0, 0, 0, 84, 85, 0, 0, 86, 0,
+	87, 88, 0, 0, 0, 0, 0, 351, 352, 0,
+	0, 0, 0, 0, 0, 0, 78, 0, 79, 0,
+	75, 74, 72, 52, 71, 81, 53, 80, 55, 54,
+	82, 0, 0, 97, 0, 0, 0, 49, 445, 58,
+	448, 447, 59, 50, 51, 0, 62, 63, 60, 0,
+	0, 66, 67, 0, 68, 65, 61, 0, 0, 83,
+	64, 0, 69, 70, 96, 98, 99, 95, 0, 0,
+	0, 84, 85, 0, 0, 86, 0, 87, 88, 0,
+	0, 0, 0, 0, 351, 352, 0, 0, 0, 0,
+	0, 0, 0, 78, 0, 79, 0, 75, 74, 72,
+	52, 71, 81, 53, 80, 55, 54, 82, 0, 0,
+	97, 0, 0, 0, 49, 0, 58, 0, 0, 59,
+	50, 51, 0, 62, 63, 60, 455, 489, 66, 67,
+	0, 68, 65, 61, 0, 0, 83, 64, 0, 69,
+	70, 96, 98, 99, 95, 0, 0, 0, 84, 85,
+	0, 0, 86, 0, 87, 88, 0, 0, 0, 0,
+	0, 351, 352, 0, 0, 0, 0, 0, 0, 0,
+	78, 0, 79, 0, 75, 74, 72, 52, 71, 81,
+	53, 80, 55, 54, 82, 0, 0, 97, 0, 0,
+	0, 49, 607, 58, 0, 0, 59, 50, 51, 0,
+	62, 63, 60, 0, 608, 66, 67, 0, 68, 65,
+	61, 0, 0, 83, 64, 0, 69, 70, 96, 98,
+	99, 95, 0, 0, 0, 84, 85, 0, 0, 86,
+	0, 87, 88, 0, 0, 0, 0, 0, 351, 352,
+	0, 0, 0, 0, 0, 0, 0, 78, 0, 79,
+	0, 75, 74, 72, 52, 71, 81, 53, 80, 55,
+	54, 82, 0, 0, 97, 0, 0, 0, 49, 0,
+	58, 0, 0, 59, 50, 51, 0, 62, 63, 60,
+	0, 0, 66, 67, 0, 68, 65, 61, 0, 0,
+	83, 64, 0, 69, 70, 96, 98, 99, 95, 0,
+	0, 0, 84, 85, 0, 0, 86, 0, 87, 88,
+	0, 0, 0, 0, 0, 6, 7, 0, 0, 0,
+	0, 0, 0, 0, 78, 0, 79, 0, 75, 74,
+	8, 72, 52, 71, 81, 53, 80, 55, 54, 82,
+	0, 0, 97, 0, 0, 0, 49, 688, 553, 0,
+	0, 554, 50, 51, 0, 62, 63, 60, 0, 0,
+	66, 67, 0, 68, 65, 61, 0, 0, 83, 64,
+	0, 69, 70, 96, 98, 99, 95, 0, 0, 0,
+	84, 85, 0, 0, 86, 0, 87, 88, 0, 0,
+	0, 0, 0, 549, 550, 0, 0, 0, 0, 0,
+	0, 0, 78, 0, 79, 0, 75, 74, 72, 52,
+	71, 81, 53, 80, 55, 54, 82, 0, 0, 97,
+	0, 0, 0, 49, 669, 58, 0, 0, 59, 50,
+	51, 0, 62, 63, 60, 0, 0, 66, 67, 0,
+	68, 65, 61, 0, 0, 83, 64, 0, 69, 70,
+	96, 98, 99, 95, 0, 0, 0, 84, 85, 0,
+	0, 86, 0, 87, 88, 0, 0, 0, 0, 0,
+	351, 352, 0, 0, 0, 0, 0, 0, 0, 78,
+	0, 79, 0, 75, 74, 72, 52, 71, 81, 53,
+	80, 55, 54, 82, 0, 0, 97, 0, 0, 0,
+	49, 654, 58, 0, 0, 59, 50, 51, 0, 62,
+	63, 60, 0, 0, 66, 67, 0, 68, 65, 61,
+	0, 0, 83, 64, 0, 69, 70, 96, 98, 99,
+	95, 0, 0, 0, 84, 85, 0, 0, 86, 0,
+	87, 88, 0, 0, 0, 0, 0, 351, 352, 0,
+	0, 0, 0, 0, 0, 0, 78, 0, 79, 0,
+	75, 74, 72, 52, 71, 81, 53, 80, 55, 54,
+	82, 0, 0, 97, 0, 0, 0, 49, 618, 58,
+	0, 0, 59, 50, 51, 0, 62, 63, 60, 0,
+	0, 66, 67, 0, 68, 65, 61, 0, 0, 83,
+	64, 0, 69, 70, 96, 98, 99, 95, 0, 0,
+	0, 84, 85, 0, 0, 86, 0, 87, 88, 0,
+	0, 0, 0, 0, 351, 352, 0, 0, 0, 0,
+	0, 0, 0, 78, 0, 79, 0, 75, 74, 72,
+	52, 71, 81, 53, 80, 55, 54, 82, 0, 0,
+	97, 0, 0, 0, 49, 609, 58, 0, 0, 59,
+	50, 51, 0, 62, 63, 60, 0, 0, 66, 67,
+	0, 68, 65, 61, 0, 0, 83, 64, 0, 69,
+	70, 96, 98, 99, 95, 0, 0, 0, 84, 85,
+	0, 0, 86, 0, 87, 88, 0, 0, 0, 0,
+	0, 351, 352, 0, 0, 0, 0, 0, 0, 0,
+	78, 0, 79, 0, 75, 74, 72, 52, 71, 81,
+	53, 80, 55, 54, 82, 0, 0, 97, 0, 0,
+	0, 49, 587, 58, 0, 0, 59, 50, 51, 0,
+	62, 63, 60, 0, 0, 66, 67, 0, 68, 65,
+	61, 0, 0, 83, 64, 0, 69, 70, 96, 98,
+	99, 95, 0, 0, 0, 84, 85, 0, 0, 86,
+	0, 87, 88, 0, 0, 0, 0, 0, 351, 352,
+	0, 0, 0, 0, 0, 0, 0, 78, 0, 79,
+	0, 75, 74, 72, 52, 71, 81, 53, 80, 55,
+	54, 82, 0, 0, 97, 0, 0, 0, 49, 557,
+	553, 0, 0, 554, 50, 51, 0, 62, 63, 60,
+	0, 0, 66, 67, 0, 68, 65, 61, 0, 0,
+	83, 64, 0, 69, 70, 96, 98, 99, 95, 0,
+	0, 0, 84, 85, 0, 0, 86, 0, 87, 88,
+	0, 0, 0, 0, 0, 549, 550, 0, 0, 0,
+	0, 0, 0, 0, 78, 0, 79, 0, 75, 74,
+	72, 52, 71, 81, 53, 80, 55, 54, 82, 0,
+	0, 97, 0, 0, 0, 49, 556, 553, 0, 0,
+	554, 50, 51, 0, 62, 63, 60, 0, 0, 66,
+	67, 0, 68, 65, 61, 0, 0, 83, 64, 0,
+	69, 70, 96, 98, 99, 95, 0, 0, 0, 84,
+	85, 0, 0, 86, 0, 87, 88, 0, 0, 0,
+	0, 0, 549, 550, 0, 0, 0, 0, 0, 0,
+	0, 78, 0, 79, 0, 75, 74, 72, 52, 71,
+	81, 53, 80, 55, 54, 82, 0, 0, 97, 0,
+	0, 0, 49, 555, 553, 0, 0, 554, 50, 51,
+	0, 62, 63, 60, 0, 0, 66, 67, 0, 68,
+	65, 61, 0, 0, 83, 64, 0, 69, 70, 96,
+	98, 99, 95, 0, 0, 0, 84, 85, 0, 0,
+	86, 0, 87, 88, 0, 0, 0, 0, 0, 549,
+	550, 0, 0, 0, 0, 0, 0, 0, 78, 0,
+	79, 0, 75, 74, 72, 52, 71, 81, 53, 80,
+	55, 54, 82, 0, 0, 97, 0, 0, 0, 49,
+	548, 553, 0, 0, 554, 50, 51, 0, 62, 63,
+	60, 0, 0, 66, 67, 0, 68, 65, 61, 0,
+	0, 83, 64, 0, 69, 70, 96, 98, 99, 95,
+	0, 0, 0, 84, 85, 0, 0, 86, 0, 87,
+	88, 0, 0, 0, 0, 0, 549, 550, 0, 0,
+	0, 0, 0, 0, 0, 78, 0, 79, 0, 75,
+	74, 72, 52, 71, 81, 53, 80, 55, 54, 82,
+	0, 0, 97, 0, 0, 0, 49, 541, 58, 0,
+	0, 59, 50, 51, 0, 62, 63, 60, 0, 0,
+	66, 67, 0, 68, 65, 61, 0, 0, 83, 64,
+	0, 69, 70, 96, 98, 99, 95, 0, 0, 0,
+	84, 85, 0, 0, 86, 0, 87, 88, 0, 0,
+	0, 0, 0, 351, 352, 0, 0, 0, 0, 0,
+	0, 0, 78, 0, 79, 0, 75, 74, 72, 52,
+	71, 81, 53, 80, 55, 54, 82, 0, 0, 97,
+	0, 0, 0, 49, 0, 58, 0, 0, 59, 50,
+	51, 0, 62, 63, 60, 0, 0, 66, 67, 0,
+	68, 65, 61, 0, 0, 83, 64, 0, 69, 70,
+	96, 98, 99, 95, 0, 0, 0, 84, 85, 0,
+	0, 86, 0, 87, 88, 0, 0, 0, 0, 0,
+	351, 352, 0, 0, 0, 0, 0, 0, 0, 78,
+	0, 79, 524, 75, 74, 72, 52, 71, 81, 53,
+	80, 55, 54, 82, 0, 0, 97, 0, 0, 0,
+	49, 519, 58, 0, 0, 59, 50, 51, 0, 62,
+	63, 60, 0, 0, 66, 67, 0, 68, 65, 61,
+	0, 0, 83, 64, 0, 69, 70, 96, 98, 99,
+	95, 0, 0, 0, 84, 85, 0, 0, 86, 0,
+	87, 88, 0, 0, 0, 0, 0, 351, 352, 0,
+	0, 0, 0, 0, 0, 0, 78, 0, 79, 0,
+	75, 74, 72, 52, 71, 81, 53, 80, 55, 54,
+	82, 0, 0, 97, 0, 0, 0, 49, 502, 58,
+	0, 0, 59, 50, 51, 0, 62, 63, 60, 0,
+	0, 66, 67, 0, 68, 65, 61, 0, 0, 83,
+	64, 0, 69, 70, 96, 98, 99, 95, 0, 0,
+	0, 84, 85, 0, 0, 86, 0, 87, 88, 0,
+	0, 0, 0, 0, 351, 352, 0, 0, 0, 0,
+	0, 0, 0, 78, 0, 79, 0, 75, 74, 72,
+	52, 71, 81, 53, 80, 55, 54, 82, 0, 0,
+	97, 0, 0, 0, 49, 425, 58, 0, 0, 59,
+	50, 51, 0, 62, 63, 60, 0, 0, 66, 67,
+	0, 68, 65, 61, 0, 0, 83, 64, 0, 69,
+	70, 96, 98, 99, 95, 0, 0, 0, 84, 85,
+	0, 0, 86, 0, 87, 88, 0, 0, 0, 0,
+	0, 351, 352, 0, 0, 0, 0, 0, 0, 0,
+	78, 0, 79, 0, 75, 74, 72, 52, 71, 81,
+	53, 80, 55, 54, 82, 0, 0, 97, 0, 0,
+	0, 49, 416, 58, 0, 0, 59, 50, 51, 0,
+	62, 63, 60, 0, 0, 66, 67, 0, 68, 65,
+	61, 0, 0, 83, 64, 0, 69, 70, 96, 98,
+	99, 95, 0, 0, 0, 84, 85, 0, 0, 86,
+	0, 87, 88, 0, 0, 0, 0, 0, 351, 352,
+	0, 0, 0, 0, 0, 0, 0, 78, 0, 79,
+	0, 75, 74, 72, 52, 71, 81, 53, 80, 55,
+	54, 82, 0, 0, 97, 0, 0, 0, 49, 413,
+	58, 0, 0, 59, 50, 51, 0, 62, 63, 60,
+	0, 0, 66, 67, 0, 68, 65, 61, 0, 0,
+	83, 64, 0, 69, 70, 96, 98, 99, 95, 0,
+	0, 0, 84, 85, 0, 0, 86, 0, 87, 88,
+	0, 0, 0, 0, 0, 351, 352, 0, 0, 0,
+	0, 0, 0, 0, 78, 0, 79, 0, 75, 74,
+	72, 52, 71, 81, 53, 80, 55, 54, 82, 0,
+	0, 97, 0, 0, 0, 49, 0, 553, 0, 0,
+	554, 50, 51, 0, 62, 63, 60, 0, 0, 66,
+	67, 0, 68, 65, 61, 0, 0, 83, 64, 0,
+	69, 70, 96, 98, 99, 95, 0, 0, 0, 84,
+	85, 0, 0, 86, 0, 87, 88, 0, 0, 0,
+	0, 0, 549, 550, 0, 0, 0, 0, 0, 0,
+	0, 78, 0, 79, 0, 75, 74, 72, 52, 71,
+	81, 53, 80, 55, 54, 82, 0, 0, 97, 0,
+	0, 0, 49, 0, 58, 0, 0, 59, 50, 51,
+	0, 62, 63, 60, 0, 0, 66, 67, 0, 68,
+	65, 61, 0, 0, 83, 64, 0, 69, 70, 96,
+	98, 99, 95, 0, 0, 0, 84, 85, 0, 0,
+	86, 0, 87, 88, 0, 0, 0, 0, 0, 351,
+	352, 0, 0, 0, 0, 0, 0, 0, 78, 0,
+	79, 0, 75, 74, 72, 52, 71, 81, 53, 80,
+	55, 54, 82, 0, 0, 97, 0, 0, 0, 49,
+	0, 58, 0, 0, 59, 50, 51, 0, 62, 63,
+	60, 0, 0, 66, 67, 0, 68, 65, 61, 0,
+	0, 83, 64, 0, 69, 70, 96, 98, 99, 95,
+	0, 0, 0, 84, 85, 0, 0, 86, 0, 87,
+	88, 0, 0, 0, 0, 0, 642, 352, 0, 0,
+	0, 0, 0, 0, 0, 78, 0, 79, 0, 75,
+	74, 72, 52, 71, 81, 53, 80, 55, 54, 82,
+	0, 0, 97, 0, 0, 0, 49, 0, 58, 0,
+	0, 59, 50, 51, 0, 62, 63, 60, 0, 0,
+	66, 67, 0, 68, 65, 61, 0, 0, 83, 64,
+	0, 69, 70, 96, 98, 99, 95, 0, 0, 0,
+	84, 85, 0, 0, 86, 0, 87, 88, 0, 0,
+	0, 0, 0, 596, 352, 0, 0, 0, 0, 0,
+	0, 0, 78, 0, 79, 0, 75, 74, 72, 52,
+	71, 81, 53, 80, 55, 54, 82, 380, 0, 97,
+	0, 0, 0, 49, 0, 58, 0, 0, 59, 50,
+	51, 0, 62, 63, 60, 0, 0, 66, 67, 0,
+	68, 65, 61, 0, 0, 83, 64, 0, 69, 70,
+	96, 98, 99, 95, 0, 0, 0, 84, 85, 0,
+	0, 86, 0, 87, 88, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 78,
+	0, 79, 0, 75, 74, 72, 52, 71, 81, 53,
+	80, 55, 54, 82, 0, 0, 97, 0, 0, 0,
+	49, 0, 58, 0, 0, 59, 50, 51, 0, 62,
+	63, 60, 0, 0, 66, 67, 0, 68, 65, 61,
+	0, 0, 83, 64, 0, 69, 70, 96, 98, 99,
+	95, 0, 0, 0, 84, 85, 0, 0, 86, 0,
+	87, 88, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 78, 0, 79, 0,
+	75, 74, 72, 52, 71, 81, 53, 80, 55, 54,
+	82, 0, 0, 97, 0, 0, 0, 49, 0, 58,
+	0, 0, 59, 50, 51, 0, 62, 63, 60, 0,
+	0, 66, 67, 0, 68, 65, 61, 0, 0, 83,
+	64, 0, 69, 70, 96, 98, 99, 95, 0, 0,
+	0, 84, 85, 0, 0, 86, 0, 87, 88, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 78, 0, 79, 0, 75, 74, 72,
+	166, 71, 81, 168, 80, 170, 169, 147, 0, 155,
+	97, 0, 171, 158, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 0, 0, 0,
+	0, 96, 98, 99, 95, 0, 0, 152, 84, 85,
+	0, 0, 86, 0, 87, 88, 0, 0, 153, 154,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	109, 274, 0, 0, 0, 0, 0, 0, 281, 283,
-	287, 289, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 155, 0, 33, 0, 305, 0, 0,
-	256, 0, 0, 0, 0, 256, 0, 118, 119, 0,
-	0, 0, 161, 225, 161, 0, 0, 107, 108, 0,
-	0, 0, 110, 155, 111, 0, 112, 120, 121, 0,
-	0, 109, 0, 0, 0, 106, 115, 113, 114, 161,
-	353, 360, 403, 0, 0, 139, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 191, 0, 0, 191,
-	105, 0, 225, 0, 0, 371, 0, 0, 118, 119,
-	0, 0, 0, 0, 0, 374, 375, 0, 107, 108,
-	191, 191, 191, 110, 0, 111, 0, 112, 161, 191,
-	191, 0, 225, 0, 0, 0, 106, 115, 113, 114,
-	117, 191, 0, 191, 191, 161, 191, 213, 191, 191,
-	191, 191, 0, 191, 0, 188, 191, 191, 0, 191,
-	109, 191, 191, 0, 0, 0, 0, 0, 0, 410,
-	0, 0, 0, 414, 0, 416, 191, 213, 0, 0,
-	0, 0, 0, 191, 191, 191, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 155, 118, 119, 0,
-	0, 0, 191, 0, 0, 191, 0, 107, 108, 0,
-	191, 437, 110, 0, 111, 0, 112, 190, 191, 0,
-	0, 0, 0, 0, 0, 106, 115, 113, 114, 117,
-	155, 0, 213, 0, 0, 272, 453, 213, 275, 0,
-	0, 256, 0, 0, 0, 0, 191, 0, 0, 295,
-	70, 162, 69, 79, 163, 78, 165, 164, 143, 0,
-	473, 95, 0, 166, 153, 0, 0, 191, 0, 0,
-	191, 0, 483, 0, 0, 0, 0, 493, 0, 0,
-	191, 191, 333, 0, 0, 0, 360, 81, 0, 0,
-	0, 94, 96, 97, 93, 507, 508, 191, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 397,
-	0, 0, 451, 0, 190, 0, 0, 0, 0, 304,
-	0, 154, 542, 73, 72, 0, 0, 0, 0, 0,
-	547, 549, 0, 493, 191, 0, 0, 0, 191, 0,
-	191, 0, 0, 0, 0, 0, 70, 162, 69, 79,
-	163, 78, 165, 164, 143, 0, 151, 95, 385, 166,
-	153, 0, 0, 0, 0, 0, 0, 395, 0, 0,
-	398, 0, 0, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 191, 81, 0, 0, 0, 94, 96, 97,
-	93, 109, 408, 0, 82, 83, 412, 84, 0, 85,
-	86, 191, 0, 0, 0, 0, 191, 0, 595, 0,
-	360, 0, 0, 0, 0, 304, 0, 154, 0, 73,
-	72, 0, 0, 0, 0, 191, 0, 0, 118, 119,
-	430, 431, 0, 0, 0, 483, 0, 0, 107, 108,
-	0, 0, 191, 110, 0, 111, 0, 112, 120, 121,
-	0, 191, 0, 0, 0, 0, 106, 115, 113, 114,
-	191, 191, 0, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 522, 0, 0, 0, 0, 0, 0, 191,
-	0, 0, 0, 0, 639, 0, 0, 191, 0, 0,
-	0, 0, 0, 479, 0, 191, 191, 0, 191, 0,
-	0, 648, 0, 0, 0, 0, 497, 0, 0, 500,
+	151, 0, 159, 0, 75, 74, 72, 166, 71, 81,
+	168, 80, 170, 169, 147, 0, 0, 97, 0, 171,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 513, 0, 0, 0, 517,
-	518, 0, 519, 0, 0, 0, 0, 0, 533, 0,
-	535, 0, 0, 0, 0, 0, 0, 0, 0, 497,
-	0, 0, 70, 162, 69, 79, 163, 78, 165, 164,
-	143, 0, 0, 95, 556, 166, 153, 0, 0, 0,
-	557, 558, 559, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
-	0, 0, 0, 94, 96, 97, 93, 571, 0, 148,
-	82, 83, 0, 84, 0, 85, 86, 578, 579, 0,
-	0, 0, 0, 0, 0, 0, 584, 0, 0, 0,
-	0, 304, 622, 154, 0, 73, 72, 0, 591, 0,
-	593, 0, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 116, 0, 0, 0,
-	0, 0, 0, 105, 0, 0, 191, 0, 0, 0,
-	0, 118, 119, 618, 0, 0, 0, 0, 619, 0,
-	0, 107, 108, 623, 624, 333, 110, 0, 111, 0,
-	112, 120, 121, 0, 0, 0, 0, 0, 0, 106,
-	115, 113, 114, 117, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 643, 644, 0, 0,
-	0, 0, 430, 431, 70, 51, 69, 79, 52, 78,
-	54, 53, 80, 0, 0, 95, 0, 0, 0, 48,
-	659, 528, 658, 657, 529, 49, 50, 0, 61, 62,
-	59, 0, 0, 65, 66, 0, 67, 64, 60, 0,
-	0, 81, 63, 0, 68, 94, 96, 97, 93, 0,
-	0, 0, 82, 83, 0, 84, 0, 85, 86, 0,
-	0, 0, 0, 0, 524, 525, 0, 0, 0, 0,
-	0, 0, 0, 76, 0, 77, 0, 73, 72, 70,
-	51, 69, 79, 52, 78, 54, 53, 80, 0, 0,
-	95, 0, 0, 0, 48, 655, 528, 658, 657, 529,
-	49, 50, 0, 61, 62, 59, 0, 0, 65, 66,
-	0, 67, 64, 60, 0, 0, 81, 63, 0, 68,
-	94, 96, 97, 93, 0, 0, 0, 82, 83, 0,
-	84, 0, 85, 86, 0, 0, 0, 0, 0, 524,
-	525, 0, 0, 0, 0, 0, 0, 0, 76, 0,
-	77, 0, 73, 72, 70, 51, 69, 79, 52, 78,
-	54, 53, 80, 0, 0, 95, 0, 0, 0, 48,
-	514, 57, 429, 428, 58, 49, 50, 0, 61, 62,
-	59, 0, 0, 65, 66, 0, 67, 64, 60, 0,
-	0, 81, 63, 0, 68, 94, 96, 97, 93, 0,
-	0, 0, 82, 83, 0, 84, 0, 85, 86, 0,
-	0, 0, 0, 0, 336, 337, 0, 0, 0, 0,
-	0, 0, 0, 76, 0, 77, 0, 73, 72, 70,
-	51, 69, 79, 52, 78, 54, 53, 80, 0, 0,
-	95, 0, 0, 0, 48, 426, 57, 429, 428, 58,
-	49, 50, 0, 61, 62, 59, 0, 0, 65, 66,
-	0, 67, 64, 60, 0, 0, 81, 63, 0, 68,
-	94, 96, 97, 93, 0, 0, 0, 82, 83, 0,
-	84, 0, 85, 86, 0, 0, 0, 0, 0, 336,
-	337, 0, 0, 0, 0, 0, 0, 0, 76, 0,
-	77, 0, 73, 72, 70, 51, 69, 79, 52, 78,
-	54, 53, 80, 0, 0, 95, 0, 0, 0, 48,
-	0, 57, 0, 0, 58, 49, 50, 0, 61, 62,
-	59, 436, 468, 65, 66, 0, 67, 64, 60, 0,
-	0, 81, 63, 0, 68, 94, 96, 97, 93, 0,
-	0, 0, 82, 83, 0, 84, 0, 85, 86, 0,
-	0, 0, 0, 0, 336, 337, 0, 0, 0, 0,
-	0, 0, 0, 76, 0, 77, 0, 73, 72, 70,
-	51, 69, 79, 52, 78, 54, 53, 80, 0, 0,
-	95, 0, 0, 0, 48, 581, 57, 0, 0, 58,
-	49, 50, 0, 61, 62, 59, 0, 582, 65, 66,
-	0, 67, 64, 60, 0, 0, 81, 63, 0, 68,
-	94, 96, 97, 93, 0, 0, 0, 82, 83, 0,
-	84, 0, 85, 86, 0, 0, 0, 0, 0, 336,
-	337, 0, 0, 0, 0, 0, 0, 0, 76, 0,
-	77, 0, 73, 72, 70, 51, 69, 79, 52, 78,
-	54, 53, 80, 0, 0, 95, 0, 0, 0, 48,
-	0, 57, 0, 0, 58, 49, 50, 0, 61, 62,
-	59, 0, 0, 65, 66, 0, 67, 64, 60, 0,
-	0, 81, 63, 0, 68, 94, 96, 97, 93, 0,
-	0, 0, 82, 83, 0, 84, 0, 85, 86, 0,
-	0, 0, 0, 0, 6, 7, 0, 0, 0, 0,
-	0, 0, 0, 76, 0, 77, 0, 73, 72, 8,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 661, 528, 0, 0,
-	529, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	524, 525, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 642, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 336, 337, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 628, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 592, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 336, 337, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 583, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 562, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 336, 337, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 530, 528, 0, 0,
-	529, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	524, 525, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 523, 528, 0, 0, 529, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 524, 525, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 516, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 0, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 336, 337, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 499, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 494, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 480, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 336, 337, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 407, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 399, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 336, 337, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 396, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 0, 528, 0, 0, 529, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 524, 525, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 0, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 0, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 616, 337, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 0, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	570, 337, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 364, 0, 95, 0, 0, 0,
-	48, 0, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 0, 363, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 0, 73, 72,
-	70, 51, 69, 79, 52, 78, 54, 53, 80, 0,
-	0, 95, 0, 0, 0, 48, 0, 57, 0, 0,
-	58, 49, 50, 0, 61, 62, 59, 0, 0, 65,
-	66, 0, 67, 64, 60, 0, 0, 81, 63, 0,
-	68, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	344, 0, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 51, 69, 79, 52,
-	78, 54, 53, 80, 0, 0, 95, 0, 0, 0,
-	48, 0, 57, 0, 0, 58, 49, 50, 0, 61,
-	62, 59, 0, 0, 65, 66, 0, 67, 64, 60,
-	0, 0, 81, 63, 0, 68, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	70, 162, 69, 79, 163, 78, 165, 164, 80, 0,
-	0, 95, 0, 166, 76, 0, 77, 0, 73, 72,
+	0, 0, 0, 83, 0, 0, 0, 0, 96, 98,
+	99, 95, 0, 0, 152, 84, 85, 0, 0, 86,
+	0, 87, 88, 0, 0, 0, 0, 0, 0, 0,
+	319, 0, 0, 0, 0, 0, 0, 318, 0, 159,
+	0, 75, 74, 72, 166, 71, 81, 168, 80, 170,
+	169, 147, 0, 155, 97, 0, 171, 158, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 81, 0, 0,
-	0, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	344, 0, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 192, 69, 79, 193,
-	78, 137, 194, 80, 0, 0, 95, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 0, 0, 0, 0, 96, 98, 99, 95, 0,
+	0, 0, 84, 85, 0, 0, 86, 0, 87, 88,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 344, 0, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 598, 73, 72,
-	70, 192, 69, 79, 193, 78, 137, 194, 80, 0,
-	0, 95, 0, 166, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 318, 0, 159, 0, 75, 74,
+	72, 166, 71, 81, 168, 80, 170, 169, 147, 0,
+	0, 97, 0, 171, 158, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 81, 0, 0,
-	0, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	344, 0, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 359, 69, 79, 193,
-	78, 137, 194, 80, 0, 0, 95, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 0, 0,
+	0, 0, 96, 98, 99, 95, 0, 0, 0, 84,
+	85, 0, 0, 86, 0, 87, 88, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 318, 0, 159, 0, 75, 74, 72, 166, 71,
+	81, 168, 80, 170, 169, 147, 0, 0, 97, 0,
+	171, 158, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 0, 0, 0, 0, 96,
+	98, 99, 95, 0, 0, 152, 84, 85, 0, 0,
+	86, 0, 87, 88, 72, 166, 71, 81, 168, 80,
+	170, 169, 82, 0, 0, 97, 0, 171, 318, 0,
+	159, 0, 75, 74, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	0, 0, 0, 0, 0, 344, 0, 0, 0, 0,
-	0, 0, 0, 0, 76, 0, 77, 356, 73, 72,
-	70, 192, 69, 79, 193, 78, 137, 194, 224, 0,
-	0, 95, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 83, 0, 0, 0, 0, 96, 98, 99, 95,
+	0, 0, 0, 84, 85, 0, 0, 86, 0, 87,
+	88, 0, 0, 0, 0, 0, 361, 0, 0, 0,
+	0, 0, 0, 0, 0, 78, 0, 79, 0, 75,
+	74, 72, 197, 71, 81, 198, 80, 141, 199, 82,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 81, 0, 0,
-	0, 94, 96, 97, 93, 0, 0, 369, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 154, 0, 73, 72, 70, 162, 69, 79, 163,
-	78, 165, 164, 143, 0, 0, 95, 0, 166, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 0,
+	0, 0, 0, 96, 98, 99, 95, 0, 0, 0,
+	84, 85, 0, 0, 86, 0, 87, 88, 0, 0,
+	0, 0, 0, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 78, 0, 79, 624, 75, 74, 72, 208,
+	71, 81, 168, 80, 170, 169, 82, 0, 0, 97,
+	0, 171, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 83, 0, 0, 0, 0,
+	96, 98, 99, 95, 0, 0, 0, 84, 85, 0,
+	0, 86, 0, 87, 88, 0, 0, 0, 0, 0,
+	361, 0, 0, 0, 0, 0, 0, 0, 0, 78,
+	0, 79, 0, 75, 74, 72, 235, 71, 81, 198,
+	80, 141, 199, 82, 0, 0, 97, 0, 171, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	70, 192, 69, 79, 193, 78, 137, 194, 80, 0,
-	0, 95, 0, 0, 304, 0, 154, 0, 73, 72,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 81, 0, 0,
-	0, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 0, 0, 0, 0, 0,
-	344, 0, 0, 0, 0, 0, 0, 0, 0, 76,
-	0, 77, 0, 73, 72, 70, 192, 69, 79, 193,
-	78, 137, 194, 224, 0, 0, 95, 0, 0, 153,
+	0, 0, 83, 0, 0, 0, 0, 96, 98, 99,
+	95, 0, 0, 0, 84, 85, 0, 0, 86, 0,
+	87, 88, 0, 0, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 78, 0, 79, 0,
+	75, 74, 72, 235, 71, 81, 198, 80, 141, 199,
+	82, 0, 0, 97, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	0, 0, 0, 0, 96, 98, 99, 95, 0, 0,
+	0, 84, 85, 0, 0, 86, 0, 87, 88, 0,
+	0, 0, 0, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 78, 0, 79, 373, 75, 74, 72,
+	166, 71, 81, 168, 80, 170, 169, 147, 0, 0,
+	97, 0, 171, 158, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	70, 192, 69, 79, 193, 78, 137, 194, 80, 0,
-	0, 95, 0, 0, 76, 0, 154, 0, 73, 72,
+	0, 0, 0, 0, 0, 0, 83, 0, 0, 0,
+	0, 96, 98, 99, 95, 0, 0, 0, 84, 85,
+	0, 0, 86, 0, 87, 88, 72, 197, 71, 81,
+	198, 80, 141, 199, 82, 0, 0, 97, 0, 0,
+	318, 0, 159, 0, 75, 74, 0, 0, 0, 0,
+	0, 0, 60, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 64, 0, 0, 0, 96, 98,
+	99, 95, 0, 0, 0, 84, 85, 0, 0, 86,
+	0, 87, 88, 72, 197, 71, 81, 198, 80, 141,
+	199, 82, 0, 0, 97, 0, 0, 78, 0, 79,
+	0, 75, 74, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 81, 63, 0,
-	0, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 70, 162, 69, 79, 163,
-	78, 165, 164, 212, 0, 0, 95, 0, 166, 76,
-	0, 77, 0, 73, 72, 0, 0, 0, 0, 0,
+	83, 0, 0, 0, 0, 96, 98, 99, 95, 0,
+	0, 0, 84, 85, 0, 0, 86, 0, 87, 88,
+	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 78, 0, 79, 0, 75, 74,
+	72, 235, 71, 81, 198, 80, 141, 199, 232, 0,
+	0, 97, 0, 0, 158, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	70, 192, 69, 79, 193, 78, 137, 194, 80, 0,
-	0, 95, 0, 0, 76, 0, 77, 0, 73, 72,
+	0, 0, 0, 0, 0, 0, 0, 83, 0, 0,
+	0, 0, 96, 98, 99, 95, 0, 0, 0, 84,
+	85, 0, 0, 86, 0, 87, 88, 72, 166, 71,
+	81, 168, 80, 170, 169, 219, 0, 0, 97, 0,
+	171, 78, 0, 159, 0, 75, 74, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 81, 0, 0,
-	0, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 70, 325, 69, 79, 193,
-	78, 137, 326, 80, 0, 0, 95, 0, 0, 76,
-	0, 77, 0, 73, 72, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 0, 0, 0, 0, 96,
+	98, 99, 95, 0, 0, 0, 84, 85, 0, 0,
+	86, 0, 87, 88, 72, 197, 71, 81, 198, 80,
+	141, 199, 82, 0, 0, 97, 0, 0, 78, 0,
+	79, 0, 75, 74, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 0, 84, 0, 85, 86,
-	70, 192, 69, 79, 193, 78, 137, 194, 224, 0,
-	0, 95, 0, 0, 76, 0, 77, 0, 73, 72,
+	0, 83, 0, 0, 0, 0, 96, 98, 99, 95,
+	0, 0, 0, 84, 85, 0, 0, 86, 0, 87,
+	88, 72, 340, 71, 81, 198, 80, 141, 341, 82,
+	0, 0, 97, 0, 0, 78, 0, 79, 0, 75,
+	74, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 0,
+	0, 0, 0, 96, 98, 99, 95, 0, 0, 0,
+	84, 85, 0, 0, 86, 0, 87, 88, 72, 235,
+	71, 81, 198, 80, 141, 199, 232, 0, 0, 97,
+	0, 0, 78, 0, 79, 0, 75, 74, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 81, 0, 0,
-	0, 94, 96, 97, 93, 0, 0, 0, 82, 83,
-	0, 84, 0, 85, 86, 70, 162, 69, 79, 163,
-	78, 165, 164, 80, 0, 0, 95, 0, 0, 76,
-	0, 77, 0, 73, 72, 0, 0, 0, 0, 0,
-	0, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 94, 96, 97, 93,
-	0, 0, 0, 82, 83, 109, 84, 0, 85, 86,
+	0, 0, 0, 0, 0, 83, 0, 0, 0, 0,
+	96, 98, 99, 95, 0, 0, 0, 84, 85, 0,
+	0, 86, 0, 87, 88, 72, 208, 71, 81, 168,
+	80, 170, 169, 82, 0, 111, 97, 0, 0, 78,
+	0, 79, 0, 75, 74, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	118, 119, 0, 0, 76, 0, 77, 0, 73, 72,
-	107, 108, 0, 0, 0, 110, 0, 111, 0, 112,
-	120, 121, 118, 119, 109, 0, 0, 372, 106, 115,
-	113, 114, 107, 108, 0, 0, 663, 110, 0, 111,
-	0, 112, 120, 121, 0, 0, 109, 302, 0, 0,
-	106, 115, 113, 114, 0, 0, 0, 0, 0, 0,
-	0, 118, 119, 0, 0, 0, 0, 0, 109, 0,
-	0, 107, 108, 0, 0, 0, 110, 0, 111, 0,
-	112, 0, 0, 118, 119, 0, 0, 0, 0, 106,
-	115, 113, 114, 107, 108, 0, 0, 0, 110, 0,
-	111, 0, 112, 0, 0, 118, 119, 0, 0, 0,
-	0, 106, 115, 113, 114, 107, 108, 0, 0, 0,
-	110, 0, 111, 0, 112, 0, 0, 0, 0, 0,
-	0, 0, 0, 106, 115, 113, 114,
+	0, 0, 83, 0, 0, 0, 0, 96, 98, 99,
+	95, 0, 121, 122, 84, 85, 0, 0, 86, 0,
+	87, 88, 111, 109, 110, 0, 0, 0, 112, 115,
+	0, 113, 0, 114, 666, 0, 78, 0, 79, 0,
+	75, 74, 108, 118, 116, 117, 120, 111, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 0, 0, 112, 115, 0, 113, 0,
+	114, 0, 0, 0, 121, 122, 0, 0, 0, 108,
+	118, 116, 117, 0, 0, 109, 110, 0, 0, 0,
+	112, 115, 0, 113, 0, 114, 0, 0, 0, 121,
+	122, 0, 0, 0, 108, 118, 116, 117, 0, 0,
+	109, 110, 0, 0, 0, 112, 115, 0, 113, 0,
+	114, 0, 0, 0, 0, 0, 0, 0, 0, 108,
+	118, 116, 117,
 }
-var RubyPact = [...]int{
 
-	-39, 2128, -1000, -1000, -1000, 20, -1000, -1000, -1000, 1590,
-	-1000, -1000, -1000, -1000, 229, -1000, -1000, -1000, -1000, -1000,
+var RubyPact = [...]int16{
+	-40, 2147, -1000, -1000, -1000, 15, -1000, -1000, -1000, 935,
+	-1000, -1000, -1000, -1000, 226, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 69, 196, -1000, 93, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 28, 517,
-	456, 748, 46, 63, 178, 230, 204, 3779, 3779, -1000,
-	4504, 3779, 3779, 4504, 4669, 366, 288, -1000, 471, -1000,
-	-1000, 335, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 4449,
-	-1000, 34, 3779, 3779, 4504, 4504, 4504, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4504, 4614, -1000, -1000, -1000, -1000, -1000,
-	-1000, 3779, 3779, 3779, 3779, 4504, 510, 4504, 4504, -1000,
-	4504, 3779, 4504, 4504, 4504, 4504, 3779, 4504, -1000, -1000,
-	4504, 4504, 3779, 4504, 3779, 4504, 4504, 3779, 3779, 3779,
-	3779, 508, 298, 90, 66, 298, -1000, -1000, -1000, 97,
-	4504, 376, -1000, -1000, 34, -1000, 55, 4504, 4394, 4504,
-	4504, 373, 440, -23, 95, 268, -1000, -1000, 367, -1000,
-	-1000, 67, 1506, 71, 40, 231, 4504, -1000, -1000, 4504,
-	-1000, 3779, 3779, 3779, 4504, 3779, 3779, 3779, 372, 3779,
-	3779, 3779, 4559, 371, 505, 502, 455, 267, 3404, 346,
-	4804, 59, 4209, 126, 45, 386, 324, 4804, 173, 346,
-	-1000, -1000, 1116, 3779, 3779, 3779, 3779, 460, -1000, 3834,
-	4059, 392, -1000, 268, 3629, -1000, 95, 455, 455, 4804,
-	4804, 4804, 4804, -1000, -1000, 4804, 455, 455, 455, 455,
-	4804, 4134, 4804, 4804, 4264, 4804, 455, 4804, 4804, 4804,
-	4804, 455, 4699, 101, 4264, 4264, 4804, 4804, 455, 123,
-	1347, 16, 455, 4804, 120, -4, 4721, 455, 455, 455,
-	455, 4339, -1000, 439, 338, -1000, 224, 499, 497, 490,
-	494, -1000, 3254, 456, 4804, 3179, 3834, -1000, -1000, -1000,
-	84, 966, -6, 1027, -1000, -1000, -1000, 1116, -1000, 1116,
-	-1000, -1000, -1000, 482, -1000, 3104, -1000, 306, 4059, 3404,
-	-1000, -1000, 4504, -1000, 4504, 4804, 3834, 82, -28, 455,
-	455, 455, 80, -46, 455, 455, 455, -1000, -1000, 481,
-	455, 455, 455, 438, 432, 1300, 79, -1000, -1000, 477,
-	422, 39, 22, 1903, -1000, -1000, -1000, -1000, 455, 427,
-	4504, -1000, -1000, 173, -1000, 413, 4504, 455, 455, 455,
-	455, -1000, 405, 4804, -1000, -1000, -1000, 403, 398, 1204,
-	4782, 3834, 455, -1000, -1000, 4264, 3834, -1000, 34, 3779,
-	4504, 4804, -1000, -1000, 4804, 4804, 221, -1000, 208, -1000,
-	205, -1000, 34, -1000, -1000, 1978, 306, 430, 381, 4504,
-	-1000, -1000, 298, 298, 298, 1978, -1000, -1000, 3029, -1000,
-	378, 3834, 203, 195, -1000, -1000, 3984, -1000, 2954, 133,
-	4782, 2, 2879, 75, 4804, 4264, 851, 392, 176, -1000,
-	172, -1000, -1000, -1000, 4504, 4504, -1000, 470, 3779, -1000,
-	1828, 2804, -1000, -1000, -1000, -1000, 503, 4804, 2729, 2654,
-	412, -1000, -1000, 4504, 346, 12, -1000, -5, -1000, -11,
-	-1000, 4504, 392, 4804, 378, -1000, 455, 13, -53, 4264,
-	4264, 3779, 4264, 3779, 3779, -1000, 326, 289, -1000, -1000,
-	-1000, -1000, -1000, 4804, -1000, -1000, -1000, 320, 289, 2579,
-	-1000, 222, -1000, 268, -1000, -1000, -1000, -1000, 367, 3779,
-	3779, 452, -1000, 4804, -1000, -1000, -14, 3404, -1000, -1000,
-	3554, -1000, -1000, 159, 213, -1000, 3779, 802, 672, -1000,
-	3779, -1000, 455, 3404, -1000, 451, -1000, 2053, 2504, 3404,
-	377, 489, -1000, -1000, -1000, -1000, 455, -1000, 3779, 3779,
-	-1000, -1000, -1000, 2429, 346, 3404, -1000, 3834, -1000, 3909,
-	-1000, 186, 4804, 202, 177, 145, -1000, 4804, -1000, 4721,
-	455, 455, 455, -1000, 310, -1000, 3404, 1978, 1978, 1978,
-	-1000, 309, -1000, 34, 3834, 455, 455, -10, -1000, -22,
-	-1000, 3479, -1000, 3704, 455, 344, -1000, 455, 3404, 3404,
-	-1000, -1000, -1000, -1000, 3404, 486, 456, -1000, -1000, 269,
-	160, 2354, -1000, 3404, 118, 4804, -1000, -1000, -1000, 100,
-	-1000, -1000, 3779, -1000, 285, 289, 281, 289, 279, 289,
-	-1000, -1000, -1000, 4504, -1000, -29, -1000, 455, 3404, 2279,
-	-1000, -1000, -1000, 3404, 3404, -1000, -1000, -1000, -1000, 118,
-	4504, 455, -1000, 274, -1000, 265, -1000, 253, 170, 193,
-	-1000, -45, -1000, 3404, 3404, 1753, 1678, 2204, 4760, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 380, 3779, -1000, -1000,
-	350, -1000, 118, -1000, -1000, 3779, -1000, 455, 3329, -1000,
-	118, 455, 3329, 3329, 3329,
+	434, -1000, -1000, -1000, 40, 45, -1000, 97, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 27,
+	514, 453, 4073, 47, 65, 220, 256, 210, 3996, 3996,
+	-1000, 5128, 3996, 3996, 5128, 5299, 310, 277, -1000, 474,
+	470, -1000, -1000, 351, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 5071, -1000, 12, 3996, 3996, 5128, 5128, 5128, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5128, 5242, -1000, -1000, -1000,
+	-1000, -1000, -1000, 3996, 3996, 3996, 3996, 5128, 520, 5128,
+	5128, -1000, 5128, 3996, 5128, 5128, 5128, 5128, 5128, 3996,
+	5128, -1000, -1000, 5128, 5128, 3996, 468, 5128, 3996, 5128,
+	5128, 3996, 3996, 3996, 3996, 519, 353, 76, 68, 353,
+	-1000, -1000, -1000, 184, 5128, 425, -1000, -1000, 12, -1000,
+	36, 5128, 4880, 5128, 5128, 318, 433, 374, 17, 101,
+	1449, -1000, -1000, 377, -1000, -1000, 4150, 88, 82, 26,
+	227, 5128, -1000, -1000, 5128, -1000, 3996, 3996, 3996, 5128,
+	3996, 3996, 3996, 289, 3996, 3996, 3996, 5185, 282, 508,
+	503, 458, 369, 3611, 371, 5408, 51, 4823, 107, 50,
+	316, 285, 5408, 185, 371, -1000, -1000, 5311, 4381, 3996,
+	3996, 3996, 3996, 330, -1000, -1000, 4592, 4746, 388, -1000,
+	1449, 374, 3842, -1000, 101, 458, 458, 5408, 5408, 5408,
+	5408, -1000, -1000, 374, 5408, 4304, 458, 458, 458, 458,
+	5408, 1092, 5408, 5408, 4937, 5408, 458, 5408, 5408, 5408,
+	5408, 5408, 458, 1552, 119, 4937, 4937, 5408, 5408, 458,
+	-1000, 86, 1294, -5, 458, 5408, 84, -6, 1588, 458,
+	458, 458, 458, 5014, -1000, 414, 272, -1000, 90, 497,
+	491, 485, 473, -1000, 3457, 453, 5408, 3380, 4438, -1000,
+	-1000, -1000, 83, 1008, -27, 1510, -1000, -1000, -1000, -1000,
+	5311, -1000, 5311, -1000, -1000, -1000, 484, -1000, -1000, 3303,
+	-1000, 268, 4746, 3611, -1000, -1000, 5128, -1000, 5128, 5128,
+	5408, 4438, 79, -29, 458, 458, 458, 63, -30, 458,
+	458, 458, -1000, -1000, 483, 458, 458, 458, 409, 408,
+	4227, 71, -1000, -1000, 480, 407, 48, 46, 1916, -1000,
+	-1000, -1000, -1000, 458, 420, 5128, -1000, -1000, -1000, -1000,
+	151, -1000, 296, 5128, 458, 458, 458, 458, -1000, 401,
+	5408, -1000, -1000, -1000, 391, 374, 5383, 4438, 458, -1000,
+	-1000, 4937, 4438, -1000, 12, 3996, 5128, 5408, -1000, -1000,
+	5408, 5408, 204, -1000, 197, -1000, 181, -1000, 12, -1000,
+	-1000, 1993, 268, 386, 403, 5128, 5128, -1000, -1000, 353,
+	353, 353, 1993, -1000, -1000, 3226, -1000, 380, 4438, 179,
+	193, -1000, -1000, 4669, 164, -1000, 3149, 122, 5383, 2,
+	3072, 95, 5408, 4937, 846, 5408, 388, 168, -1000, 162,
+	-1000, -1000, -1000, 5128, 5128, -1000, 481, 3996, -1000, 1839,
+	2995, -1000, -1000, -1000, -1000, 512, 5408, 2918, 2841, 2764,
+	2687, -1000, -1000, 290, -1000, -1000, 5128, 371, -21, -1000,
+	-13, -1000, -14, 388, 5408, 380, -1000, 458, 59, -52,
+	4937, 4937, 3996, 4937, 3996, 3996, -1000, 343, 322, -1000,
+	-1000, -1000, -1000, -1000, 5408, 5408, -1000, -1000, -1000, 342,
+	322, 2610, -1000, 188, -1000, 1449, -1000, -1000, -1000, -1000,
+	377, 374, 3996, 3996, 459, -1000, 374, 5408, 118, -1000,
+	-1000, -15, 3611, -1000, -1000, 3765, -1000, -1000, 150, 187,
+	-1000, 3996, 1377, 1333, -1000, 3996, -1000, 458, 3611, -1000,
+	477, -1000, 2070, 2533, 3611, 366, 449, -1000, -1000, -1000,
+	-1000, 458, -1000, 3996, 3996, -1000, -1000, -1000, -1000, -1000,
+	2456, 371, 3611, -1000, 4592, -1000, 4515, -1000, 186, 182,
+	136, -1000, 5408, -1000, 1588, 458, 458, 458, -1000, 341,
+	-1000, 3611, 1993, 1993, 1993, -1000, 335, -1000, 12, 4438,
+	458, 458, -3, 5128, -1000, -18, -1000, 3688, -1000, 3919,
+	458, 362, -1000, 458, 3611, 3611, -1000, -1000, -1000, -1000,
+	3611, 444, 453, -1000, -1000, 198, 169, 2379, -1000, 3611,
+	123, 5408, -1000, -1000, -1000, -1000, -1000, 3996, -1000, 327,
+	322, 323, 322, 321, 322, -1000, -1000, -1000, 5128, 5358,
+	-1000, -38, -1000, 458, 3611, 2302, -1000, -1000, -1000, 3611,
+	3611, -1000, -1000, -1000, -1000, 123, 458, -1000, 301, -1000,
+	299, -1000, 295, 139, 794, 123, -1000, -1000, -44, -1000,
+	3611, 3611, 1762, 1682, 2225, -1000, -1000, -1000, -1000, -1000,
+	123, -1000, -1000, 472, 3996, -1000, -1000, 421, -1000, -1000,
+	3996, -1000, 458, 3534, -1000, 458, 3534, 3534, 3534,
 }
-var RubyPgo = [...]int{
-
-	0, 626, 0, 302, 621, 39, 8, 620, 619, 618,
-	617, 616, 3, 608, 1, 607, 11, 606, 143, 602,
-	599, 597, 830, 266, 12, 474, 594, 593, 591, 586,
-	584, 581, 573, 571, 565, 558, 557, 556, 995, 15,
-	26, 555, 553, 22, 551, 550, 6, 17, 548, 546,
-	544, 543, 542, 541, 540, 537, 536, 534, 1055, 533,
-	19, 18, 13, 532, 24, 4, 531, 14, 9, 525,
-	106, 25, 519, 463, 10, 16, 31, 20, 7, 5,
-	69,
+
+var RubyPgo = [...]int16{
+	0, 592, 0, 298, 588, 231, 4, 587, 585, 584,
+	583, 582, 581, 11, 580, 90, 579, 8, 578, 92,
+	577, 575, 27, 640, 242, 14, 152, 574, 570, 569,
+	567, 566, 565, 564, 563, 562, 561, 560, 557, 1152,
+	16, 23, 556, 552, 22, 551, 549, 3, 20, 547,
+	546, 545, 544, 543, 542, 541, 540, 538, 537, 1028,
+	533, 21, 19, 5, 531, 15, 6, 529, 69, 12,
+	548, 18, 25, 528, 521, 13, 7, 10, 17, 1,
+	9, 721, 519,
 }
-var RubyR1 = [...]int{
-
-	0, 63, 63, 63, 63, 63, 63, 63, 63, 63,
-	63, 80, 80, 58, 58, 58, 58, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 23, 22, 22,
-	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
-	22, 22, 22, 22, 22, 22, 33, 33, 33, 33,
-	33, 33, 2, 2, 2, 2, 2, 2, 2, 2,
+
+var RubyR1 = [...]int8{
+	0, 64, 64, 64, 64, 64, 64, 64, 64, 64,
+	64, 81, 81, 82, 82, 82, 59, 59, 59, 59,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+	23, 23, 23, 23, 23, 23, 23, 23, 23, 34,
+	34, 34, 34, 34, 34, 2, 2, 2, 2, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 19, 19, 43, 17, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	26, 61, 61, 61, 61, 68, 68, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	16, 70, 70, 65, 65, 27, 27, 27, 27, 27,
-	27, 27, 27, 27, 27, 76, 76, 76, 77, 77,
-	77, 74, 74, 74, 74, 34, 34, 35, 36, 38,
-	38, 38, 18, 18, 18, 18, 18, 18, 18, 18,
-	20, 20, 20, 71, 71, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 47, 47, 47,
-	47, 47, 47, 47, 47, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 9, 3, 1, 73,
-	73, 73, 73, 73, 73, 73, 4, 4, 4, 4,
-	78, 79, 79, 69, 69, 69, 6, 6, 6, 6,
-	6, 6, 6, 6, 24, 24, 75, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 62, 62,
-	62, 62, 59, 59, 59, 10, 21, 21, 21, 21,
-	12, 12, 12, 12, 12, 12, 72, 72, 66, 66,
-	60, 60, 28, 28, 29, 30, 30, 30, 30, 32,
-	32, 32, 31, 31, 31, 14, 14, 44, 44, 44,
-	44, 64, 64, 64, 64, 64, 45, 45, 45, 45,
-	45, 46, 46, 46, 46, 42, 41, 11, 40, 40,
-	40, 40, 39, 39, 5, 5, 7, 13, 8, 8,
+	2, 2, 2, 2, 2, 2, 2, 20, 20, 44,
+	18, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 27, 62, 62, 62, 62, 62,
+	62, 69, 69, 68, 68, 68, 68, 68, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 17, 71,
+	71, 66, 66, 28, 28, 28, 28, 28, 28, 28,
+	28, 28, 28, 77, 77, 77, 78, 78, 78, 75,
+	75, 75, 75, 75, 75, 35, 35, 36, 37, 39,
+	39, 39, 19, 19, 19, 19, 19, 19, 19, 19,
+	19, 21, 21, 21, 72, 72, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 48, 48,
+	48, 48, 48, 48, 48, 48, 48, 49, 50, 51,
+	52, 53, 54, 55, 55, 56, 57, 58, 10, 3,
+	1, 74, 74, 74, 74, 74, 74, 74, 4, 4,
+	4, 4, 79, 80, 80, 70, 70, 70, 6, 6,
+	6, 6, 6, 6, 6, 6, 25, 25, 76, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	63, 63, 63, 63, 60, 60, 60, 11, 22, 22,
+	22, 22, 13, 13, 13, 13, 13, 13, 73, 73,
+	67, 67, 61, 61, 29, 29, 30, 31, 31, 31,
+	31, 33, 33, 33, 32, 32, 32, 15, 15, 45,
+	45, 45, 45, 45, 45, 65, 65, 65, 65, 65,
+	46, 46, 46, 46, 46, 47, 47, 47, 47, 43,
+	42, 12, 41, 41, 41, 41, 40, 40, 5, 5,
+	7, 8, 8, 14, 9, 9,
 }
-var RubyR2 = [...]int{
 
+var RubyR2 = [...]int8{
 	0, 0, 1, 1, 1, 3, 3, 3, 2, 2,
-	2, 0, 2, 0, 2, 2, 2, 1, 1, 1,
+	2, 0, 2, 0, 2, 2, 0, 2, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 3, 2, 6, 7, 1,
-	2, 6, 6, 2, 3, 2, 3, 4, 5, 4,
-	5, 4, 5, 2, 3, 3, 3, 3, 4, 4,
-	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	6, 6, 6, 6, 6, 6, 7, 6, 6, 8,
-	4, 5, 8, 1, 4, 1, 3, 0, 1, 1,
-	1, 1, 1, 1, 4, 4, 4, 4, 4, 4,
-	2, 1, 4, 0, 2, 6, 7, 8, 8, 8,
-	9, 9, 9, 6, 7, 1, 3, 3, 0, 1,
-	3, 1, 2, 3, 2, 4, 6, 5, 4, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 3,
+	2, 6, 7, 1, 2, 6, 6, 2, 3, 2,
+	3, 4, 5, 4, 5, 4, 5, 2, 3, 3,
+	3, 3, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 6, 6, 6, 6, 6, 6,
+	7, 6, 6, 8, 4, 5, 8, 1, 4, 1,
+	4, 1, 3, 0, 1, 1, 1, 1, 1, 1,
+	4, 4, 4, 4, 4, 4, 1, 4, 2, 1,
+	4, 0, 2, 6, 7, 8, 8, 8, 9, 9,
+	9, 6, 7, 1, 3, 3, 0, 1, 3, 1,
+	2, 3, 2, 2, 3, 4, 6, 5, 4, 1,
 	2, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 9, 6, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-	3, 3, 4, 3, 3, 4, 2, 2, 2, 2,
-	3, 3, 3, 3, 3, 3, 5, 1, 1, 0,
-	1, 1, 1, 4, 4, 4, 3, 5, 6, 5,
-	3, 1, 4, 3, 7, 8, 3, 4, 4, 4,
-	7, 8, 5, 6, 0, 1, 3, 4, 5, 3,
-	3, 3, 3, 3, 5, 6, 5, 3, 4, 3,
-	3, 2, 0, 2, 2, 3, 4, 6, 8, 6,
-	2, 3, 5, 5, 4, 4, 1, 3, 0, 2,
-	1, 2, 2, 1, 1, 2, 2, 2, 1, 1,
-	3, 3, 1, 3, 3, 6, 6, 5, 5, 3,
-	3, 0, 2, 2, 2, 2, 5, 6, 5, 6,
-	5, 4, 3, 3, 2, 4, 4, 2, 5, 7,
-	4, 6, 4, 5, 3, 3, 3, 2, 1, 2,
+	3, 3, 3, 3, 9, 6, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	4, 3, 3, 4, 3, 3, 4, 2, 2, 2,
+	2, 3, 3, 3, 3, 3, 3, 3, 5, 1,
+	1, 0, 1, 1, 1, 4, 4, 4, 3, 5,
+	6, 5, 3, 1, 4, 3, 7, 8, 3, 4,
+	4, 4, 7, 8, 5, 6, 0, 1, 3, 4,
+	5, 3, 3, 3, 3, 3, 5, 6, 5, 3,
+	4, 3, 3, 2, 0, 2, 2, 3, 4, 6,
+	8, 6, 2, 3, 5, 5, 4, 4, 1, 3,
+	0, 2, 1, 2, 2, 1, 1, 2, 2, 2,
+	1, 1, 3, 3, 1, 3, 3, 6, 6, 5,
+	5, 5, 5, 3, 3, 0, 2, 2, 2, 2,
+	5, 6, 5, 6, 5, 4, 3, 3, 2, 4,
+	4, 2, 5, 7, 4, 6, 4, 5, 3, 3,
+	3, 2, 3, 2, 1, 2,
 }
-var RubyChk = [...]int{
-
-	-1000, -63, 66, 67, 81, -2, 66, 67, 81, -22,
-	-27, -34, -36, -35, -18, -20, -37, -15, -21, -28,
-	-44, -40, -30, -31, -32, -43, -5, -29, -14, -7,
-	-23, -9, -4, -38, -25, -26, -10, -11, -48, -49,
-	-50, -51, -17, -42, -41, -33, -13, -8, 21, 27,
-	28, 7, 10, 13, 12, -47, -71, 23, 26, 32,
-	40, 30, 31, 44, 39, 35, 36, 38, 46, 8,
-	6, -19, 80, 79, -3, -1, 75, 77, 11, 9,
-	14, 43, 54, 55, 57, 59, 60, -52, -53, -54,
-	-55, -56, -57, 50, 47, 17, 48, 49, 67, 66,
-	81, 23, 26, 31, 30, 33, 69, 51, 52, 4,
-	56, 58, 60, 71, 72, 70, 26, 73, 41, 42,
-	61, 62, 26, 75, 53, 51, 75, 63, 64, 23,
-	26, 69, 7, -23, -3, 4, 10, 12, 13, -38,
-	4, 10, -38, 14, -61, -6, -67, 75, 53, 63,
-	64, 16, -70, 20, 77, -22, -18, -16, -79, -14,
-	-5, -25, 7, 10, 13, 12, 19, -78, 14, 75,
-	11, 53, 63, 64, 75, 53, 63, 64, 16, 53,
-	63, 64, 53, 16, 53, 16, -2, -2, -58, -68,
-	-22, -38, 7, 10, 13, -2, -2, -22, -80, -68,
-	-14, -18, -22, 23, 26, 23, 26, 8, 17, -80,
-	-80, -67, 14, -22, -59, -6, 77, -2, -2, -22,
-	-22, -22, -22, -61, 14, -22, -2, -2, -2, -2,
-	-22, 7, -22, -22, -80, -22, -2, -22, -22, -22,
-	-22, -2, -22, -5, -80, -80, -22, -22, -2, -70,
-	-22, -5, -2, -22, -70, -5, -22, -2, -2, -2,
-	-2, 7, -76, -77, 14, -74, 7, 60, 19, 69,
-	69, -76, -58, 51, -22, -58, -80, -6, -6, 16,
-	-70, -22, -5, -22, -43, -14, -40, -22, -14, -22,
-	-14, 7, 13, 60, 16, -58, -75, 70, -80, -58,
-	-75, 66, 5, 16, 75, -22, -80, -70, -5, -2,
-	-2, -2, -70, -5, -2, -2, -2, 7, 13, 60,
-	-2, -2, -2, -47, -70, 7, 13, 7, 13, 60,
-	-71, 7, 7, -58, 66, 67, 66, 67, -2, -66,
-	16, 66, 66, -80, 66, -39, 45, -2, -2, -2,
-	-2, 8, -73, -22, -18, -16, 78, -79, -69, 7,
-	-22, -80, -2, 67, 15, -80, -80, -6, -61, 53,
-	75, -22, 68, 68, -22, -22, 76, 16, 76, 76,
-	76, 76, -61, -24, -6, -58, 16, -77, 60, 53,
-	7, 7, 7, 7, 4, -58, 22, -38, -58, 22,
-	-67, -80, 76, 76, 76, 7, -80, 22, -58, -77,
-	-22, -80, -58, -80, -22, -80, -22, -67, 76, 76,
-	76, 76, 7, 7, 75, 75, 22, -62, 25, 24,
-	-58, -58, 22, 24, 34, -12, 33, -22, -64, -64,
-	-39, 22, 24, 45, -68, -80, 16, -80, 16, -80,
-	16, 68, -67, -22, -67, -6, -2, -70, -5, -80,
-	-80, 53, -80, 53, 53, -24, -65, -60, 34, -12,
-	-74, 15, 15, -22, -76, -76, -76, -65, -60, -58,
-	22, -80, 16, -22, -18, -16, -14, -5, -79, 53,
-	53, 16, -16, -22, 22, 70, -80, -58, 78, 78,
-	-58, -75, -78, 76, -80, 53, 53, -22, -22, 22,
-	25, 24, -2, -58, 22, -62, 22, -58, -58, -58,
-	-72, 5, -38, 22, 66, 67, -2, -45, 23, 26,
-	22, 22, 24, -58, -68, -58, 76, -80, 78, -80,
-	78, -80, -22, -80, -80, 76, 76, -22, -5, -22,
-	-2, -2, -2, 22, -65, -12, -58, -58, -58, -58,
-	22, -65, 22, 15, -80, -2, -2, 7, 78, -80,
-	66, -58, 15, -80, -2, 76, 76, -2, -58, -58,
-	22, 22, 34, 22, -58, 5, 16, 7, 13, -2,
-	-2, -58, 22, -58, -80, -22, -18, -16, 78, 7,
-	15, 15, 53, 22, -65, -60, -65, -60, -65, -60,
-	22, -6, -16, 75, 78, -80, 66, -2, -58, -58,
-	7, 13, -38, -58, -58, 66, 66, 67, 22, -80,
-	68, -2, 22, -65, 22, -65, 22, -65, -80, -22,
-	78, -80, 22, -58, -58, -64, -64, -64, -22, 22,
-	22, 22, 15, 76, 78, 22, -46, 25, 24, 22,
-	-46, 22, -80, 16, 22, 25, 24, -2, -64, 22,
-	-80, -2, -64, -64, -64,
+
+var RubyChk = [...]int16{
+	-1000, -64, 68, 69, 83, -2, 68, 69, 83, -23,
+	-28, -35, -37, -36, -19, -21, -38, -16, -22, -29,
+	-45, -41, -31, -32, -33, -44, -5, -30, -15, -7,
+	-8, -24, -10, -4, -39, -26, -27, -11, -12, -49,
+	-50, -51, -52, -18, -43, -42, -34, -14, -9, 21,
+	27, 28, 7, 10, 13, 12, -48, -72, 23, 26,
+	32, 40, 30, 31, 44, 39, 35, 36, 38, 46,
+	47, 8, 6, -20, 82, 81, -3, -1, 77, 79,
+	11, 9, 14, 43, 55, 56, 59, 61, 62, -53,
+	-54, -55, -56, -57, -58, 51, 48, 17, 49, 50,
+	69, 68, 83, 23, 26, 31, 30, 33, 71, 52,
+	53, 4, 57, 60, 62, 58, 73, 74, 72, 26,
+	75, 41, 42, 63, 64, 26, 16, 77, 54, 52,
+	77, 65, 66, 23, 26, 71, 7, -24, -3, 4,
+	10, 12, 13, -39, 4, 10, -39, 14, -62, -6,
+	-68, 77, 54, 65, 66, 16, -71, -70, 20, 79,
+	-23, -19, -17, -80, -15, -5, 7, -26, 10, 13,
+	12, 19, -79, 14, 77, 11, 54, 65, 66, 77,
+	54, 65, 66, 16, 54, 65, 66, 54, 16, 54,
+	16, -2, -2, -59, -69, -23, -39, 7, 10, 13,
+	-2, -2, -23, -81, -69, -15, -19, -23, 7, 23,
+	26, 23, 26, 8, 8, 17, -81, -81, -68, 14,
+	-23, -70, -60, -6, 79, -2, -2, -23, -23, -23,
+	-23, -62, 14, -70, -23, 7, -2, -2, -2, -2,
+	-23, 7, -23, -23, -81, -23, -2, -23, -23, -23,
+	-23, -23, -2, -23, -5, -81, -81, -23, -23, -2,
+	8, -71, -23, -5, -2, -23, -71, -5, -23, -2,
+	-2, -2, -2, 7, -77, -78, 14, -75, 7, 62,
+	19, 71, 71, -77, -59, 52, -23, -59, -81, -6,
+	-6, 16, -71, -23, -5, -23, -44, -15, -41, -22,
+	-23, -15, -23, -15, 7, 13, 62, 16, 16, -59,
+	-76, 72, -81, -59, -76, 68, 5, 16, 77, 70,
+	-23, -81, -71, -5, -2, -2, -2, -71, -5, -2,
+	-2, -2, 7, 13, 62, -2, -2, -2, -48, -71,
+	7, 13, 7, 13, 62, -72, 7, 7, -59, 68,
+	69, 68, 69, -2, -67, 16, 68, 69, 68, 69,
+	-82, 68, -40, 45, -2, -2, -2, -2, 8, -74,
+	-23, -19, -17, 80, -80, -70, -23, -81, -2, 69,
+	15, -81, -81, -6, -62, 54, 77, -23, 70, 70,
+	-23, -23, 78, 16, 78, 78, 78, 78, -62, -25,
+	-6, -59, 16, -78, 62, 54, 70, 7, 7, 7,
+	7, 4, -59, 22, -39, -59, 22, -68, -81, 78,
+	78, 78, 7, -81, -81, 22, -59, -78, -23, -81,
+	-59, -81, -23, -81, -23, -23, -68, 78, 78, 78,
+	78, 7, 7, 77, 77, 22, -63, 25, 24, -59,
+	-59, 22, 24, 34, -13, 33, -23, -65, -65, -65,
+	-65, 68, 69, -40, 22, 24, 45, -69, -81, 16,
+	-81, 16, -81, -68, -23, -68, -6, -2, -71, -5,
+	-81, -81, 54, -81, 54, 54, -25, -66, -61, 34,
+	-13, -75, 15, 15, -23, -23, -77, -77, -77, -66,
+	-61, -59, 22, -81, 16, -23, -19, -17, -15, -5,
+	-80, -70, 54, 54, 16, -17, -70, -23, 7, 22,
+	72, -81, -59, 80, 80, -59, -76, -79, 78, -81,
+	54, 54, -23, -23, 22, 25, 24, -2, -59, 22,
+	-63, 22, -59, -59, -59, -73, 5, -39, 22, 68,
+	69, -2, -46, 23, 26, 22, 22, 22, 22, 24,
+	-59, -69, -59, 78, -81, 80, -81, 80, -81, -81,
+	78, 78, -23, -5, -23, -2, -2, -2, 22, -66,
+	-13, -59, -59, -59, -59, 22, -66, 22, 15, -81,
+	-2, -2, 7, 70, 80, -81, 68, -59, 15, -81,
+	-2, 78, 78, -2, -59, -59, 22, 22, 34, 22,
+	-59, 5, 16, 7, 13, -2, -2, -59, 22, -59,
+	-81, -23, -19, -17, 80, 15, 15, 54, 22, -66,
+	-61, -66, -61, -66, -61, 22, -6, -17, 77, -23,
+	80, -81, 68, -2, -59, -59, 7, 13, -39, -59,
+	-59, 68, 68, 69, 22, -81, -2, 22, -66, 22,
+	-66, 22, -66, -81, -23, -81, 16, 80, -81, 22,
+	-59, -59, -65, -65, -65, 22, 22, 22, 15, 78,
+	-81, 80, 22, -47, 25, 24, 22, -47, 22, 22,
+	25, 24, -2, -65, 22, -2, -65, -65, -65,
 }
-var RubyDef = [...]int{
-
-	1, -2, 2, 3, 4, 0, 8, 9, 10, 52,
-	53, 54, 55, 56, 57, 58, 59, 60, 61, 62,
-	63, 64, 65, 66, 67, 68, 69, 70, 71, 72,
-	28, 29, 30, 31, 32, 33, 34, 35, 36, 37,
-	38, 39, 40, 41, 42, 43, 44, 45, 0, 0,
-	0, 20, 21, 23, 22, 0, 0, 0, 0, 13,
-	283, 0, 0, 11, 288, 292, 289, 284, 0, 17,
-	18, 19, 24, 25, 26, 27, 11, 11, 171, 79,
-	262, 0, 0, 0, 0, 0, 0, 46, 47, 48,
-	49, 50, 51, 0, 328, 73, 217, 218, 5, 6,
-	7, 0, 0, 0, 0, 0, 0, 0, 0, 11,
-	0, 0, 0, 0, 0, 0, 0, 0, 11, 11,
+
+var RubyDef = [...]int16{
+	1, -2, 2, 3, 4, 0, 8, 9, 10, 55,
+	56, 57, 58, 59, 60, 61, 62, 63, 64, 65,
+	66, 67, 68, 69, 70, 71, 72, 73, 74, 75,
+	76, 31, 32, 33, 34, 35, 36, 37, 38, 39,
+	40, 41, 42, 43, 44, 45, 46, 47, 48, 0,
+	0, 0, 23, 24, 26, 25, 0, 0, 0, 0,
+	16, 295, 0, 0, 11, 300, 304, 301, 296, 0,
+	0, 20, 21, 22, 27, 28, 29, 30, 11, 11,
+	181, 83, 274, 0, 0, 0, 0, 0, 0, 49,
+	50, 51, 52, 53, 54, 0, 344, 77, 229, 230,
+	5, 6, 7, 0, 0, 0, 0, 0, 0, 0,
+	0, 11, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 11, 11, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 0, 0, 166,
+	24, 25, 26, 16, 0, 179, 16, -2, 87, 89,
+	97, 11, 0, 0, 0, 0, 127, 129, 16, -2,
+	134, 135, 136, 137, 138, 139, 23, 35, 24, 26,
+	25, 0, 243, 11, 0, 180, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, -2, 0, 0, 158, 21, 22, 23, 13,
-	0, 169, 13, -2, 83, 85, 93, 11, 0, 0,
-	0, 0, 123, 13, -2, 128, 129, 130, 131, 132,
-	133, 32, 20, 21, 23, 22, 0, 231, 11, 0,
-	170, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 13, 0, 278, 282,
-	125, 31, 20, 21, 23, 0, 0, 11, 0, 285,
-	286, 287, 125, 0, 0, 0, 0, 0, 74, 219,
-	0, 80, -2, 128, 0, 317, -2, 206, 207, 208,
-	209, 76, 327, 329, -2, 141, 249, 257, 299, 300,
-	75, 86, 95, 97, 0, 210, 211, 212, 213, 214,
-	215, 251, 0, 0, 0, 0, 324, 325, 253, 0,
-	141, 0, 179, 96, 0, 0, 141, 190, 196, 250,
-	252, 244, 13, 155, 158, 159, 161, 0, 0, 0,
-	0, 13, 0, 0, 13, 0, 127, 84, 94, 11,
-	0, 141, 0, 172, 173, 174, 175, 185, 186, 191,
-	192, 197, 198, 0, 11, 0, 13, 158, 0, 11,
-	13, 11, 0, 11, 11, 140, 127, 0, 0, 176,
-	187, 193, 0, 0, 177, 188, 194, 200, 201, 0,
-	178, 189, 195, 180, 181, 20, 23, 203, 204, 0,
-	182, 0, 0, 0, 13, 13, 14, 15, 16, 0,
-	0, 301, 301, 0, 12, 0, 0, 293, 294, 290,
-	291, 326, 11, 220, 221, 222, 226, 11, 11, -2,
-	0, 127, 263, 264, 265, 0, 127, 87, 89, 0,
-	11, 120, 11, 11, 315, 316, 101, 11, 102, 103,
-	108, 109, 244, 91, 245, 143, 0, 0, 0, 0,
-	162, 164, 158, 158, 158, 143, 165, 13, 0, 168,
-	11, 0, 98, 99, 100, 199, 0, 236, 0, 0,
-	-2, 0, 0, 13, 230, 0, 141, 11, 104, 105,
-	106, 107, 202, 205, 0, 0, 247, 0, 0, 13,
-	0, 0, 266, 13, 13, 279, 13, 126, 0, 0,
-	0, 320, 13, 0, 13, 0, 11, 0, 11, 0,
-	11, 0, 11, -2, 11, 88, 92, 0, 0, 0,
-	0, 0, 0, 0, 0, 90, 0, 143, 13, 280,
-	160, 156, 157, 163, 13, 13, 13, 0, 143, 0,
-	167, 0, 11, 134, 135, 136, 137, 138, 139, 0,
-	0, 0, 124, 142, 237, 246, 0, 11, 238, 239,
-	0, 13, 232, 99, 0, 11, 0, 0, 0, 248,
-	0, 13, 13, 261, 254, 0, 256, 0, 0, 270,
-	13, 0, 276, 297, 302, 303, 304, 305, 0, 0,
-	298, 318, 13, 0, 13, 11, 216, 0, 227, 0,
-	229, 0, 233, 0, 0, 110, 111, 295, 296, 0,
-	114, 115, 118, 145, 0, 281, 144, 143, 143, 143,
-	153, 0, 166, 77, 0, 112, 113, 0, 242, 0,
-	-2, 0, 82, 0, 117, 0, 184, 13, 259, 260,
-	255, 267, 13, 269, 271, 0, 0, 13, 13, 13,
-	0, 0, 321, 11, 322, 223, 224, 225, 228, 0,
-	81, 121, 0, 146, 0, 143, 0, 143, 0, 143,
-	154, 78, -2, 0, 243, 0, -2, 116, 258, 0,
-	13, 13, 277, 274, 275, 301, 13, 13, 319, 323,
-	0, 119, 147, 0, 148, 0, 149, 0, 0, 0,
-	240, 0, 268, 272, 273, 0, 0, 0, 11, 150,
-	151, 152, 122, 183, 241, 306, 0, 0, 301, 308,
-	0, 310, 234, 11, 307, 0, 301, 301, 314, 309,
-	235, 301, 312, 313, 311,
+	0, 16, 0, 290, 294, 131, 34, 23, 24, 26,
+	0, 0, 13, 0, 297, 298, 299, 131, 23, 0,
+	0, 0, 0, 0, 341, 78, 231, 0, 84, -2,
+	134, 146, 0, 331, -2, 217, 218, 219, 220, 80,
+	343, 345, -2, 129, 149, 23, 261, 269, 313, 314,
+	79, 90, 99, 101, 0, 221, 222, 223, 224, 225,
+	226, 227, 263, 0, 0, 0, 0, 338, 339, 265,
+	342, 0, 149, 0, 190, 100, 0, 0, 149, 201,
+	207, 262, 264, 256, 16, 163, 166, 167, 169, 0,
+	0, 0, 0, 16, 0, 0, 16, 0, 133, 88,
+	98, 11, 0, 149, 0, 182, 183, 184, 185, 186,
+	196, 197, 202, 203, 208, 209, 0, 11, 11, 0,
+	16, 166, 0, 11, 16, 11, 0, 11, 11, 0,
+	148, 133, 0, 0, 187, 198, 204, 0, 0, 188,
+	199, 205, 211, 212, 0, 189, 200, 206, 191, 192,
+	23, 26, 214, 215, 0, 193, 0, 0, 0, 16,
+	16, 17, 18, 19, 0, 0, 315, 315, 315, 315,
+	0, 12, 0, 0, 305, 306, 302, 303, 340, 11,
+	232, 233, 234, 238, 11, 11, 0, 133, 275, 276,
+	277, 0, 133, 91, 93, 0, 11, 124, 11, 11,
+	329, 330, 105, 11, 106, 107, 112, 113, 256, 95,
+	257, 151, 0, 0, 0, 0, 173, 170, 172, 166,
+	166, 166, 151, 175, 16, 0, 178, 11, 0, 102,
+	103, 104, 210, 0, 0, 248, 0, 0, -2, 0,
+	0, 16, 242, 0, 149, 245, 11, 108, 109, 110,
+	111, 213, 216, 0, 0, 259, 0, 0, 16, 0,
+	0, 278, 16, 16, 291, 16, 132, 0, 0, 0,
+	0, 14, 15, 0, 334, 16, 0, 16, 0, 11,
+	0, 11, 0, 11, -2, 11, 92, 96, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 0, 151, 16,
+	292, 168, 164, 165, 171, 174, 16, 16, 16, 0,
+	151, 0, 177, 0, 11, 140, 141, 142, 143, 144,
+	145, 147, 0, 0, 0, 128, 130, 150, 0, 249,
+	258, 0, 11, 250, 251, 0, 16, 244, 103, 0,
+	11, 0, 0, 0, 260, 0, 16, 16, 273, 266,
+	0, 268, 0, 0, 282, 16, 0, 288, 309, 316,
+	317, 318, 319, 0, 0, 311, 310, 312, 332, 16,
+	0, 16, 11, 228, 0, 239, 0, 241, 0, 0,
+	114, 115, 307, 308, 0, 118, 119, 122, 153, 0,
+	293, 152, 151, 151, 151, 161, 0, 176, 81, 0,
+	116, 117, 0, 0, 254, 0, -2, 0, 86, 0,
+	121, 0, 195, 16, 271, 272, 267, 279, 16, 281,
+	283, 0, 0, 16, 16, 16, 0, 0, 335, 11,
+	336, 235, 236, 237, 240, 85, 125, 0, 154, 0,
+	151, 0, 151, 0, 151, 162, 82, -2, 0, 11,
+	255, 0, -2, 120, 270, 0, 16, 16, 289, 286,
+	287, 315, 16, 16, 333, 337, 123, 155, 0, 156,
+	0, 157, 0, 0, 0, 246, 11, 252, 0, 280,
+	284, 285, 0, 0, 0, 158, 159, 160, 126, 194,
+	247, 253, 320, 0, 0, 315, 322, 0, 324, 321,
+	0, 315, 315, 328, 323, 315, 326, 327, 325,
 }
-var RubyTok1 = [...]int{
 
+var RubyTok1 = [...]int8{
 	1,
 }
-var RubyTok2 = [...]int{
 
+var RubyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1131,8 +1208,10 @@ var RubyTok2 = [...]int{
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
 	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83,
 }
-var RubyTok3 = [...]int{
+
+var RubyTok3 = [...]int8{
 	0,
 }
 
@@ -1162,18 +1241,17 @@ type RubyParser interface {
 }
 
 type RubyParserImpl struct {
-	lookahead func() int
+	lval  RubySymType
+	stack [RubyInitialStackSize]RubySymType
+	char  int
 }
 
 func (p *RubyParserImpl) Lookahead() int {
-	return p.lookahead()
+	return p.char
 }
 
 func RubyNewParser() RubyParser {
-	p := &RubyParserImpl{
-		lookahead: func() int { return -1 },
-	}
-	return p
+	return &RubyParserImpl{}
 }
 
 const RubyFlag = -1000
@@ -1215,9 +1293,9 @@ func RubyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := RubyPact[state]
+	base := int(RubyPact[state])
 	for tok := TOKSTART; tok-1 < len(RubyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < RubyLast && RubyChk[RubyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < RubyLast && int(RubyChk[int(RubyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -1227,13 +1305,13 @@ func RubyErrorMessage(state, lookAhead int) string {
 
 	if RubyDef[state] == -2 {
 		i := 0
-		for RubyExca[i] != -1 || RubyExca[i+1] != state {
+		for RubyExca[i] != -1 || int(RubyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; RubyExca[i] >= 0; i += 2 {
-			tok := RubyExca[i]
+			tok := int(RubyExca[i])
 			if tok < TOKSTART || RubyExca[i+1] == 0 {
 				continue
 			}
@@ -1264,30 +1342,30 @@ func Rubylex1(lex RubyLexer, lval *RubySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = RubyTok1[0]
+		token = int(RubyTok1[0])
 		goto out
 	}
 	if char < len(RubyTok1) {
-		token = RubyTok1[char]
+		token = int(RubyTok1[char])
 		goto out
 	}
 	if char >= RubyPrivate {
 		if char < RubyPrivate+len(RubyTok2) {
-			token = RubyTok2[char-RubyPrivate]
+			token = int(RubyTok2[char-RubyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(RubyTok3); i += 2 {
-		token = RubyTok3[i+0]
+		token = int(RubyTok3[i+0])
 		if token == char {
-			token = RubyTok3[i+1]
+			token = int(RubyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = RubyTok2[1] /* unknown char */
+		token = int(RubyTok2[1]) /* unknown char */
 	}
 	if RubyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", RubyTokname(token), uint(char))
@@ -1301,22 +1379,20 @@ func RubyParse(Rubylex RubyLexer) int {
 
 func (Rubyrcvr *RubyParserImpl) Parse(Rubylex RubyLexer) int {
 	var Rubyn int
-	var Rubylval RubySymType
 	var RubyVAL RubySymType
 	var RubyDollar []RubySymType
 	_ = RubyDollar // silence set and not used
-	RubyS := make([]RubySymType, RubyMaxDepth)
+	RubyS := Rubyrcvr.stack[:]
 
 	Nerrs := 0   /* number of errors */
 	Errflag := 0 /* error recovery flag */
 	Rubystate := 0
-	Rubychar := -1
-	Rubytoken := -1 // Rubychar translated into internal numbering
-	Rubyrcvr.lookahead = func() int { return Rubychar }
+	Rubyrcvr.char = -1
+	Rubytoken := -1 // Rubyrcvr.char translated into internal numbering
 	defer func() {
 		// Make sure we report no lookahead when not parsing.
 		Rubystate = -1
-		Rubychar = -1
+		Rubyrcvr.char = -1
 		Rubytoken = -1
 	}()
 	Rubyp := -1
@@ -1344,22 +1420,22 @@ Rubystack:
 	RubyS[Rubyp].yys = Rubystate
 
 Rubynewstate:
-	Rubyn = RubyPact[Rubystate]
+	Rubyn = int(RubyPact[Rubystate])
 	if Rubyn <= RubyFlag {
 		goto Rubydefault /* simple state */
 	}
-	if Rubychar < 0 {
-		Rubychar, Rubytoken = Rubylex1(Rubylex, &Rubylval)
+	if Rubyrcvr.char < 0 {
+		Rubyrcvr.char, Rubytoken = Rubylex1(Rubylex, &Rubyrcvr.lval)
 	}
 	Rubyn += Rubytoken
 	if Rubyn < 0 || Rubyn >= RubyLast {
 		goto Rubydefault
 	}
-	Rubyn = RubyAct[Rubyn]
-	if RubyChk[Rubyn] == Rubytoken { /* valid shift */
-		Rubychar = -1
+	Rubyn = int(RubyAct[Rubyn])
+	if int(RubyChk[Rubyn]) == Rubytoken { /* valid shift */
+		Rubyrcvr.char = -1
 		Rubytoken = -1
-		RubyVAL = Rubylval
+		RubyVAL = Rubyrcvr.lval
 		Rubystate = Rubyn
 		if Errflag > 0 {
 			Errflag--
@@ -1369,27 +1445,27 @@ Rubynewstate:
 
 Rubydefault:
 	/* default state action */
-	Rubyn = RubyDef[Rubystate]
+	Rubyn = int(RubyDef[Rubystate])
 	if Rubyn == -2 {
-		if Rubychar < 0 {
-			Rubychar, Rubytoken = Rubylex1(Rubylex, &Rubylval)
+		if Rubyrcvr.char < 0 {
+			Rubyrcvr.char, Rubytoken = Rubylex1(Rubylex, &Rubyrcvr.lval)
 		}
 
 		/* look through exception table */
 		xi := 0
 		for {
-			if RubyExca[xi+0] == -1 && RubyExca[xi+1] == Rubystate {
+			if RubyExca[xi+0] == -1 && int(RubyExca[xi+1]) == Rubystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			Rubyn = RubyExca[xi+0]
+			Rubyn = int(RubyExca[xi+0])
 			if Rubyn < 0 || Rubyn == Rubytoken {
 				break
 			}
 		}
-		Rubyn = RubyExca[xi+1]
+		Rubyn = int(RubyExca[xi+1])
 		if Rubyn < 0 {
 			goto ret0
 		}
@@ -1411,10 +1487,10 @@ Rubydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for Rubyp >= 0 {
-				Rubyn = RubyPact[RubyS[Rubyp].yys] + RubyErrCode
+				Rubyn = int(RubyPact[RubyS[Rubyp].yys]) + RubyErrCode
 				if Rubyn >= 0 && Rubyn < RubyLast {
-					Rubystate = RubyAct[Rubyn] /* simulate a shift of "error" */
-					if RubyChk[Rubystate] == RubyErrCode {
+					Rubystate = int(RubyAct[Rubyn]) /* simulate a shift of "error" */
+					if int(RubyChk[Rubystate]) == RubyErrCode {
 						goto Rubystack
 					}
 				}
@@ -1435,7 +1511,7 @@ Rubydefault:
 			if Rubytoken == RubyEofCode {
 				goto ret1
 			}
-			Rubychar = -1
+			Rubyrcvr.char = -1
 			Rubytoken = -1
 			goto Rubynewstate /* try again in the same state */
 		}
@@ -1450,7 +1526,7 @@ Rubydefault:
 	Rubypt := Rubyp
 	_ = Rubypt // guard against "declared and not used"
 
-	Rubyp -= RubyR2[Rubyn]
+	Rubyp -= int(RubyR2[Rubyn])
 	// Rubyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if Rubyp+1 >= len(RubyS) {
@@ -1461,16 +1537,16 @@ Rubydefault:
 	RubyVAL = RubyS[Rubyp+1]
 
 	/* consult goto table to find next state */
-	Rubyn = RubyR1[Rubyn]
-	Rubyg := RubyPgo[Rubyn]
+	Rubyn = int(RubyR1[Rubyn])
+	Rubyg := int(RubyPgo[Rubyn])
 	Rubyj := Rubyg + RubyS[Rubyp].yys + 1
 
 	if Rubyj >= RubyLast {
-		Rubystate = RubyAct[Rubyg]
+		Rubystate = int(RubyAct[Rubyg])
 	} else {
-		Rubystate = RubyAct[Rubyj]
-		if RubyChk[Rubystate] != -Rubyn {
-			Rubystate = RubyAct[Rubyg]
+		Rubystate = int(RubyAct[Rubyj])
+		if int(RubyChk[Rubystate]) != -Rubyn {
+			Rubystate = int(RubyAct[Rubyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -1478,118 +1554,136 @@ Rubydefault:
 
 	case 1:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:239
+//line parser.y:243
 		{
 			Statements = []ast.Node{}
 		}
 	case 2:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:241
+//line parser.y:245
 		{
 			Statements = []ast.Node{}
 		}
 	case 3:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:243
+//line parser.y:247
 		{
 			Statements = []ast.Node{}
 		}
 	case 4:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:245
+//line parser.y:249
 		{
 			Statements = []ast.Node{}
 		}
 	case 5:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:247
+//line parser.y:251
 		{
 			Statements = append(Statements, RubyDollar[2].genericValue)
 		}
 	case 6:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:249
+//line parser.y:253
 		{
 			Statements = append(Statements, RubyDollar[2].genericValue)
 		}
 	case 7:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:251
+//line parser.y:255
 		{
 			Statements = append(Statements, RubyDollar[2].genericValue)
 		}
 	case 10:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:257
+//line parser.y:261
 		{
 			RubyVAL.genericSlice = RubyVAL.genericSlice
 		}
 	case 11:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:259
+//line parser.y:263
 		{
 			RubyVAL.genericValue = nil
 		}
 	case 12:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:260
+//line parser.y:264
 		{
 			RubyVAL.genericValue = nil
 		}
 	case 13:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:263
+//line parser.y:266
 		{
-			RubyVAL.genericSlice = ast.Nodes{}
+			RubyVAL.genericValue = nil
 		}
 	case 14:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:265
+//line parser.y:267
 		{
-			RubyVAL.genericSlice = RubyVAL.genericSlice
+			RubyVAL.genericValue = nil
 		}
 	case 15:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:267
+//line parser.y:268
 		{
-			RubyVAL.genericSlice = RubyVAL.genericSlice
+			RubyVAL.genericValue = nil
 		}
 	case 16:
+		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
+//line parser.y:271
+		{
+			RubyVAL.genericSlice = ast.Nodes{}
+		}
+	case 17:
+		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
+//line parser.y:273
+		{
+			RubyVAL.genericSlice = RubyVAL.genericSlice
+		}
+	case 18:
+		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
+//line parser.y:275
+		{
+			RubyVAL.genericSlice = RubyVAL.genericSlice
+		}
+	case 19:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:269
+//line parser.y:277
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[2].genericValue)
 		}
-	case 73:
+	case 77:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:280
+//line parser.y:288
 		{
 			RubyVAL.genericValue = RubyDollar[1].astString
 		}
-	case 74:
+	case 78:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:282
+//line parser.y:290
 		{
 			RubyVAL.genericValue = ast.InterpolatedString{
 				Line:  RubyDollar[1].genericValue.LineNumber(),
-				Value: RubyDollar[1].genericValue.(ast.String).StringValue() + RubyDollar[2].astString.StringValue(),
+				Value: interpolatedStringValue(RubyDollar[1].genericValue.(ast.String)) + interpolatedStringValue(RubyDollar[2].astString),
 			}
 		}
-	case 75:
+	case 79:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:290
+//line parser.y:298
 		{
-			RubyVAL.genericValue = ast.RescueModifier{Statement: RubyDollar[1].genericValue, Rescue: RubyDollar[3].genericValue}
+			RubyVAL.genericValue = ast.RescueModifier{Line: RubyDollar[1].genericValue.LineNumber(), Statement: RubyDollar[1].genericValue, Rescue: RubyDollar[3].genericValue}
 		}
-	case 76:
+	case 80:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:293
+//line parser.y:301
 		{
 			RubyVAL.genericValue = ast.StarSplat{Value: RubyDollar[2].genericValue}
 		}
-	case 77:
+	case 81:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:296
+//line parser.y:304
 		{
 			callExpr := ast.CallExpression{
 				Func: RubyDollar[1].genericValue.(ast.BareReference),
@@ -1598,9 +1692,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 78:
+	case 82:
 		RubyDollar = RubyS[Rubypt-7 : Rubypt+1]
-		//line parser.y:305
+//line parser.y:313
 		{
 			callExpr := ast.CallExpression{
 				Func:          RubyDollar[1].genericValue.(ast.BareReference),
@@ -1610,17 +1704,17 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 79:
+	case 83:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:315
+//line parser.y:323
 		{
 			callExpr := ast.CallExpression{Func: RubyDollar[1].genericValue.(ast.BareReference)}
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 80:
+	case 84:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:321
+//line parser.y:329
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line: RubyDollar[1].genericValue.LineNumber(),
@@ -1628,9 +1722,9 @@ Rubydefault:
 				Args: RubyDollar[2].genericSlice,
 			}
 		}
-	case 81:
+	case 85:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:329
+//line parser.y:337
 		{
 			callExpr := ast.CallExpression{
 				Func: RubyDollar[1].genericValue.(ast.BareReference),
@@ -1639,9 +1733,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 82:
+	case 86:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:338
+//line parser.y:346
 		{
 			callExpr := ast.CallExpression{
 				Func: ast.BareReference{Name: RubyDollar[1].genericValue.(ast.Constant).Name, Line: RubyDollar[1].genericValue.LineNumber()},
@@ -1650,9 +1744,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 83:
+	case 87:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:347
+//line parser.y:355
 		{
 			callExpr := ast.CallExpression{
 				Func: RubyDollar[1].genericValue.(ast.BareReference),
@@ -1661,9 +1755,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 84:
+	case 88:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:356
+//line parser.y:364
 		{
 			callExpr := ast.CallExpression{
 				Func:          RubyDollar[1].genericValue.(ast.BareReference),
@@ -1673,9 +1767,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 85:
+	case 89:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:366
+//line parser.y:374
 		{
 			callExpr := ast.CallExpression{
 				Func:          RubyDollar[1].genericValue.(ast.BareReference),
@@ -1685,9 +1779,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 86:
+	case 90:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:376
+//line parser.y:384
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1695,9 +1789,9 @@ Rubydefault:
 				Func:   RubyDollar[3].genericValue.(ast.BareReference),
 			}
 		}
-	case 87:
+	case 91:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:384
+//line parser.y:392
 		{
 			callExpr := ast.CallExpression{
 				Target:        RubyDollar[1].genericValue,
@@ -1708,9 +1802,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 88:
+	case 92:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:395
+//line parser.y:403
 		{
 			callExpr := ast.CallExpression{
 				Target:        RubyDollar[1].genericValue,
@@ -1721,9 +1815,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 89:
+	case 93:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:406
+//line parser.y:414
 		{
 			callExpr := ast.CallExpression{
 				Target: RubyDollar[1].genericValue,
@@ -1733,9 +1827,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 90:
+	case 94:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:416
+//line parser.y:424
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:          RubyDollar[1].genericValue.LineNumber(),
@@ -1745,9 +1839,9 @@ Rubydefault:
 				OptionalBlock: RubyDollar[5].genericBlock,
 			}
 		}
-	case 91:
+	case 95:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:426
+//line parser.y:434
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:          RubyDollar[1].genericValue.LineNumber(),
@@ -1757,9 +1851,9 @@ Rubydefault:
 				OptionalBlock: RubyDollar[4].genericBlock,
 			}
 		}
-	case 92:
+	case 96:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:436
+//line parser.y:444
 		{
 			methodName := RubyDollar[3].genericValue.(ast.BareReference).Name + "="
 			callExpr := ast.CallExpression{
@@ -1770,9 +1864,9 @@ Rubydefault:
 			callExpr.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = callExpr
 		}
-	case 93:
+	case 97:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:449
+//line parser.y:457
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line: RubyDollar[1].genericValue.LineNumber(),
@@ -1780,9 +1874,9 @@ Rubydefault:
 				Args: RubyDollar[2].genericSlice,
 			}
 		}
-	case 94:
+	case 98:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:457
+//line parser.y:465
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:          RubyDollar[1].genericValue.LineNumber(),
@@ -1791,9 +1885,9 @@ Rubydefault:
 				OptionalBlock: RubyDollar[3].genericBlock,
 			}
 		}
-	case 95:
+	case 99:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:466
+//line parser.y:474
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1802,9 +1896,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 96:
+	case 100:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:475
+//line parser.y:483
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1813,9 +1907,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 97:
+	case 101:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:484
+//line parser.y:492
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1824,9 +1918,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 98:
+	case 102:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:495
+//line parser.y:503
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1835,9 +1929,9 @@ Rubydefault:
 				Args:   RubyDollar[3].genericSlice,
 			}
 		}
-	case 99:
+	case 103:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:504
+//line parser.y:512
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1846,9 +1940,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 100:
+	case 104:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:513
+//line parser.y:521
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1857,9 +1951,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 101:
+	case 105:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:522
+//line parser.y:530
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1868,9 +1962,9 @@ Rubydefault:
 				Args:   RubyDollar[3].genericSlice,
 			}
 		}
-	case 102:
+	case 106:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:531
+//line parser.y:539
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1879,9 +1973,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 103:
+	case 107:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:540
+//line parser.y:548
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1890,9 +1984,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 104:
+	case 108:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:549
+//line parser.y:557
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1901,9 +1995,9 @@ Rubydefault:
 				Args:   RubyDollar[3].genericSlice,
 			}
 		}
-	case 105:
+	case 109:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:558
+//line parser.y:566
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1912,9 +2006,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 106:
+	case 110:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:567
+//line parser.y:575
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1923,9 +2017,9 @@ Rubydefault:
 				Args:   RubyDollar[3].genericSlice,
 			}
 		}
-	case 107:
+	case 111:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:576
+//line parser.y:584
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1934,9 +2028,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 108:
+	case 112:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:585
+//line parser.y:593
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1945,9 +2039,9 @@ Rubydefault:
 				Args:   RubyDollar[3].genericSlice,
 			}
 		}
-	case 109:
+	case 113:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:594
+//line parser.y:602
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -1956,9 +2050,9 @@ Rubydefault:
 				Args:   []ast.Node{RubyDollar[3].genericValue},
 			}
 		}
-	case 110:
+	case 114:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:603
+//line parser.y:611
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line: RubyDollar[1].genericValue.LineNumber(),
@@ -1971,9 +2065,9 @@ Rubydefault:
 				Args: RubyDollar[5].genericSlice,
 			}
 		}
-	case 111:
+	case 115:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:616
+//line parser.y:624
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line: RubyDollar[1].genericValue.LineNumber(),
@@ -1986,9 +2080,9 @@ Rubydefault:
 				Args: []ast.Node{RubyDollar[5].genericValue},
 			}
 		}
-	case 112:
+	case 116:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:632
+//line parser.y:640
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Func:   ast.BareReference{Line: RubyDollar[3].genericSlice.LineNumber(), Name: "[]="},
@@ -1997,9 +2091,9 @@ Rubydefault:
 				Line:   RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 113:
+	case 117:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:641
+//line parser.y:649
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Func:   ast.BareReference{Line: RubyDollar[3].genericValue.LineNumber(), Name: "[]="},
@@ -2008,9 +2102,9 @@ Rubydefault:
 				Line:   RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 114:
+	case 118:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:650
+//line parser.y:658
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Func:   ast.BareReference{Line: RubyDollar[3].genericSlice.LineNumber(), Name: "[]="},
@@ -2019,9 +2113,9 @@ Rubydefault:
 				Line:   RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 115:
+	case 119:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:659
+//line parser.y:667
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Func:   ast.BareReference{Line: RubyDollar[3].genericValue.LineNumber(), Name: "[]="},
@@ -2030,9 +2124,9 @@ Rubydefault:
 				Line:   RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 116:
+	case 120:
 		RubyDollar = RubyS[Rubypt-7 : Rubypt+1]
-		//line parser.y:668
+//line parser.y:676
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Func:   ast.BareReference{Line: RubyDollar[3].genericSlice.LineNumber(), Name: "[]="},
@@ -2041,9 +2135,9 @@ Rubydefault:
 				Line:   RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 117:
+	case 121:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:677
+//line parser.y:685
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Func:   ast.BareReference{Line: RubyDollar[3].genericSlice.LineNumber(), Name: "[]="},
@@ -2052,9 +2146,9 @@ Rubydefault:
 				Line:   RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 118:
+	case 122:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:686
+//line parser.y:694
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Func:   ast.BareReference{Line: RubyDollar[3].genericSlice.LineNumber(), Name: "[]="},
@@ -2063,9 +2157,9 @@ Rubydefault:
 				Line:   RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 119:
+	case 123:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:695
+//line parser.y:703
 		{
 			RubyVAL.genericValue = ast.CallExpression{
 				Line: RubyDollar[1].genericValue.LineNumber(),
@@ -2078,9 +2172,9 @@ Rubydefault:
 				Args: append(RubyDollar[5].genericSlice, RubyDollar[8].genericValue),
 			}
 		}
-	case 120:
+	case 124:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:710
+//line parser.y:718
 		{
 			callExpr := ast.CallExpression{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -2090,129 +2184,153 @@ Rubydefault:
 			}
 			RubyVAL.genericValue = callExpr
 		}
-	case 121:
+	case 125:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:722
+//line parser.y:730
 		{
 			RubyVAL.genericSlice = RubyDollar[3].genericSlice
 		}
-	case 122:
+	case 126:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:724
+//line parser.y:732
 		{
 			RubyVAL.genericSlice = append(RubyDollar[3].genericSlice, RubyDollar[6].genericValue)
 		}
-	case 123:
+	case 127:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:726
+//line parser.y:734
 		{
 			RubyVAL.genericSlice = RubyDollar[1].genericSlice
 		}
-	case 124:
+	case 128:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:728
+//line parser.y:736
 		{
 			RubyVAL.genericSlice = append(RubyDollar[1].genericSlice, RubyDollar[4].genericValue)
 		}
-	case 125:
+	case 129:
+		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
+//line parser.y:738
+		{
+			RubyVAL.genericSlice = ast.Nodes{hashFromSymbolKeyValuePairs(RubyDollar[1].genericSlice)}
+		}
+	case 130:
+		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
+//line parser.y:740
+		{
+			RubyVAL.genericSlice = append(RubyDollar[1].genericSlice, hashFromSymbolKeyValuePairs(RubyDollar[4].genericSlice))
+		}
+	case 131:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:731
+//line parser.y:743
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 126:
+	case 132:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:733
+//line parser.y:745
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[3].genericValue)
 		}
-	case 127:
+	case 133:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:736
+//line parser.y:748
 		{
 			RubyVAL.genericSlice = ast.Nodes{}
 		}
-	case 128:
+	case 134:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:738
+//line parser.y:750
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 129:
+	case 135:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:740
+//line parser.y:752
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 130:
+	case 136:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:742
+//line parser.y:754
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 131:
+	case 137:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:744
+//line parser.y:756
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.Hash{
 				Line:  RubyDollar[1].hashPairSlice[0].LineNumber(),
 				Pairs: RubyDollar[1].hashPairSlice,
 			})
 		}
-	case 132:
+	case 138:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:751
+//line parser.y:763
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 133:
+	case 139:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:753
+//line parser.y:765
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 134:
+	case 140:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:755
+//line parser.y:767
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 135:
+	case 141:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:757
+//line parser.y:769
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 136:
+	case 142:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:759
+//line parser.y:771
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 137:
+	case 143:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:761
+//line parser.y:773
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 138:
+	case 144:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:763
+//line parser.y:775
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 139:
+	case 145:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:765
+//line parser.y:777
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.Hash{
 				Line:  RubyDollar[2].genericValue.LineNumber(),
 				Pairs: RubyDollar[4].hashPairSlice,
 			})
 		}
-	case 140:
+	case 146:
+		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
+//line parser.y:784
+		{
+			RubyVAL.genericSlice = ast.Nodes{hashFromSymbolKeyValuePairs(RubyDollar[1].genericSlice)}
+		}
+	case 147:
+		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
+//line parser.y:786
+		{
+			RubyVAL.genericSlice = append(RubyDollar[1].genericSlice, hashFromSymbolKeyValuePairs(RubyDollar[4].genericSlice))
+		}
+	case 148:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:774
+//line parser.y:790
 		{
 			callExpr := ast.CallExpression{
 				Line:   RubyDollar[2].genericValue.LineNumber(),
@@ -2221,33 +2339,33 @@ Rubydefault:
 			}
 			RubyVAL.genericValue = callExpr
 		}
-	case 141:
+	case 149:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:785
+//line parser.y:801
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 142:
+	case 150:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:787
+//line parser.y:803
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 143:
+	case 151:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:791
+//line parser.y:807
 		{
 			RubyVAL.genericSlice = nil
 		}
-	case 144:
+	case 152:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:793
+//line parser.y:809
 		{
 			RubyVAL.genericSlice = RubyDollar[2].genericSlice
 		}
-	case 145:
+	case 153:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:796
+//line parser.y:812
 		{
 			method := ast.FuncDecl{
 				Name:   RubyDollar[2].genericValue.(ast.BareReference),
@@ -2258,9 +2376,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 146:
+	case 154:
 		RubyDollar = RubyS[Rubypt-7 : Rubypt+1]
-		//line parser.y:807
+//line parser.y:823
 		{
 			method := ast.FuncDecl{
 				Name:    RubyDollar[2].genericValue.(ast.BareReference),
@@ -2272,9 +2390,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 147:
+	case 155:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:819
+//line parser.y:835
 		{
 			method := ast.FuncDecl{
 				Target: RubyDollar[2].genericValue,
@@ -2286,9 +2404,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 148:
+	case 156:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:831
+//line parser.y:847
 		{
 			method := ast.FuncDecl{
 				Target: RubyDollar[2].genericValue,
@@ -2300,9 +2418,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 149:
+	case 157:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:843
+//line parser.y:859
 		{
 			method := ast.FuncDecl{
 				Target: RubyDollar[2].genericValue,
@@ -2314,9 +2432,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 150:
+	case 158:
 		RubyDollar = RubyS[Rubypt-9 : Rubypt+1]
-		//line parser.y:855
+//line parser.y:871
 		{
 			method := ast.FuncDecl{
 				Target:  RubyDollar[2].genericValue,
@@ -2329,9 +2447,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 151:
+	case 159:
 		RubyDollar = RubyS[Rubypt-9 : Rubypt+1]
-		//line parser.y:868
+//line parser.y:884
 		{
 			method := ast.FuncDecl{
 				Target:  RubyDollar[2].genericValue,
@@ -2344,9 +2462,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 152:
+	case 160:
 		RubyDollar = RubyS[Rubypt-9 : Rubypt+1]
-		//line parser.y:881
+//line parser.y:897
 		{
 			method := ast.FuncDecl{
 				Target:  RubyDollar[2].genericValue,
@@ -2359,9 +2477,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 153:
+	case 161:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:894
+//line parser.y:910
 		{
 			method := ast.FuncDecl{
 				Name:   RubyDollar[2].genericValue.(ast.BareReference),
@@ -2372,9 +2490,9 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 154:
+	case 162:
 		RubyDollar = RubyS[Rubypt-7 : Rubypt+1]
-		//line parser.y:905
+//line parser.y:921
 		{
 			method := ast.FuncDecl{
 				Name:    RubyDollar[2].genericValue.(ast.BareReference),
@@ -2386,69 +2504,81 @@ Rubydefault:
 			method.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = method
 		}
-	case 155:
+	case 163:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:919
+//line parser.y:935
 		{
 			RubyVAL.methodParamSlice = RubyDollar[1].methodParamSlice
 		}
-	case 156:
+	case 164:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:921
+//line parser.y:937
 		{
 			RubyVAL.methodParamSlice = RubyDollar[2].methodParamSlice
 		}
-	case 157:
+	case 165:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:923
+//line parser.y:939
 		{
 			RubyVAL.methodParamSlice = []ast.MethodParam{{Name: "", IsSplat: true}}
 		}
-	case 158:
+	case 166:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:926
+//line parser.y:942
 		{
 			RubyVAL.methodParamSlice = nil
 		}
-	case 159:
+	case 167:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:928
+//line parser.y:944
 		{
 			RubyVAL.methodParamSlice = append(RubyVAL.methodParamSlice, RubyDollar[1].methodParam)
 		}
-	case 160:
+	case 168:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:930
+//line parser.y:946
 		{
 			RubyVAL.methodParamSlice = append(RubyVAL.methodParamSlice, RubyDollar[3].methodParam)
 		}
-	case 161:
+	case 169:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:933
+//line parser.y:949
 		{
 			RubyVAL.methodParam = ast.MethodParam{Name: RubyDollar[1].genericValue.(ast.BareReference).Name}
 		}
-	case 162:
+	case 170:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:935
+//line parser.y:951
 		{
 			RubyVAL.methodParam = ast.MethodParam{Name: RubyDollar[2].genericValue.(ast.BareReference).Name, IsSplat: true}
 		}
-	case 163:
+	case 171:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:937
+//line parser.y:953
 		{
 			RubyVAL.methodParam = ast.MethodParam{Name: RubyDollar[1].genericValue.(ast.BareReference).Name, DefaultValue: RubyDollar[3].genericValue}
 		}
-	case 164:
+	case 172:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:939
+//line parser.y:955
 		{
 			RubyVAL.methodParam = ast.MethodParam{Name: RubyDollar[2].genericValue.(ast.BareReference).Name, IsProc: true}
 		}
-	case 165:
+	case 173:
+		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
+//line parser.y:957
+		{
+			RubyVAL.methodParam = ast.MethodParam{Name: RubyDollar[1].genericValue.(ast.BareReference).Name, IsKeyword: true}
+		}
+	case 174:
+		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
+//line parser.y:959
+		{
+			RubyVAL.methodParam = ast.MethodParam{Name: RubyDollar[1].genericValue.(ast.BareReference).Name, IsKeyword: true, DefaultValue: RubyDollar[3].genericValue}
+		}
+	case 175:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:943
+//line parser.y:963
 		{
 			class := ast.ClassDecl{
 				Name:      RubyDollar[2].genericValue.(ast.Class).Name,
@@ -2458,9 +2588,9 @@ Rubydefault:
 			class.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = class
 		}
-	case 166:
+	case 176:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:953
+//line parser.y:973
 		{
 			class := ast.ClassDecl{
 				Name:       RubyDollar[2].genericValue.(ast.Class).Name,
@@ -2471,9 +2601,9 @@ Rubydefault:
 			class.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = class
 		}
-	case 167:
+	case 177:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:965
+//line parser.y:985
 		{
 			if RubyDollar[2].genericValue.(ast.BareReference).Name != "<<" {
 				panic("FREAKOUT")
@@ -2485,9 +2615,9 @@ Rubydefault:
 				Body:   RubyDollar[4].genericSlice,
 			}
 		}
-	case 168:
+	case 178:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:978
+//line parser.y:998
 		{
 			module := ast.ModuleDecl{
 				Name:      RubyDollar[2].genericValue.(ast.Class).Name,
@@ -2497,9 +2627,9 @@ Rubydefault:
 			module.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = module
 		}
-	case 169:
+	case 179:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:989
+//line parser.y:1009
 		{
 			class := ast.Class{
 				Name:              RubyDollar[1].genericValue.(ast.Constant).Name,
@@ -2508,9 +2638,9 @@ Rubydefault:
 			class.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = class
 		}
-	case 170:
+	case 180:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:998
+//line parser.y:1018
 		{
 			firstPart := RubyDollar[1].genericValue.(ast.Constant).Name
 			fullName := strings.Join([]string{firstPart, RubyDollar[2].genericValue.(ast.BareReference).Name}, "")
@@ -2529,9 +2659,9 @@ Rubydefault:
 			class.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = class
 		}
-	case 171:
+	case 181:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1017
+//line parser.y:1037
 		{
 			pieces := strings.Split(RubyDollar[1].genericValue.(ast.BareReference).Name, "::")
 			name := pieces[len(pieces)-1]
@@ -2547,9 +2677,9 @@ Rubydefault:
 				IsGlobalNamespace: true,
 			}
 		}
-	case 172:
+	case 182:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1035
+//line parser.y:1055
 		{
 			eql := ast.Assignment{
 				LHS: RubyDollar[1].genericValue,
@@ -2558,31 +2688,37 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 173:
+	case 183:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1044
+//line parser.y:1064
 		{
 			eql := ast.Assignment{LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 174:
+	case 184:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1050
+//line parser.y:1070
 		{
 			eql := ast.Assignment{LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 175:
+	case 185:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1056
+//line parser.y:1076
 		{
 			RubyVAL.genericValue = ast.Assignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 		}
-	case 176:
+	case 186:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1058
+//line parser.y:1078
+		{
+			RubyVAL.genericValue = ast.Assignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
+		}
+	case 187:
+		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
+//line parser.y:1080
 		{
 			eql := ast.Assignment{
 				LHS: RubyDollar[1].genericValue,
@@ -2591,27 +2727,27 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 177:
+	case 188:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1067
+//line parser.y:1089
 		{
 			RubyVAL.genericValue = ast.Assignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 		}
-	case 178:
+	case 189:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1069
+//line parser.y:1091
 		{
 			RubyVAL.genericValue = ast.Assignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 		}
-	case 179:
+	case 190:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1071
+//line parser.y:1093
 		{
 			RubyVAL.genericValue = ast.Assignment{LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue, Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 180:
+	case 191:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1074
+//line parser.y:1096
 		{
 			eql := ast.Assignment{
 				LHS: RubyDollar[1].genericValue,
@@ -2620,9 +2756,9 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 181:
+	case 192:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1083
+//line parser.y:1105
 		{
 			var rhs ast.Node = RubyDollar[3].genericSlice
 			if len(RubyDollar[3].genericSlice) == 1 {
@@ -2634,9 +2770,9 @@ Rubydefault:
 				RHS:  rhs,
 			}
 		}
-	case 182:
+	case 193:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1095
+//line parser.y:1117
 		{
 			eql := ast.Assignment{
 				LHS: ast.Array{Nodes: RubyDollar[1].genericSlice},
@@ -2645,9 +2781,9 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericSlice[0].(ast.CallExpression).Target.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 183:
+	case 194:
 		RubyDollar = RubyS[Rubypt-9 : Rubypt+1]
-		//line parser.y:1105
+//line parser.y:1127
 		{
 			RubyVAL.genericSlice = []ast.Node{
 				ast.CallExpression{
@@ -2662,16 +2798,16 @@ Rubydefault:
 				},
 			}
 		}
-	case 184:
+	case 195:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1120
+//line parser.y:1142
 		{
 			tail := ast.CallExpression{Line: RubyDollar[3].genericValue.LineNumber(), Target: RubyDollar[3].genericValue, Func: ast.BareReference{Name: "[]="}, Args: []ast.Node{RubyDollar[5].genericValue}}
 			RubyVAL.genericSlice = append(RubyDollar[1].genericSlice, tail)
 		}
-	case 185:
+	case 196:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1126
+//line parser.y:1148
 		{
 			eql := ast.ConditionalAssignment{
 				LHS: RubyDollar[1].genericValue,
@@ -2680,17 +2816,17 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 186:
+	case 197:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1135
+//line parser.y:1157
 		{
 			eql := ast.ConditionalAssignment{LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 187:
+	case 198:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1141
+//line parser.y:1163
 		{
 			eql := ast.ConditionalAssignment{
 				LHS: RubyDollar[1].genericValue,
@@ -2699,29 +2835,29 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 188:
+	case 199:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1150
+//line parser.y:1172
 		{
 			RubyVAL.genericValue = ast.ConditionalAssignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 		}
-	case 189:
+	case 200:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1152
+//line parser.y:1174
 		{
 			RubyVAL.genericValue = ast.ConditionalAssignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 		}
-	case 190:
+	case 201:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1154
+//line parser.y:1176
 		{
 			eql := ast.ConditionalAssignment{LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 191:
+	case 202:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1163
+//line parser.y:1185
 		{
 			eql := ast.ConditionalTruthyAssignment{
 				LHS: RubyDollar[1].genericValue,
@@ -2730,17 +2866,17 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 192:
+	case 203:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1172
+//line parser.y:1194
 		{
 			eql := ast.ConditionalTruthyAssignment{LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 193:
+	case 204:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1178
+//line parser.y:1200
 		{
 			eql := ast.ConditionalTruthyAssignment{
 				LHS: RubyDollar[1].genericValue,
@@ -2749,129 +2885,129 @@ Rubydefault:
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 194:
+	case 205:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1187
+//line parser.y:1209
 		{
 			RubyVAL.genericValue = ast.ConditionalTruthyAssignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 		}
-	case 195:
+	case 206:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1189
+//line parser.y:1211
 		{
 			RubyVAL.genericValue = ast.ConditionalTruthyAssignment{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 		}
-	case 196:
+	case 207:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1191
+//line parser.y:1213
 		{
 			eql := ast.ConditionalTruthyAssignment{LHS: RubyDollar[1].genericValue, RHS: RubyDollar[3].genericValue}
 			eql.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = eql
 		}
-	case 197:
+	case 208:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1199
+//line parser.y:1221
 		{
 			vars := ast.Array{Nodes: []ast.Node{RubyDollar[1].genericValue, RubyDollar[3].genericValue}}
 			vars.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = vars
 		}
-	case 198:
+	case 209:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1201
+//line parser.y:1223
 		{
 			RubyVAL.genericValue = ast.Array{Nodes: []ast.Node{RubyDollar[1].genericValue, RubyDollar[3].genericValue}, Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 199:
+	case 210:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1203
+//line parser.y:1225
 		{
 			vars := ast.Array{Nodes: []ast.Node{RubyDollar[1].genericValue, ast.StarSplat{Value: RubyDollar[4].genericValue}}}
 			vars.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = vars
 		}
-	case 200:
+	case 211:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1206
+//line parser.y:1228
 		{
 			vars := ast.Array{Nodes: []ast.Node{RubyDollar[1].genericValue, RubyDollar[3].genericValue}}
 			vars.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = vars
 		}
-	case 201:
+	case 212:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1208
+//line parser.y:1230
 		{
 			RubyVAL.genericValue = ast.Array{Nodes: []ast.Node{RubyDollar[1].genericValue, RubyDollar[3].genericValue}, Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 202:
+	case 213:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1210
+//line parser.y:1232
 		{
 			vars := ast.Array{Nodes: []ast.Node{RubyDollar[1].genericValue, ast.StarSplat{Value: RubyDollar[4].genericValue}}}
 			vars.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = vars
 		}
-	case 203:
+	case 214:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1213
+//line parser.y:1235
 		{
 			vars := ast.Array{Nodes: append(RubyVAL.genericValue.(ast.Array).Nodes, RubyDollar[3].genericValue)}
 			vars.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = vars
 		}
-	case 204:
+	case 215:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1215
+//line parser.y:1237
 		{
 			vars := ast.Array{Nodes: append(RubyVAL.genericValue.(ast.Array).Nodes, RubyDollar[3].genericValue)}
 			vars.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = vars
 		}
-	case 205:
+	case 216:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1217
+//line parser.y:1239
 		{
 			vars := ast.Array{Nodes: []ast.Node{RubyDollar[1].genericValue, ast.StarSplat{Value: RubyDollar[4].genericValue}}}
 			vars.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = vars
 		}
-	case 206:
+	case 217:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1221
+//line parser.y:1243
 		{
 			bang := ast.Negation{Target: RubyDollar[2].genericValue}
 			bang.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = bang
 		}
-	case 207:
+	case 218:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1223
+//line parser.y:1245
 		{
 			comp := ast.Complement{Target: RubyDollar[2].genericValue}
 			comp.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = comp
 		}
-	case 208:
+	case 219:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1225
+//line parser.y:1247
 		{
 			plus := ast.Positive{Target: RubyDollar[2].genericValue}
 			plus.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = plus
 		}
-	case 209:
+	case 220:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1227
+//line parser.y:1249
 		{
 			minus := ast.Negative{Target: RubyDollar[2].genericValue}
 			minus.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = minus
 		}
-	case 210:
+	case 221:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1230
+//line parser.y:1252
 		{
 			add := ast.CallExpression{
 				Target: RubyDollar[1].genericValue,
@@ -2881,9 +3017,9 @@ Rubydefault:
 			add.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = add
 		}
-	case 211:
+	case 222:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1241
+//line parser.y:1263
 		{
 			sub := ast.CallExpression{
 				Target: RubyDollar[1].genericValue,
@@ -2893,9 +3029,9 @@ Rubydefault:
 			sub.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = sub
 		}
-	case 212:
+	case 223:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1252
+//line parser.y:1274
 		{
 			mult := ast.CallExpression{
 				Target: RubyDollar[1].genericValue,
@@ -2905,9 +3041,21 @@ Rubydefault:
 			mult.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = mult
 		}
-	case 213:
+	case 224:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1263
+//line parser.y:1284
+		{
+			mult := ast.CallExpression{
+				Target: RubyDollar[1].genericValue,
+				Func:   ast.BareReference{Line: RubyDollar[2].genericValue.LineNumber(), Name: "*"},
+				Args:   []ast.Node{RubyDollar[3].genericValue},
+			}
+			mult.Line = RubyDollar[1].genericValue.LineNumber()
+			RubyVAL.genericValue = mult
+		}
+	case 225:
+		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
+//line parser.y:1295
 		{
 			divis := ast.CallExpression{
 				Target: RubyDollar[1].genericValue,
@@ -2917,9 +3065,9 @@ Rubydefault:
 			divis.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = divis
 		}
-	case 214:
+	case 226:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1274
+//line parser.y:1306
 		{
 			and := ast.CallExpression{
 				Target: RubyDollar[1].genericValue,
@@ -2929,9 +3077,9 @@ Rubydefault:
 			and.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = and
 		}
-	case 215:
+	case 227:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1285
+//line parser.y:1317
 		{
 			or := ast.CallExpression{
 				Target: RubyDollar[1].genericValue,
@@ -2941,210 +3089,208 @@ Rubydefault:
 			or.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = or
 		}
-	case 216:
+	case 228:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1296
+//line parser.y:1328
 		{
 			RubyVAL.genericValue = ast.Array{Line: RubyDollar[1].genericValue.LineNumber(), Nodes: RubyDollar[3].genericSlice}
 		}
-	case 217:
+	case 229:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1298
+//line parser.y:1330
 		{
 			RubyVAL.genericValue = RubyDollar[1].genericValue
 		}
-	case 218:
+	case 230:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1299
+//line parser.y:1331
 		{
 			RubyVAL.genericValue = RubyDollar[1].genericValue
 		}
-	case 219:
+	case 231:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:1301
+//line parser.y:1333
 		{
 			RubyVAL.genericSlice = ast.Nodes{}
 		}
-	case 220:
+	case 232:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1303
+//line parser.y:1335
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 221:
+	case 233:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1305
+//line parser.y:1337
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 222:
+	case 234:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1307
+//line parser.y:1339
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 223:
+	case 235:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1309
+//line parser.y:1341
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 224:
+	case 236:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1311
+//line parser.y:1343
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 225:
+	case 237:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1313
+//line parser.y:1345
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[4].genericValue)
 		}
-	case 226:
+	case 238:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1316
+//line parser.y:1348
 		{
 			RubyVAL.genericValue = ast.Hash{Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 227:
+	case 239:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1318
+//line parser.y:1350
 		{
 			RubyVAL.genericValue = ast.Hash{Line: RubyDollar[1].genericValue.LineNumber(), Pairs: RubyDollar[3].hashPairSlice}
 		}
-	case 228:
+	case 240:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1320
+//line parser.y:1352
 		{
 			RubyVAL.genericValue = ast.Hash{Line: RubyDollar[1].genericValue.LineNumber(), Pairs: RubyDollar[3].hashPairSlice}
 		}
-	case 229:
+	case 241:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1322
+//line parser.y:1354
 		{
-			pairs := []ast.HashKeyValuePair{}
-			for _, node := range RubyDollar[3].genericSlice {
-				pairs = append(pairs, node.(ast.HashKeyValuePair))
-			}
-			RubyVAL.genericValue = ast.Hash{Line: RubyDollar[1].genericValue.LineNumber(), Pairs: pairs}
+			hash := hashFromSymbolKeyValuePairs(RubyDollar[3].genericSlice)
+			hash.Line = RubyDollar[1].genericValue.LineNumber()
+			RubyVAL.genericValue = hash
 		}
-	case 230:
+	case 242:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1331
+//line parser.y:1361
 		{
 			RubyVAL.hashPair = ast.HashKeyValuePair{Key: RubyDollar[1].genericValue, Value: RubyDollar[3].genericValue}
 		}
-	case 231:
+	case 243:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1334
+//line parser.y:1364
 		{
 			RubyVAL.hashPairSlice = append(RubyVAL.hashPairSlice, RubyDollar[1].hashPair)
 		}
-	case 232:
+	case 244:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1336
+//line parser.y:1366
 		{
 			RubyVAL.hashPairSlice = append(RubyVAL.hashPairSlice, RubyDollar[4].hashPair)
 		}
-	case 233:
+	case 245:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1339
+//line parser.y:1369
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.HashKeyValuePair{
 				Key:   ast.Symbol{Line: RubyDollar[1].genericValue.LineNumber(), Name: RubyDollar[1].genericValue.(ast.BareReference).Name},
 				Value: RubyDollar[3].genericValue,
 			})
 		}
-	case 234:
+	case 246:
 		RubyDollar = RubyS[Rubypt-7 : Rubypt+1]
-		//line parser.y:1346
+//line parser.y:1376
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.HashKeyValuePair{
 				Key:   ast.Symbol{Line: RubyDollar[4].genericValue.LineNumber(), Name: RubyDollar[4].genericValue.(ast.BareReference).Name},
 				Value: RubyDollar[6].genericValue,
 			})
 		}
-	case 235:
+	case 247:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:1353
+//line parser.y:1383
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.HashKeyValuePair{
 				Key:   ast.Symbol{Line: RubyDollar[4].genericValue.LineNumber(), Name: RubyDollar[4].genericValue.(ast.BareReference).Name},
 				Value: RubyDollar[6].genericValue,
 			})
 		}
-	case 236:
+	case 248:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1361
+//line parser.y:1391
 		{
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Body: RubyDollar[2].genericSlice}
 		}
-	case 237:
+	case 249:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1365
+//line parser.y:1395
 		{
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Args: RubyDollar[2].methodParamSlice, Body: RubyDollar[3].genericSlice}
 		}
-	case 238:
+	case 250:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1369
+//line parser.y:1399
 		{
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Body: RubyDollar[2].genericSlice}
 		}
-	case 239:
+	case 251:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1373
+//line parser.y:1403
 		{
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Args: RubyDollar[2].methodParamSlice, Body: RubyDollar[3].genericSlice}
 		}
-	case 240:
+	case 252:
 		RubyDollar = RubyS[Rubypt-7 : Rubypt+1]
-		//line parser.y:1377
+//line parser.y:1407
 		{
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Body: RubyDollar[4].genericSlice}
 		}
-	case 241:
+	case 253:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:1381
+//line parser.y:1411
 		{
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Args: RubyDollar[4].methodParamSlice, Body: RubyDollar[5].genericSlice}
 		}
-	case 242:
+	case 254:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1385
+//line parser.y:1415
 		{
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Body: []ast.Node{RubyDollar[3].genericValue}}
 		}
-	case 243:
+	case 255:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1389
+//line parser.y:1419
 		{
 			head := []ast.Node{RubyDollar[3].genericValue}
 			tail := RubyDollar[4].genericSlice
 			body := append(head, tail...)
 			RubyVAL.genericBlock = ast.Block{Line: RubyDollar[1].genericValue.LineNumber(), Body: body}
 		}
-	case 244:
+	case 256:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:1397
+//line parser.y:1427
 		{
 		}
-	case 245:
+	case 257:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1397
+//line parser.y:1427
 		{
 			RubyVAL.genericBlock = RubyDollar[1].genericBlock
 		}
-	case 246:
+	case 258:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1401
+//line parser.y:1431
 		{
 			RubyVAL.methodParamSlice = RubyDollar[2].methodParamSlice
 		}
-	case 247:
+	case 259:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1405
+//line parser.y:1435
 		{
 			cond := ast.IfBlock{
 				Condition: RubyDollar[2].genericValue,
@@ -3153,9 +3299,9 @@ Rubydefault:
 			cond.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 248:
+	case 260:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1414
+//line parser.y:1444
 		{
 			cond := ast.IfBlock{
 				Condition: RubyDollar[2].genericValue,
@@ -3165,9 +3311,9 @@ Rubydefault:
 			cond.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 249:
+	case 261:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1424
+//line parser.y:1454
 		{
 			cond := ast.IfBlock{
 				Condition: RubyDollar[3].genericValue,
@@ -3176,9 +3322,9 @@ Rubydefault:
 			cond.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 250:
+	case 262:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1433
+//line parser.y:1463
 		{
 			cond := ast.IfBlock{
 				Condition: RubyDollar[3].genericValue,
@@ -3187,9 +3333,9 @@ Rubydefault:
 			cond.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 251:
+	case 263:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1442
+//line parser.y:1472
 		{
 			cond := ast.IfBlock{
 				Condition: ast.Negation{Line: RubyDollar[1].genericValue.LineNumber(), Target: RubyDollar[3].genericValue},
@@ -3198,9 +3344,9 @@ Rubydefault:
 			cond.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 252:
+	case 264:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1451
+//line parser.y:1481
 		{
 			cond := ast.IfBlock{
 				Condition: ast.Negation{Line: RubyDollar[1].genericValue.LineNumber(), Target: RubyDollar[3].genericValue},
@@ -3209,9 +3355,9 @@ Rubydefault:
 			cond.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 253:
+	case 265:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1460
+//line parser.y:1490
 		{
 			cond := ast.IfBlock{
 				Condition: ast.Negation{Line: RubyDollar[1].genericValue.LineNumber(), Target: RubyDollar[3].genericValue},
@@ -3220,9 +3366,9 @@ Rubydefault:
 			cond.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 254:
+	case 266:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1469
+//line parser.y:1499
 		{
 			cond := ast.IfBlock{
 				Condition: ast.Negation{Line: RubyDollar[2].genericValue.LineNumber(), Target: RubyDollar[2].genericValue},
@@ -3231,9 +3377,9 @@ Rubydefault:
 			cond.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 255:
+	case 267:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1478
+//line parser.y:1508
 		{
 			cond := ast.IfBlock{
 				Condition: ast.Negation{Line: RubyDollar[2].genericValue.LineNumber(), Target: RubyDollar[2].genericValue},
@@ -3243,9 +3389,9 @@ Rubydefault:
 			cond.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 256:
+	case 268:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1488
+//line parser.y:1518
 		{
 			cond := ast.IfBlock{
 				Condition: ast.Negation{Line: RubyDollar[2].genericValue.LineNumber(), Target: RubyDollar[2].genericValue},
@@ -3254,9 +3400,9 @@ Rubydefault:
 			cond.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 257:
+	case 269:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1497
+//line parser.y:1527
 		{
 			cond := ast.IfBlock{
 				Condition: ast.Negation{Line: RubyDollar[1].genericValue.LineNumber(), Target: RubyDollar[3].genericValue},
@@ -3265,9 +3411,9 @@ Rubydefault:
 			cond.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = cond
 		}
-	case 258:
+	case 270:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1508
+//line parser.y:1538
 		{
 			ifblock := ast.IfBlock{
 				Line:      RubyDollar[3].genericValue.LineNumber(),
@@ -3276,9 +3422,9 @@ Rubydefault:
 			}
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ifblock)
 		}
-	case 259:
+	case 271:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1517
+//line parser.y:1547
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3286,9 +3432,9 @@ Rubydefault:
 				Body:      RubyDollar[3].genericSlice,
 			})
 		}
-	case 260:
+	case 272:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1525
+//line parser.y:1555
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3296,9 +3442,9 @@ Rubydefault:
 				Body:      RubyDollar[3].genericSlice,
 			})
 		}
-	case 261:
+	case 273:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1533
+//line parser.y:1563
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.IfBlock{
 				Line:      RubyDollar[1].genericValue.LineNumber(),
@@ -3306,35 +3452,35 @@ Rubydefault:
 				Body:      RubyDollar[2].genericSlice,
 			})
 		}
-	case 262:
+	case 274:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:1541
+//line parser.y:1571
 		{
 			RubyVAL.genericSlice = []ast.Node{}
 		}
-	case 263:
+	case 275:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1542
+//line parser.y:1572
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[2].genericValue)
 		}
-	case 264:
+	case 276:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1543
+//line parser.y:1573
 		{
 			RubyVAL.genericSlice = RubyVAL.genericSlice
 		}
-	case 265:
+	case 277:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1546
+//line parser.y:1576
 		{
 			group := ast.Group{Body: RubyDollar[2].genericSlice}
 			group.Line = RubyDollar[1].genericValue.(ast.Nil).Line
 			RubyVAL.genericValue = group
 		}
-	case 266:
+	case 278:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1549
+//line parser.y:1579
 		{
 			begin := ast.Begin{
 				Body:   RubyDollar[2].genericSlice,
@@ -3343,9 +3489,9 @@ Rubydefault:
 			begin.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = begin
 		}
-	case 267:
+	case 279:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1558
+//line parser.y:1588
 		{
 			begin := ast.Begin{
 				Body:   RubyDollar[2].genericSlice,
@@ -3355,9 +3501,9 @@ Rubydefault:
 			begin.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = begin
 		}
-	case 268:
+	case 280:
 		RubyDollar = RubyS[Rubypt-8 : Rubypt+1]
-		//line parser.y:1568
+//line parser.y:1598
 		{
 			RubyVAL.genericValue = ast.Begin{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -3367,9 +3513,9 @@ Rubydefault:
 				Ensure: RubyDollar[7].genericSlice,
 			}
 		}
-	case 269:
+	case 281:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1578
+//line parser.y:1608
 		{
 			RubyVAL.genericValue = ast.Begin{
 				Line:   RubyDollar[1].genericValue.LineNumber(),
@@ -3378,15 +3524,15 @@ Rubydefault:
 				Ensure: RubyDollar[5].genericSlice,
 			}
 		}
-	case 270:
+	case 282:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1588
+//line parser.y:1618
 		{
 			RubyVAL.genericValue = ast.Rescue{Line: RubyDollar[1].genericValue.LineNumber(), Body: RubyDollar[2].genericSlice}
 		}
-	case 271:
+	case 283:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1590
+//line parser.y:1620
 		{
 			classes := []ast.Class{}
 			for _, class := range RubyDollar[2].genericSlice {
@@ -3400,9 +3546,9 @@ Rubydefault:
 				},
 			}
 		}
-	case 272:
+	case 284:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1604
+//line parser.y:1634
 		{
 			classes := []ast.Class{}
 			for _, class := range RubyDollar[2].genericSlice {
@@ -3418,9 +3564,9 @@ Rubydefault:
 				},
 			}
 		}
-	case 273:
+	case 285:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1620
+//line parser.y:1650
 		{
 			classes := []ast.Class{}
 			for _, class := range RubyDollar[2].genericSlice {
@@ -3436,9 +3582,9 @@ Rubydefault:
 				},
 			}
 		}
-	case 274:
+	case 286:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1636
+//line parser.y:1666
 		{
 			RubyVAL.genericValue = ast.Rescue{
 				Line: RubyDollar[1].genericValue.LineNumber(),
@@ -3448,9 +3594,9 @@ Rubydefault:
 				},
 			}
 		}
-	case 275:
+	case 287:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1646
+//line parser.y:1676
 		{
 			RubyVAL.genericValue = ast.Rescue{
 				Line: RubyDollar[1].genericValue.LineNumber(),
@@ -3460,45 +3606,45 @@ Rubydefault:
 				},
 			}
 		}
-	case 276:
+	case 288:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1658
+//line parser.y:1688
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 277:
+	case 289:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1660
+//line parser.y:1690
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[3].genericValue)
 		}
-	case 278:
+	case 290:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:1663
+//line parser.y:1693
 		{
 			RubyVAL.genericSlice = []ast.Node{}
 		}
-	case 279:
+	case 291:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1665
+//line parser.y:1695
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[2].genericValue)
 		}
-	case 280:
+	case 292:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1668
+//line parser.y:1698
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[1].genericValue)
 		}
-	case 281:
+	case 293:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1670
+//line parser.y:1700
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[2].genericValue)
 		}
-	case 282:
+	case 294:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1673
+//line parser.y:1703
 		{
 			if len(RubyDollar[2].genericSlice) == 1 {
 				RubyVAL.genericValue = ast.Yield{Line: RubyDollar[1].genericValue.LineNumber(), Value: RubyDollar[2].genericSlice[0]}
@@ -3506,21 +3652,21 @@ Rubydefault:
 				RubyVAL.genericValue = ast.Yield{Line: RubyDollar[1].genericValue.LineNumber(), Value: RubyDollar[2].genericSlice}
 			}
 		}
-	case 283:
+	case 295:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1680
+//line parser.y:1710
 		{
 			RubyVAL.genericValue = ast.Yield{Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 284:
+	case 296:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1682
+//line parser.y:1712
 		{
 			RubyVAL.genericValue = ast.Retry{Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 285:
+	case 297:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1685
+//line parser.y:1715
 		{
 			if len(RubyDollar[2].genericSlice) == 1 {
 				RubyVAL.genericValue = ast.Return{Line: RubyDollar[1].genericValue.LineNumber(), Value: RubyDollar[2].genericSlice[0]}
@@ -3528,63 +3674,63 @@ Rubydefault:
 				RubyVAL.genericValue = ast.Return{Line: RubyDollar[1].genericValue.LineNumber(), Value: RubyDollar[2].genericSlice}
 			}
 		}
-	case 286:
+	case 298:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1693
+//line parser.y:1723
 		{
 			RubyVAL.genericValue = ast.Return{Line: RubyDollar[1].genericValue.LineNumber(), Value: RubyDollar[2].genericValue}
 		}
-	case 287:
+	case 299:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1695
+//line parser.y:1725
 		{
 			RubyVAL.genericValue = ast.Return{Line: RubyDollar[1].genericValue.LineNumber(), Value: RubyDollar[2].genericValue}
 		}
-	case 288:
+	case 300:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1697
+//line parser.y:1727
 		{
 			RubyVAL.genericValue = ast.Return{Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 289:
+	case 301:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1701
+//line parser.y:1731
 		{
 			RubyVAL.genericValue = ast.Next{}
 		}
-	case 290:
+	case 302:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1703
+//line parser.y:1733
 		{
 			RubyVAL.genericValue = ast.IfBlock{Line: RubyDollar[3].genericValue.LineNumber(), Condition: RubyDollar[3].genericValue, Body: []ast.Node{ast.Next{}}}
 		}
-	case 291:
+	case 303:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1705
+//line parser.y:1735
 		{
 			RubyVAL.genericValue = ast.IfBlock{Line: RubyDollar[3].genericValue.LineNumber(), Condition: ast.Negation{Line: RubyDollar[3].genericValue.LineNumber(), Target: RubyDollar[3].genericValue}, Body: []ast.Node{ast.Next{}}}
 		}
-	case 292:
+	case 304:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1709
+//line parser.y:1739
 		{
 			RubyVAL.genericValue = ast.Break{}
 		}
-	case 293:
+	case 305:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1711
+//line parser.y:1741
 		{
 			RubyVAL.genericValue = ast.IfBlock{Line: RubyDollar[3].genericValue.LineNumber(), Condition: RubyDollar[3].genericValue, Body: []ast.Node{ast.Break{}}}
 		}
-	case 294:
+	case 306:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1713
+//line parser.y:1743
 		{
 			RubyVAL.genericValue = ast.IfBlock{Line: RubyDollar[3].genericValue.LineNumber(), Condition: ast.Negation{Line: RubyDollar[3].genericValue.LineNumber(), Target: RubyDollar[3].genericValue}, Body: []ast.Node{ast.Break{}}}
 		}
-	case 295:
+	case 307:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1717
+//line parser.y:1747
 		{
 			ternary := ast.Ternary{
 				Condition: RubyDollar[1].genericValue,
@@ -3594,9 +3740,9 @@ Rubydefault:
 			ternary.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = ternary
 		}
-	case 296:
+	case 308:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1727
+//line parser.y:1757
 		{
 			RubyVAL.genericValue = ast.Ternary{
 				Condition: RubyDollar[1].genericValue,
@@ -3605,72 +3751,92 @@ Rubydefault:
 				Line:      RubyDollar[1].genericValue.LineNumber(),
 			}
 		}
-	case 297:
+	case 309:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1737
+//line parser.y:1767
 		{
 			loop := ast.Loop{Condition: RubyDollar[2].genericValue, Body: RubyDollar[4].genericSlice}
 			loop.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = loop
 		}
-	case 298:
+	case 310:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1743
+//line parser.y:1773
 		{
 			condition := ast.Negation{Line: RubyDollar[2].genericValue.LineNumber(), Target: RubyDollar[2].genericValue}
 			loop := ast.Loop{Condition: condition, Body: RubyDollar[4].genericSlice}
 			loop.Line = RubyDollar[2].genericValue.LineNumber()
 			RubyVAL.genericValue = loop
 		}
-	case 299:
+	case 311:
+		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
+//line parser.y:1780
+		{
+			loop := ast.Loop{Condition: RubyDollar[2].genericValue, Body: RubyDollar[4].genericSlice}
+			loop.Line = RubyDollar[2].genericValue.LineNumber()
+			RubyVAL.genericValue = loop
+		}
+	case 312:
+		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
+//line parser.y:1786
+		{
+			condition := ast.Negation{Line: RubyDollar[2].genericValue.LineNumber(), Target: RubyDollar[2].genericValue}
+			loop := ast.Loop{Condition: condition, Body: RubyDollar[4].genericSlice}
+			loop.Line = RubyDollar[2].genericValue.LineNumber()
+			RubyVAL.genericValue = loop
+		}
+	case 313:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1750
+//line parser.y:1793
 		{
+			_, bodyFirst := RubyDollar[1].genericValue.(ast.Begin)
 			RubyVAL.genericValue = ast.Loop{
 				Line:      RubyDollar[1].genericValue.LineNumber(),
 				Condition: ast.Negation{Line: RubyDollar[1].genericValue.LineNumber(), Target: RubyDollar[3].genericValue},
 				Body:      []ast.Node{RubyDollar[1].genericValue},
+				BodyFirst: bodyFirst,
 			}
 		}
-	case 300:
+	case 314:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1758
+//line parser.y:1803
 		{
-			loop := ast.Loop{Condition: RubyDollar[3].genericValue, Body: []ast.Node{RubyDollar[1].genericValue}}
+			_, bodyFirst := RubyDollar[1].genericValue.(ast.Begin)
+			loop := ast.Loop{Condition: RubyDollar[3].genericValue, Body: []ast.Node{RubyDollar[1].genericValue}, BodyFirst: bodyFirst}
 			loop.Line = RubyDollar[3].genericValue.LineNumber()
 			RubyVAL.genericValue = loop
 		}
-	case 301:
+	case 315:
 		RubyDollar = RubyS[Rubypt-0 : Rubypt+1]
-		//line parser.y:1765
+//line parser.y:1811
 		{
 			RubyVAL.genericSlice = ast.Nodes{}
 		}
-	case 302:
+	case 316:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1767
+//line parser.y:1813
 		{
 		}
-	case 303:
+	case 317:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1769
+//line parser.y:1815
 		{
 		}
-	case 304:
+	case 318:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1771
+//line parser.y:1817
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[2].genericValue)
 		}
-	case 305:
+	case 319:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1773
+//line parser.y:1819
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, RubyDollar[2].genericValue)
 		}
-	case 306:
+	case 320:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1776
+//line parser.y:1822
 		{
 			RubyVAL.genericValue = ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3678,9 +3844,9 @@ Rubydefault:
 				Body:      RubyDollar[4].genericSlice,
 			}
 		}
-	case 307:
+	case 321:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1784
+//line parser.y:1830
 		{
 			RubyVAL.genericValue = ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3689,9 +3855,9 @@ Rubydefault:
 				Else:      RubyDollar[5].genericSlice,
 			}
 		}
-	case 308:
+	case 322:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1793
+//line parser.y:1839
 		{
 			RubyVAL.genericValue = ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3699,9 +3865,9 @@ Rubydefault:
 				Body:      RubyDollar[4].genericSlice,
 			}
 		}
-	case 309:
+	case 323:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1801
+//line parser.y:1847
 		{
 			RubyVAL.genericValue = ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3710,9 +3876,9 @@ Rubydefault:
 				Else:      RubyDollar[5].genericSlice,
 			}
 		}
-	case 310:
+	case 324:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1810
+//line parser.y:1856
 		{
 			RubyVAL.genericValue = ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3720,9 +3886,9 @@ Rubydefault:
 				Body:      RubyDollar[4].genericSlice,
 			}
 		}
-	case 311:
+	case 325:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1819
+//line parser.y:1865
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.IfBlock{
 				Line:      RubyDollar[3].genericValue.LineNumber(),
@@ -3730,9 +3896,9 @@ Rubydefault:
 				Body:      RubyDollar[4].genericSlice,
 			})
 		}
-	case 312:
+	case 326:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1827
+//line parser.y:1873
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.IfBlock{
 				Line:      RubyDollar[1].genericSlice.LineNumber(),
@@ -3740,9 +3906,9 @@ Rubydefault:
 				Body:      RubyDollar[3].genericSlice,
 			})
 		}
-	case 313:
+	case 327:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1835
+//line parser.y:1881
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.IfBlock{
 				Line:      RubyDollar[2].genericValue.LineNumber(),
@@ -3750,9 +3916,9 @@ Rubydefault:
 				Body:      RubyDollar[3].genericSlice,
 			})
 		}
-	case 314:
+	case 328:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1843
+//line parser.y:1889
 		{
 			RubyVAL.genericSlice = append(RubyVAL.genericSlice, ast.IfBlock{
 				Line:      RubyDollar[1].genericValue.LineNumber(),
@@ -3760,79 +3926,79 @@ Rubydefault:
 				Body:      RubyDollar[2].genericSlice,
 			})
 		}
-	case 315:
+	case 329:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1852
+//line parser.y:1898
 		{
 			RubyVAL.genericValue = ast.WeakLogicalAnd{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[4].genericValue}
 		}
-	case 316:
+	case 330:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1855
+//line parser.y:1901
 		{
 			RubyVAL.genericValue = ast.WeakLogicalOr{Line: RubyDollar[1].genericValue.LineNumber(), LHS: RubyDollar[1].genericValue, RHS: RubyDollar[4].genericValue}
 		}
-	case 317:
+	case 331:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1858
+//line parser.y:1904
 		{
 			lambda := ast.Lambda{Body: RubyDollar[2].genericBlock}
 			lambda.Line = RubyDollar[2].genericBlock.LineNumber()
 			RubyVAL.genericValue = lambda
 		}
-	case 318:
+	case 332:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1865
+//line parser.y:1911
 		{
 			switchstmt := ast.SwitchStatement{Condition: RubyDollar[2].genericValue, Cases: RubyDollar[4].switchCaseSlice}
 			switchstmt.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = switchstmt
 		}
-	case 319:
+	case 333:
 		RubyDollar = RubyS[Rubypt-7 : Rubypt+1]
-		//line parser.y:1871
+//line parser.y:1917
 		{
 			switchstmt := ast.SwitchStatement{Condition: RubyDollar[2].genericValue, Cases: RubyDollar[4].switchCaseSlice, Else: RubyDollar[6].genericSlice}
 			switchstmt.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = switchstmt
 		}
-	case 320:
+	case 334:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1877
+//line parser.y:1923
 		{
 			switchstmt := ast.SwitchStatement{Cases: RubyDollar[3].switchCaseSlice}
 			switchstmt.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = switchstmt
 		}
-	case 321:
+	case 335:
 		RubyDollar = RubyS[Rubypt-6 : Rubypt+1]
-		//line parser.y:1883
+//line parser.y:1929
 		{
 			switchstmt := ast.SwitchStatement{Cases: RubyDollar[3].switchCaseSlice, Else: RubyDollar[5].genericSlice}
 			switchstmt.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = switchstmt
 		}
-	case 322:
+	case 336:
 		RubyDollar = RubyS[Rubypt-4 : Rubypt+1]
-		//line parser.y:1890
+//line parser.y:1936
 		{
 			RubyVAL.switchCaseSlice = append(RubyVAL.switchCaseSlice, ast.SwitchCase{Conditions: RubyDollar[2].genericSlice, Body: RubyDollar[3].genericSlice})
 		}
-	case 323:
+	case 337:
 		RubyDollar = RubyS[Rubypt-5 : Rubypt+1]
-		//line parser.y:1892
+//line parser.y:1938
 		{
 			RubyVAL.switchCaseSlice = append(RubyVAL.switchCaseSlice, ast.SwitchCase{Conditions: RubyDollar[3].genericSlice, Body: RubyDollar[4].genericSlice})
 		}
-	case 324:
+	case 338:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1895
+//line parser.y:1941
 		{
 			RubyVAL.genericValue = ast.Range{Start: RubyDollar[1].genericValue, End: RubyDollar[3].genericValue, Line: RubyDollar[1].genericValue.LineNumber()}
 		}
-	case 325:
+	case 339:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1897
+//line parser.y:1943
 		{
 			RubyVAL.genericValue = ast.Range{
 				Start:            RubyDollar[1].genericValue,
@@ -3841,29 +4007,45 @@ Rubydefault:
 				ExcludeLastValue: true,
 			}
 		}
-	case 326:
+	case 340:
 		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
-		//line parser.y:1907
+//line parser.y:1953
 		{
 			alias := ast.Alias{To: RubyDollar[2].genericValue.(ast.Symbol), From: RubyDollar[3].genericValue.(ast.Symbol)}
 			alias.Line = RubyDollar[1].genericValue.LineNumber()
 			RubyVAL.genericValue = alias
 		}
-	case 327:
+	case 341:
+		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
+//line parser.y:1960
+		{
+			undef := ast.Undef{Names: []ast.Symbol{RubyDollar[2].genericValue.(ast.Symbol)}}
+			undef.Line = RubyDollar[1].genericValue.LineNumber()
+			RubyVAL.genericValue = undef
+		}
+	case 342:
+		RubyDollar = RubyS[Rubypt-3 : Rubypt+1]
+//line parser.y:1966
+		{
+			undef := RubyDollar[1].genericValue.(ast.Undef)
+			undef.Names = append(undef.Names, RubyDollar[3].genericValue.(ast.Symbol))
+			RubyVAL.genericValue = undef
+		}
+	case 343:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1914
+//line parser.y:1973
 		{
 			RubyVAL.genericValue = ast.Defined{Node: RubyDollar[2].genericValue}
 		}
-	case 328:
+	case 344:
 		RubyDollar = RubyS[Rubypt-1 : Rubypt+1]
-		//line parser.y:1918
+//line parser.y:1977
 		{
 			RubyVAL.genericValue = RubyDollar[1].genericValue
 		}
-	case 329:
+	case 345:
 		RubyDollar = RubyS[Rubypt-2 : Rubypt+1]
-		//line parser.y:1920
+//line parser.y:1979
 		{
 			RubyVAL.genericValue = ast.SuperclassMethodImplCall{
 				Line: RubyDollar[1].genericValue.LineNumber(),
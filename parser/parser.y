@@ -79,6 +79,7 @@ var Statements []ast.Node
 %token <genericValue> CASE
 %token <genericValue> WHEN
 %token <genericValue> ALIAS
+%token <genericValue> UNDEF
 %token <genericValue> SUPER
 %token <genericValue> SELF
 %token <genericValue> NIL
@@ -92,6 +93,7 @@ var Statements []ast.Node
 %token <genericValue> COMPLEMENT
 
 %token <genericValue> BINARY_PLUS
+%token <genericValue> BINARY_STAR
 %token <genericValue> UNARY_PLUS
 
 %token <genericValue> BINARY_MINUS
@@ -137,6 +139,7 @@ var Statements []ast.Node
 %type <genericValue> range
 %type <genericBlock> block
 %type <genericValue> alias
+%type <genericValue> undef
 %type <genericValue> super
 %type <genericValue> array
 %type <genericValue> group
@@ -229,6 +232,7 @@ var Statements []ast.Node
 
 // misc
 %type <genericValue> optional_newlines
+%type <genericValue> line_separators
 
 %left DOT
 %left QUESTIONMARK
@@ -259,6 +263,10 @@ capture_list : /* empty */
 optional_newlines : /* empty */ { $$ = nil }
 | optional_newlines NEWLINE { $$ = nil }
 
+line_separators : /* empty */ { $$ = nil }
+| line_separators NEWLINE { $$ = nil }
+| line_separators SEMICOLON { $$ = nil };
+
 list : /* empty */
   { $$ = ast.Nodes{} }
 | list NEWLINE
@@ -274,7 +282,7 @@ single_node : simple_node | array | hash | class_name_with_modules | call_expres
 
 binary_expression : binary_addition | binary_subtraction | binary_multiplication | binary_division | bitwise_and | bitwise_or;
 
-expr : single_node | method_declaration | class_declaration | module_declaration | eigenclass_declaration | assignment | multiple_assignment | conditional_assignment | if_block | begin_block | yield_expression | while_loop | switch_statement | return_expression | break_expression | next_expression | rescue_modifier | range | retry_expression | ternary | alias;
+expr : single_node | method_declaration | class_declaration | module_declaration | eigenclass_declaration | assignment | multiple_assignment | conditional_assignment | if_block | begin_block | yield_expression | while_loop | switch_statement | return_expression | break_expression | next_expression | rescue_modifier | range | retry_expression | ternary | alias | undef;
 
 string_literal : STRING
   { $$ = $1 }
@@ -282,12 +290,12 @@ string_literal : STRING
   {
     $$ = ast.InterpolatedString{
       Line: $1.LineNumber(),
-      Value: $1.(ast.String).StringValue() + $2.StringValue(),
+      Value: interpolatedStringValue($1.(ast.String)) + interpolatedStringValue($2),
     }
   };
 
 rescue_modifier : single_node RESCUE single_node
-  { $$ = ast.RescueModifier{Statement: $1, Rescue: $3} };
+  { $$ = ast.RescueModifier{Line: $1.LineNumber(), Statement: $1, Rescue: $3} };
 
 splat_arg : STAR single_node
   { $$ = ast.StarSplat{Value: $2} };
@@ -725,7 +733,11 @@ call_args : LPAREN optional_newlines nodes_with_commas optional_newlines RPAREN
 | nonempty_nodes_with_commas
   { $$ = $1 }
 | nonempty_nodes_with_commas COMMA optional_newlines proc_arg
-  { $$ = append($1, $4) };
+  { $$ = append($1, $4) }
+| symbol_key_value_pairs
+  { $$ = ast.Nodes{hashFromSymbolKeyValuePairs($1)} }
+| nonempty_nodes_with_commas COMMA optional_newlines symbol_key_value_pairs
+  { $$ = append($1, hashFromSymbolKeyValuePairs($4)) };
 
 comma_delimited_nodes : single_node
   { $$ = append($$, $1) }
@@ -767,7 +779,11 @@ nodes_with_commas : /* empty */ { $$ = ast.Nodes{} }
       Line: $2.LineNumber(),
       Pairs: $4,
     })
-  };
+  }
+| symbol_key_value_pairs
+  { $$ = ast.Nodes{hashFromSymbolKeyValuePairs($1)} }
+| nodes_with_commas COMMA optional_newlines symbol_key_value_pairs
+  { $$ = append($1, hashFromSymbolKeyValuePairs($4)) };
 
 
 proc_arg : ProcArg single_node
@@ -936,7 +952,11 @@ default_value_arg : REF
 | REF EQUALTO single_node
   { $$ = ast.MethodParam{Name: $1.(ast.BareReference).Name, DefaultValue: $3} }
 | ProcArg REF
-  { $$ = ast.MethodParam{Name: $2.(ast.BareReference).Name, IsProc: true} };
+  { $$ = ast.MethodParam{Name: $2.(ast.BareReference).Name, IsProc: true} }
+| REF COLON
+  { $$ = ast.MethodParam{Name: $1.(ast.BareReference).Name, IsKeyword: true} }
+| REF COLON single_node
+  { $$ = ast.MethodParam{Name: $1.(ast.BareReference).Name, IsKeyword: true, DefaultValue: $3} };
 
 
 class_declaration : CLASS class_name_with_modules list END
@@ -1054,6 +1074,8 @@ assignment : REF EQUALTO single_node
   }
 | REF EQUALTO switch_statement
   { $$ = ast.Assignment{Line: $1.LineNumber(), LHS: $1, RHS: $3} }
+| REF EQUALTO begin_block
+  { $$ = ast.Assignment{Line: $1.LineNumber(), LHS: $1, RHS: $3} }
 | CONSTANT EQUALTO expr
   {
     eql := ast.Assignment{
@@ -1249,6 +1271,16 @@ binary_subtraction : single_node BINARY_MINUS expr
   };
 
 binary_multiplication : single_node STAR single_node
+  {
+    mult := ast.CallExpression{
+      Target: $1,
+      Func: ast.BareReference{Line: $2.LineNumber(), Name: "*"},
+      Args: []ast.Node{$3},
+    }
+    mult.Line = $1.LineNumber()
+    $$ = mult
+  }
+| single_node BINARY_STAR single_node
   {
     mult := ast.CallExpression{
       Target: $1,
@@ -1320,11 +1352,9 @@ hash : LBRACE optional_newlines RBRACE
   { $$ = ast.Hash{Line: $1.LineNumber(), Pairs: $3} }
 | LBRACE optional_newlines symbol_key_value_pairs optional_newlines RBRACE
   {
-    pairs := []ast.HashKeyValuePair{}
-    for _, node := range $3 {
-      pairs = append(pairs, node.(ast.HashKeyValuePair))
-    }
-    $$ = ast.Hash{Line: $1.LineNumber(), Pairs: pairs}
+    hash := hashFromSymbolKeyValuePairs($3)
+    hash.Line = $1.LineNumber()
+    $$ = hash
   };
 
 key_value_pair : single_node HASH_ROCKET single_node
@@ -1746,17 +1776,33 @@ while_loop : WHILE expr NEWLINE loop_expressions END
     loop.Line = $2.LineNumber()
     $$ = loop
   }
+| WHILE expr SEMICOLON loop_expressions END
+  {
+    loop := ast.Loop{Condition: $2, Body: $4}
+    loop.Line = $2.LineNumber()
+    $$ = loop
+  }
+| UNTIL expr SEMICOLON loop_expressions END
+  {
+    condition := ast.Negation{Line: $2.LineNumber(), Target:$2}
+    loop := ast.Loop{Condition: condition, Body: $4}
+    loop.Line = $2.LineNumber()
+    $$ = loop
+  }
 | expr UNTIL expr
   {
+    _, bodyFirst := $1.(ast.Begin)
     $$ = ast.Loop{
       Line: $1.LineNumber(),
       Condition: ast.Negation{Line: $1.LineNumber(), Target:$3},
       Body: []ast.Node{$1},
+      BodyFirst: bodyFirst,
     }
   }
 | expr WHILE expr
   {
-    loop := ast.Loop{Condition: $3, Body: []ast.Node{$1}}
+    _, bodyFirst := $1.(ast.Begin)
+    loop := ast.Loop{Condition: $3, Body: []ast.Node{$1}, BodyFirst: bodyFirst}
     loop.Line = $3.LineNumber()
     $$ = loop
   };
@@ -1861,13 +1907,13 @@ lambda : LAMBDA block
     $$ = lambda
   };
 
-switch_statement : CASE single_node optional_newlines switch_cases END
+switch_statement : CASE single_node line_separators switch_cases END
   {
     switchstmt := ast.SwitchStatement{Condition: $2, Cases: $4}
     switchstmt.Line = $1.LineNumber()
     $$ = switchstmt
   }
-| CASE single_node optional_newlines switch_cases ELSE list END
+| CASE single_node line_separators switch_cases ELSE list END
   {
     switchstmt := ast.SwitchStatement{Condition: $2, Cases: $4, Else: $6}
     switchstmt.Line = $1.LineNumber()
@@ -1910,6 +1956,19 @@ alias : ALIAS SYMBOL SYMBOL
     $$ = alias
   };
 
+undef : UNDEF SYMBOL
+  {
+    undef := ast.Undef{Names: []ast.Symbol{$2.(ast.Symbol)}}
+    undef.Line = $1.LineNumber()
+    $$ = undef
+  }
+| undef COMMA SYMBOL
+  {
+    undef := $1.(ast.Undef)
+    undef.Names = append(undef.Names, $3.(ast.Symbol))
+    $$ = undef
+  };
+
 defined: DEFINED single_node
   { $$ = ast.Defined{Node: $2} };
 
@@ -2,6 +2,7 @@ package parser_test
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/grubby/grubby/ast"
 	"github.com/grubby/grubby/parser"
@@ -56,6 +57,16 @@ var _ = Describe("goyacc parser", func() {
 				})
 			})
 
+			Context("encoded as hexadecimal with an uppercase X", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer("0XFF")
+				})
+
+				It("returns a ConstantInt struct representing the value", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{ast.ConstantInt{Value: 255}}))
+				})
+			})
+
 			Context("... a very very large integer", func() {
 				BeforeEach(func() {
 					lexer = parser.NewLexer("9223372036854775808")
@@ -67,6 +78,19 @@ var _ = Describe("goyacc parser", func() {
 					}))
 				})
 			})
+
+			Context("... an integer too large for 64 bits", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer("99999999999999999999")
+				})
+
+				It("should be parsed as a big int", func() {
+					value, _ := new(big.Int).SetString("99999999999999999999", 10)
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.ConstantBigInt{Value: value},
+					}))
+				})
+			})
 		})
 
 		Describe("parsing a float", func() {
@@ -81,6 +105,30 @@ var _ = Describe("goyacc parser", func() {
 			})
 		})
 
+		Describe("parsing a rational", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("0.75r")
+			})
+
+			It("returns a ConstantRational struct representing the value", func() {
+				Expect(parser.Statements).To(Equal([]ast.Node{
+					ast.ConstantRational{Value: big.NewRat(3, 4)},
+				}))
+			})
+		})
+
+		Describe("parsing an imaginary number", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("2i")
+			})
+
+			It("returns a ConstantComplex struct representing the value", func() {
+				Expect(parser.Statements).To(Equal([]ast.Node{
+					ast.ConstantComplex{Value: 2i},
+				}))
+			})
+		})
+
 		Describe("backtics", func() {
 			BeforeEach(func() {
 				lexer = parser.NewLexer("`echo 'this wont work on windows'`")
@@ -116,6 +164,18 @@ var _ = Describe("goyacc parser", func() {
 						ast.InterpolatedString{Value: "foobar"},
 					}))
 				})
+
+				Context("with double quoted strings", func() {
+					BeforeEach(func() {
+						lexer = parser.NewLexer(`'a\b#{c}' "\td"`)
+					})
+
+					It("keeps the backslashes and #s of the single quoted ones literal", func() {
+						Expect(parser.Statements).To(Equal([]ast.Node{
+							ast.InterpolatedString{Value: "a\\\\b\\#{c}\td"},
+						}))
+					})
+				})
 			})
 
 			Context("with escaped single quotes", func() {
@@ -148,7 +208,43 @@ var _ = Describe("goyacc parser", func() {
 
 					It("returns a InterpolatedString struct", func() {
 						Expect(parser.Statements).To(Equal([]ast.Node{
-							ast.InterpolatedString{Value: `pianic-\"-vespid`},
+							ast.InterpolatedString{Value: `pianic-"-vespid`},
+						}))
+					})
+				})
+
+				Context("with escape sequences", func() {
+					BeforeEach(func() {
+						lexer = parser.NewLexer(`"a\tb\n\\ \e\0 \u00e9 \x41 #{"\n"}"`)
+					})
+
+					It("replaces them with the characters they represent", func() {
+						Expect(parser.Statements).To(Equal([]ast.Node{
+							ast.InterpolatedString{Value: "a\tb\n\\\\ \x1b\x00 é A #{\"\\n\"}"},
+						}))
+					})
+				})
+
+				Context("with octal and braced unicode escapes", func() {
+					BeforeEach(func() {
+						lexer = parser.NewLexer(`"\101\012\0x \u{1F600}\u{41 42}"`)
+					})
+
+					It("replaces them with the characters they represent", func() {
+						Expect(parser.Statements).To(Equal([]ast.Node{
+							ast.InterpolatedString{Value: "A\n\x00x 😀AB"},
+						}))
+					})
+				})
+
+				Context("with an escaped #", func() {
+					BeforeEach(func() {
+						lexer = parser.NewLexer(`"\#{x} \x23{x} \\#{x}"`)
+					})
+
+					It("escapes it, so that it does not start an interpolation", func() {
+						Expect(parser.Statements).To(Equal([]ast.Node{
+							ast.InterpolatedString{Value: `\#{x} \#{x} \\#{x}`},
 						}))
 					})
 				})
@@ -320,6 +416,22 @@ FOO
 				})
 			})
 
+			Context("quoted with escape sequences", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`[:"a\"b", :"c\\", :"d\ne"]`)
+				})
+
+				It("is named by the characters they stand for", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.Array{Nodes: []ast.Node{
+							ast.Symbol{Name: `a"b`},
+							ast.Symbol{Name: `c\`},
+							ast.Symbol{Name: "d\ne"},
+						}},
+					}))
+				})
+			})
+
 			Context("with an @ following the :", func() {
 				BeforeEach(func() {
 					lexer = parser.NewLexer(":@foo")
@@ -1611,6 +1723,33 @@ end
 				})
 			})
 
+			Context("with keyword parameters", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`
+def configure(host:, port: 80)
+end
+`)
+				})
+
+				It("returns a function declaration with keyword parameters", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.FuncDecl{
+							Line: 1,
+							Name: ast.BareReference{Line: 1, Name: "configure"},
+							Args: []ast.MethodParam{
+								{Name: "host", IsKeyword: true},
+								{
+									Name:         "port",
+									IsKeyword:    true,
+									DefaultValue: ast.ConstantInt{Line: 1, Value: 80},
+								},
+							},
+							Body: []ast.Node{},
+						},
+					}))
+				})
+			})
+
 			Context("with default value args and a block", func() {
 				BeforeEach(func() {
 					lexer = parser.NewLexer(`
@@ -1780,6 +1919,90 @@ end
 					}))
 				})
 			})
+
+			Context("between =begin and =end", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`5
+=begin
+this is documentation (
+  which need not parse
+=end
+12
+`)
+				})
+
+				It("ignores the whole block, keeping track of line numbers", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.ConstantInt{Line: 0, Value: 5},
+						ast.ConstantInt{Line: 5, Value: 12},
+					}))
+				})
+			})
+
+			Context("with the frozen_string_literal magic comment", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`# frozen_string_literal: true
+'single'
+"double"
+"#{1}"
+`)
+				})
+
+				It("marks the string literals that follow as frozen", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.SimpleString{Line: 1, Value: "single", Frozen: true},
+						ast.InterpolatedString{Line: 2, Value: "double", Frozen: true},
+						ast.InterpolatedString{Line: 3, Value: "#{1}"},
+					}))
+				})
+			})
+
+			Context("with the frozen_string_literal magic comment below other comments", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`#!/usr/bin/env ruby
+# encoding: utf-8
+
+# frozen_string_literal: true
+'single'
+`)
+				})
+
+				It("marks the string literals that follow as frozen", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.SimpleString{Line: 4, Value: "single", Frozen: true},
+					}))
+				})
+			})
+
+			Context("with the frozen_string_literal magic comment after some code", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`# a comment
+'single'
+# another comment
+# frozen_string_literal: true
+'double'
+`)
+				})
+
+				It("ignores the magic comment", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.SimpleString{Line: 1, Value: "single"},
+						ast.SimpleString{Line: 4, Value: "double"},
+					}))
+				})
+			})
+
+			Context("inside of a string", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`"not # a comment #{1} # either"`)
+				})
+
+				It("is part of the string", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.InterpolatedString{Value: "not # a comment #{1} # either"},
+					}))
+				})
+			})
 		})
 
 		Describe("classes", func() {
@@ -2677,6 +2900,33 @@ false
 				})
 			})
 
+			Describe("* after a reference", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`
+x * 2
+puts *args
+`)
+				})
+
+				It("multiplies when followed by a space, and splats otherwise", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.CallExpression{
+							Line:   1,
+							Target: ast.BareReference{Line: 1, Name: "x"},
+							Func:   ast.BareReference{Line: 1, Name: "*"},
+							Args:   []ast.Node{ast.ConstantInt{Line: 1, Value: 2}},
+						},
+						ast.CallExpression{
+							Line: 2,
+							Func: ast.BareReference{Line: 2, Name: "puts"},
+							Args: []ast.Node{
+								ast.StarSplat{Value: ast.BareReference{Line: 2, Name: "args"}},
+							},
+						},
+					}))
+				})
+			})
+
 			Describe("/", func() {
 				BeforeEach(func() {
 					lexer = parser.NewLexer(`
@@ -3295,11 +3545,36 @@ foo: bar,
 					}))
 				})
 			})
+
+			Context("as trailing 'key: value' method arguments", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer(`configure(1, host: 'example.com')`)
+				})
+
+				It("returns a call expression with a Hash as its last argument", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.CallExpression{
+							Func: ast.BareReference{Name: "configure"},
+							Args: []ast.Node{
+								ast.ConstantInt{Value: 1},
+								ast.Hash{
+									Pairs: []ast.HashKeyValuePair{
+										{
+											Key:   ast.Symbol{Name: "host"},
+											Value: ast.SimpleString{Value: "example.com"},
+										},
+									},
+								},
+							},
+						},
+					}))
+				})
+			})
 		})
 
 		Describe("globals", func() {
 			BeforeEach(func() {
-				lexer = parser.NewLexer("$LOAD_PATH; $0; $\\; $$; $>; $<")
+				lexer = parser.NewLexer("$LOAD_PATH; $0; $\\; $$; $>; $<; $~; $1")
 			})
 
 			It("should be parsed as a GlobalVariable", func() {
@@ -3310,6 +3585,8 @@ foo: bar,
 					ast.GlobalVariable{Name: "$"},
 					ast.GlobalVariable{Name: ">"},
 					ast.GlobalVariable{Name: "<"},
+					ast.GlobalVariable{Name: "~"},
+					ast.GlobalVariable{Name: "1"},
 				}))
 			})
 		})
@@ -3652,6 +3929,39 @@ end
 			})
 		})
 
+		Describe("the 'undef' keyword", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer(`
+class Foo
+  undef wat, zomg?
+  undef :seriously
+end
+`)
+			})
+
+			It("takes a comma separated list of method names", func() {
+				Expect(parser.Statements).To(Equal([]ast.Node{
+					ast.ClassDecl{
+						Line: 1,
+						Name: "Foo",
+						Body: []ast.Node{
+							ast.Undef{
+								Line: 2,
+								Names: []ast.Symbol{
+									{Line: 2, Name: "wat"},
+									{Line: 2, Name: "zomg?"},
+								},
+							},
+							ast.Undef{
+								Line:  3,
+								Names: []ast.Symbol{{Line: 3, Name: "seriously"}},
+							},
+						},
+					},
+				}))
+			})
+		})
+
 		Describe("the 'alias' keyword", func() {
 			BeforeEach(func() {
 				lexer = parser.NewLexer(`
@@ -4459,6 +4769,37 @@ end
 			})
 		})
 
+		Describe("assigning the value of a begin block", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer(`
+value = begin
+  compute
+rescue
+  fallback
+end
+`)
+			})
+
+			It("is parsed as an assignment of the BeginBlock struct", func() {
+				Expect(parser.Statements).To(Equal([]ast.Node{
+					ast.Assignment{
+						Line: 1,
+						LHS:  ast.BareReference{Line: 1, Name: "value"},
+						RHS: ast.Begin{
+							Line: 1,
+							Body: []ast.Node{ast.BareReference{Line: 2, Name: "compute"}},
+							Rescue: []ast.Node{
+								ast.Rescue{
+									Line: 3,
+									Body: []ast.Node{ast.BareReference{Line: 4, Name: "fallback"}},
+								},
+							},
+						},
+					},
+				}))
+			})
+		})
+
 		Describe("begin followed by several rescue statements", func() {
 			BeforeEach(func() {
 				lexer = parser.NewLexer(`
@@ -4683,6 +5024,92 @@ end`)
 			})
 		})
 
+		Describe("semicolons in loops and case statements", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("while x; y; end; case x; when 1; y; end")
+			})
+
+			It("separate the condition from the body", func() {
+				Expect(parser.Statements).To(Equal([]ast.Node{
+					ast.Loop{
+						Condition: ast.BareReference{Name: "x"},
+						Body:      []ast.Node{ast.BareReference{Name: "y"}},
+					},
+					ast.SwitchStatement{
+						Condition: ast.BareReference{Name: "x"},
+						Cases: []ast.SwitchCase{
+							{
+								Conditions: []ast.Node{ast.ConstantInt{Value: 1}},
+								Body:       []ast.Node{ast.BareReference{Name: "y"}},
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		Describe("then and do after a condition", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("if x then y end; while x do y end; case x when 1 then y end")
+			})
+
+			It("separate the condition from the body", func() {
+				Expect(parser.Statements).To(Equal([]ast.Node{
+					ast.IfBlock{
+						Condition: ast.BareReference{Name: "x"},
+						Body:      []ast.Node{ast.BareReference{Name: "y"}},
+					},
+					ast.Loop{
+						Condition: ast.BareReference{Name: "x"},
+						Body:      []ast.Node{ast.BareReference{Name: "y"}},
+					},
+					ast.SwitchStatement{
+						Condition: ast.BareReference{Name: "x"},
+						Cases: []ast.SwitchCase{
+							{
+								Conditions: []ast.Node{ast.ConstantInt{Value: 1}},
+								Body:       []ast.Node{ast.BareReference{Name: "y"}},
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		Describe("do after the condition of a loop that passes a block", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("while x do y.each do |z| z end end")
+			})
+
+			It("still begins the block", func() {
+				Expect(parser.Statements).To(HaveLen(1))
+				loop := parser.Statements[0].(ast.Loop)
+				Expect(loop.Body).To(HaveLen(1))
+				Expect(loop.Body[0].(ast.CallExpression).OptionalBlock.Body).To(Equal([]ast.Node{
+					ast.BareReference{Name: "z"},
+				}))
+			})
+		})
+
+		Describe("a trailing backslash", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("a = 1 \\\n  + 2")
+			})
+
+			It("joins the line with the one after it", func() {
+				Expect(parser.Statements).To(Equal([]ast.Node{
+					ast.Assignment{
+						LHS: ast.BareReference{Name: "a"},
+						RHS: ast.CallExpression{
+							Target: ast.ConstantInt{Value: 1},
+							Func:   ast.BareReference{Line: 1, Name: "+"},
+							Args:   []ast.Node{ast.ConstantInt{Line: 1, Value: 2}},
+						},
+					},
+				}))
+			})
+		})
+
 		Describe("parentheses", func() {
 			BeforeEach(func() {
 				lexer = parser.NewLexer(`
@@ -4845,6 +5272,27 @@ end
 				})
 			})
 
+			Context("with a begin block followed by until", func() {
+				BeforeEach(func() {
+					lexer = parser.NewLexer("begin; foo; end until bar")
+				})
+
+				It("is parsed as a loop that runs its body before checking the condition", func() {
+					Expect(parser.Statements).To(Equal([]ast.Node{
+						ast.Loop{
+							Condition: ast.Negation{Target: ast.BareReference{Name: "bar"}},
+							Body: []ast.Node{
+								ast.Begin{
+									Body:   []ast.Node{ast.BareReference{Name: "foo"}},
+									Rescue: []ast.Node{},
+								},
+							},
+							BodyFirst: true,
+						},
+					}))
+				})
+			})
+
 			Context("with an until statement", func() {
 				BeforeEach(func() {
 					lexer = parser.NewLexer(`
@@ -4918,6 +5366,7 @@ end while true
 										Rescue: []ast.Node{},
 									},
 								},
+								BodyFirst: true,
 							},
 						}))
 					})
@@ -5352,6 +5801,65 @@ end
 			It("fails and returns a useful parse error", func() {
 				Expect(parser.Statements).To(BeEmpty())
 			})
+
+			It("reports the position of the offending token", func() {
+				syntaxError := lexer.(*parser.ConcreteStatefulRubyLexer).SyntaxError()
+				Expect(syntaxError.Line).To(Equal(2))
+				Expect(syntaxError.Column).To(Equal(7))
+				Expect(syntaxError.SourceLine).To(Equal("class foo"))
+			})
+
+			It("reports the unexpected token and the tokens that were expected", func() {
+				syntaxError := lexer.(*parser.ConcreteStatefulRubyLexer).SyntaxError()
+				Expect(syntaxError.Unexpected).To(Equal("'foo'"))
+				Expect(syntaxError.Expected).To(ContainElement("constant"))
+			})
+		})
+
+		Context("given a method with no end", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("def foo\n")
+			})
+
+			It("reports that it expected the end keyword at the end of input", func() {
+				syntaxError := lexer.(*parser.ConcreteStatefulRubyLexer).SyntaxError()
+				Expect(syntaxError.Unexpected).To(Equal("end-of-input"))
+				Expect(syntaxError.Expected).To(Equal([]string{"'end'"}))
+			})
+		})
+
+		Context("given a hex literal with a rational or imaginary suffix", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("1 + 0x10r")
+			})
+
+			It("reports the malformed number", func() {
+				syntaxError := lexer.(*parser.ConcreteStatefulRubyLexer).SyntaxError()
+				Expect(syntaxError.Unexpected).To(Equal("'0x10r'"))
+				Expect(syntaxError.Column).To(Equal(5))
+			})
+		})
+
+		Context("given an imaginary hex literal", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("0xabi")
+			})
+
+			It("reports the malformed number", func() {
+				syntaxError := lexer.(*parser.ConcreteStatefulRubyLexer).SyntaxError()
+				Expect(syntaxError.Unexpected).To(Equal("'0xabi'"))
+			})
+		})
+
+		Context("given a hex literal with an uppercase X and a rational suffix", func() {
+			BeforeEach(func() {
+				lexer = parser.NewLexer("0X10r")
+			})
+
+			It("reports the malformed number", func() {
+				syntaxError := lexer.(*parser.ConcreteStatefulRubyLexer).SyntaxError()
+				Expect(syntaxError.Unexpected).To(Equal("'0X10r'"))
+			})
 		})
 
 		PContext("when the 'next' keyword is outside of a loop or block", func() {
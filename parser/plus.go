@@ -81,6 +81,8 @@ func lexPlus(l StatefulRubyLexer) stateFn {
 		l.emit(tokenTypeUnaryPlus)
 	case tokenTypeStar:
 		l.emit(tokenTypeUnaryPlus)
+	case tokenTypeBinaryStar:
+		l.emit(tokenTypeUnaryPlus)
 	case tokenTypeLBracket:
 		l.emit(tokenTypeUnaryPlus)
 	case tokenTypeRBracket:
@@ -59,7 +59,13 @@ func lexReference(l StatefulRubyLexer) stateFn {
 			defaultCase()
 		}
 	case "do":
-		l.emit(tokenTypeDO)
+		if isLoopDo(l) {
+			l.emit(tokenTypeSemicolon)
+		} else {
+			l.emit(tokenTypeDO)
+		}
+	case "then":
+		l.emit(tokenTypeSemicolon)
 	case "end":
 		l.emit(tokenTypeEND)
 	case "if":
@@ -125,6 +131,9 @@ func lexReference(l StatefulRubyLexer) stateFn {
 	case "alias":
 		l.emit(tokenTypeALIAS)
 		readCommaDelimitedSymbolsUntilEOL(l)
+	case "undef":
+		l.emit(tokenTypeUNDEF)
+		readSymbolListUntilEOL(l)
 	case "super":
 		l.emit(tokenTypeSUPER)
 
@@ -146,6 +155,26 @@ func readSymbolsUntilEOL(l StatefulRubyLexer) {
 	}
 }
 
+// like readCommaDelimitedSymbolsUntilEOL, but emits the commas between them
+func readSymbolListUntilEOL(l StatefulRubyLexer) {
+	l.acceptRun(whitespace)
+	l.ignore()
+	for l.accept(alphaNumericUnderscore) {
+		l.acceptRun(alphaNumericUnderscore)
+		l.accept("?!=")
+		l.emit(tokenTypeSymbol)
+		l.acceptRun(whitespace)
+		l.ignore()
+
+		if !l.accept(",") {
+			return
+		}
+		l.emit(tokenTypeComma)
+		l.acceptRun(whitespace)
+		l.ignore()
+	}
+}
+
 func readCommaDelimitedSymbolsUntilEOL(l StatefulRubyLexer) {
 	l.acceptRun(whitespace)
 	l.ignore()
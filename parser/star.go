@@ -0,0 +1,25 @@
+package parser
+
+// a '*' between a value and a space multiplies, as in `x * 2`, rather than
+// splatting the argument of a method call named x, as in `puts *args`
+func isBinaryStar(l StatefulRubyLexer) bool {
+	switch l.peek() {
+	case ' ', '\t':
+	default:
+		return false
+	}
+
+	switch l.lastToken().typ {
+	case tokenTypeReference,
+		tokenTypeInstanceVariable,
+		tokenTypeClassVariable,
+		tokenTypeGlobal,
+		tokenTypeInteger,
+		tokenTypeFloat,
+		tokenTypeRParen,
+		tokenTypeRBracket:
+		return true
+	default:
+		return false
+	}
+}
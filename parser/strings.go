@@ -1,5 +1,14 @@
 package parser
 
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/grubby/grubby/ast"
+)
+
 func lexSingleQuoteString(l StatefulRubyLexer) stateFn {
 	var (
 		r    rune
@@ -28,20 +37,23 @@ func lexSingleQuoteString(l StatefulRubyLexer) stateFn {
 
 func lexDoubleQuoteString(l StatefulRubyLexer) stateFn {
 	var (
-		r    rune
-		prev rune
+		r       rune
+		escaped bool
 	)
 
 	l.moveCurrentTokenStartIndex(1)
 
 	for {
-		prev = r
 		switch r = l.next(); {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
 		case r == '#':
 			if l.accept("{") {
 				lexUntilClosingMatchingBraces('{', '}')(l)
 			}
-		case r == '"' && prev != '\\':
+		case r == '"':
 			l.moveCurrentPositionIndex(-1)
 			l.emit(tokenTypeDoubleQuoteString)
 			l.next()
@@ -71,3 +83,175 @@ func lexUntilClosingMatchingBraces(openingBrace, closingBrace rune) func(Statefu
 		}
 	}
 }
+
+// unescapeDoubleQuotedString replaces backslash escape sequences in the body
+// of a double quoted string with the characters they represent. The contents
+// of #{...} interpolations are left untouched, since they are evaluated later.
+// A backslash, or a # given by an escape sequence, is escaped with a backslash
+// again, so that the interpreter can tell "\#{x}" from an interpolation.
+func unescapeDoubleQuotedString(str string) string {
+	var buffer bytes.Buffer
+	depth := 0
+
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+
+		if depth > 0 {
+			switch c {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+			buffer.WriteByte(c)
+			continue
+		}
+
+		if c == '#' && i+1 < len(str) && str[i+1] == '{' {
+			depth = 1
+			buffer.WriteString("#{")
+			i++
+			continue
+		}
+
+		if c == '\\' && i+1 >= len(str) {
+			writeLiteral(&buffer, c)
+			continue
+		}
+		if c != '\\' {
+			buffer.WriteByte(c)
+			continue
+		}
+
+		i++
+		switch str[i] {
+		case 'n':
+			buffer.WriteByte('\n')
+		case 't':
+			buffer.WriteByte('\t')
+		case 'r':
+			buffer.WriteByte('\r')
+		case 's':
+			buffer.WriteByte(' ')
+		case 'a':
+			buffer.WriteByte('\a')
+		case 'b':
+			buffer.WriteByte('\b')
+		case 'f':
+			buffer.WriteByte('\f')
+		case 'v':
+			buffer.WriteByte('\v')
+		case 'e':
+			buffer.WriteByte(0x1b)
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			digits := octalDigitsAt(str, i, 3)
+			value, _ := strconv.ParseUint(digits, 8, 16)
+			writeLiteral(&buffer, byte(value))
+			i += len(digits) - 1
+		case 'u':
+			if i+1 < len(str) && str[i+1] == '{' {
+				end := strings.IndexByte(str[i+1:], '}')
+				codepoints, ok := codepointsIn(str[i+2 : i+1+max(end, 1)])
+				if end == -1 || !ok {
+					buffer.WriteString("\\u")
+					continue
+				}
+				for _, codepoint := range codepoints {
+					writeCodepoint(&buffer, codepoint)
+				}
+				i += end + 1
+				continue
+			}
+
+			digits := hexDigitsAt(str, i+1, 4)
+			if len(digits) != 4 {
+				buffer.WriteString("\\u")
+				continue
+			}
+			codepoint, _ := strconv.ParseUint(digits, 16, 32)
+			writeCodepoint(&buffer, rune(codepoint))
+			i += 4
+		case 'x':
+			digits := hexDigitsAt(str, i+1, 2)
+			if len(digits) == 0 {
+				buffer.WriteString("\\x")
+				continue
+			}
+			value, _ := strconv.ParseUint(digits, 16, 8)
+			writeLiteral(&buffer, byte(value))
+			i += len(digits)
+		case '\n':
+			// an escaped newline continues the string on the next line
+		default:
+			writeLiteral(&buffer, str[i])
+		}
+	}
+
+	return buffer.String()
+}
+
+// interpolatedStringValue is the value of a string literal as it is kept in an
+// ast.InterpolatedString, so that adjacent literals can be joined into one.
+// a single quoted one has its backslashes and #s escaped, like those given by
+// escape sequences in a double quoted one, so they are taken literally
+func interpolatedStringValue(str ast.String) string {
+	if _, ok := str.(ast.InterpolatedString); ok {
+		return str.StringValue()
+	}
+
+	var buffer bytes.Buffer
+	value := str.StringValue()
+	for i := 0; i < len(value); i++ {
+		writeLiteral(&buffer, value[i])
+	}
+
+	return buffer.String()
+}
+
+func writeLiteral(buffer *bytes.Buffer, c byte) {
+	if c == '\\' || c == '#' {
+		buffer.WriteByte('\\')
+	}
+	buffer.WriteByte(c)
+}
+
+func writeCodepoint(buffer *bytes.Buffer, codepoint rune) {
+	if codepoint < utf8.RuneSelf {
+		writeLiteral(buffer, byte(codepoint))
+	} else {
+		buffer.WriteRune(codepoint)
+	}
+}
+
+// codepointsIn reads the space separated hex codepoints of a \u{...} escape
+func codepointsIn(str string) ([]rune, bool) {
+	fields := strings.Fields(str)
+	codepoints := make([]rune, 0, len(fields))
+	for _, field := range fields {
+		if len(field) > 6 || len(hexDigitsAt(field, 0, 6)) != len(field) {
+			return nil, false
+		}
+		codepoint, _ := strconv.ParseUint(field, 16, 32)
+		codepoints = append(codepoints, rune(codepoint))
+	}
+
+	return codepoints, len(codepoints) > 0
+}
+
+func octalDigitsAt(str string, start, max int) string {
+	end := start
+	for end < len(str) && end-start < max && str[end] >= '0' && str[end] <= '7' {
+		end++
+	}
+
+	return str[start:end]
+}
+
+func hexDigitsAt(str string, start, max int) string {
+	end := start
+	for end < len(str) && end-start < max && strings.ContainsRune("0123456789abcdefABCDEF", rune(str[end])) {
+		end++
+	}
+
+	return str[start:end]
+}
@@ -1,5 +1,7 @@
 package parser
 
+import "bytes"
+
 const alphaLower = "abcdefghijklmnopqrstuvwxyz"
 const alpha = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 const alphaUnderscore = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_"
@@ -31,15 +33,21 @@ func lexSymbol(l StatefulRubyLexer) stateFn {
 	// some dynamic symbols can start with " and '
 	if l.slice(l.currentIndex()-1, l.currentIndex()) == "\"" {
 		var (
-			r    rune
-			prev rune
+			r       rune
+			escaped bool
 		)
 
 		l.ignore() // ignore : and opening quote
 
 		for {
-			prev = r
 			switch r = l.next(); {
+			case r == eof:
+				l.emit(tokenTypeError)
+				return lexSomething
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
 			case r == '#':
 				if l.accept("{") {
 					// check that we close the #{} template if present
@@ -49,15 +57,12 @@ func lexSymbol(l StatefulRubyLexer) stateFn {
 						}
 					}
 				}
-			case r == '"' && prev != '\\':
+			case r == '"':
 				l.moveCurrentPositionIndex(-1)
 				l.emit(tokenTypeSymbol)
 				l.next()
 				l.ignore()
 				return lexSomething
-			case r == eof:
-				l.emit(tokenTypeError)
-				return lexSomething
 			}
 		}
 	}
@@ -67,3 +72,26 @@ func lexSymbol(l StatefulRubyLexer) stateFn {
 	l.emit(tokenTypeSymbol)
 	return lexSomething
 }
+
+// isQuotedSymbol is whether the symbol token was written like :"foo bar",
+// whose name can contain escape sequences
+func (lexer *ConcreteStatefulRubyLexer) isQuotedSymbol(t token) bool {
+	return t.typ == tokenTypeSymbol && t.offset > 0 && lexer.input[t.offset-1] == '"'
+}
+
+// unescapeSymbolName replaces the escape sequences in the name of a quoted
+// symbol, as in a double quoted string. a symbol is never interpolated, so
+// the backslashes unescapeDoubleQuotedString leaves before \\ and # go too
+func unescapeSymbolName(name string) string {
+	unescaped := unescapeDoubleQuotedString(name)
+
+	var buffer bytes.Buffer
+	for i := 0; i < len(unescaped); i++ {
+		if unescaped[i] == '\\' && i+1 < len(unescaped) {
+			i++
+		}
+		buffer.WriteByte(unescaped[i])
+	}
+
+	return buffer.String()
+}
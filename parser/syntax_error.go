@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+func init() {
+	// ask goyacc for "unexpected X, expecting Y" messages, rather than
+	// a bare "syntax error"
+	RubyErrorVerbose = true
+}
+
+// SyntaxError describes where in its input the parser gave up
+// Line and Column are 1-based, for display
+type SyntaxError struct {
+	Message    string
+	Line       int
+	Column     int
+	SourceLine string
+
+	// the offending token, and the tokens the parser would have accepted
+	Unexpected string
+	Expected   []string
+}
+
+// readable names for the grammar's tokens in error messages
+var tokenDescriptions = map[string]string{
+	"$end":                   "end-of-input",
+	"EOF":                    "end-of-input",
+	"NEWLINE":                "newline",
+	"SEMICOLON":              "';'",
+	"COLON":                  "':'",
+	"COMMA":                  "','",
+	"DOT":                    "'.'",
+	"LPAREN":                 "'('",
+	"RPAREN":                 "')'",
+	"LBRACKET":               "'['",
+	"RBRACKET":               "']'",
+	"LBRACE":                 "'{'",
+	"RBRACE":                 "'}'",
+	"PIPE":                   "'|'",
+	"EQUALTO":                "'='",
+	"HASH_ROCKET":            "'=>'",
+	"REF":                    "identifier",
+	"CONSTANT":               "constant",
+	"NAMESPACED_CAPITAL_REF": "constant",
+	"NODE":                   "literal",
+	"STRING":                 "string literal",
+	"SYMBOL":                 "symbol literal",
+	"OPERATOR":               "operator",
+	"GLOBAL_VARIABLE":        "global variable",
+}
+
+func newSyntaxError(message string, input string, offending token) *SyntaxError {
+	offset := offending.offset
+	if offset > len(input) {
+		offset = len(input)
+	}
+
+	lineStart := strings.LastIndex(input[:offset], "\n") + 1
+	lineEnd := strings.Index(input[offset:], "\n")
+	if lineEnd == -1 {
+		lineEnd = len(input)
+	} else {
+		lineEnd += offset
+	}
+
+	err := &SyntaxError{
+		Message:    message,
+		Line:       strings.Count(input[:lineStart], "\n") + 1,
+		Column:     offset - lineStart + 1,
+		SourceLine: input[lineStart:lineEnd],
+		Unexpected: describeToken(offending),
+	}
+
+	// goyacc reports "syntax error: unexpected RPAREN, expecting END or COMMA"
+	if i := strings.Index(message, ", expecting "); i != -1 {
+		seen := map[string]bool{}
+		for _, name := range strings.Split(message[i+len(", expecting "):], " or ") {
+			description := describeTokenName(name)
+			if !seen[description] {
+				seen[description] = true
+				err.Expected = append(err.Expected, description)
+			}
+		}
+	}
+
+	err.Message = "syntax error, unexpected " + err.Unexpected
+	if len(err.Expected) > 0 {
+		err.Message += ", expecting " + strings.Join(err.Expected, " or ")
+	}
+
+	return err
+}
+
+func describeToken(t token) string {
+	switch t.typ {
+	case tokenTypeEOF:
+		return "end-of-input"
+	case tokenTypeNewline:
+		return "newline"
+	default:
+		return fmt.Sprintf("'%s'", t.value)
+	}
+}
+
+func describeTokenName(name string) string {
+	description, ok := tokenDescriptions[name]
+	if ok {
+		return description
+	}
+
+	// keywords, eg: END, DO, RESCUE
+	return fmt.Sprintf("'%s'", strings.ToLower(name))
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", err.Line, err.Column, err.Message)
+}
@@ -0,0 +1,25 @@
+package testhelpers
+
+import (
+	"os"
+
+	. "github.com/onsi/gomega"
+)
+
+func SwapStdin(input string, block func()) {
+	in, out, err := os.Pipe()
+	Expect(err).ToNot(HaveOccurred())
+
+	_, err = out.Write([]byte(input))
+	Expect(err).ToNot(HaveOccurred())
+	out.Close()
+
+	oldPipe := os.Stdin
+	defer func() {
+		os.Stdin = oldPipe
+		in.Close()
+	}()
+	os.Stdin = in
+
+	block()
+}